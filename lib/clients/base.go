@@ -33,6 +33,25 @@ type httpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// DeploymentTokenClient sets the token provided to deployment containers via the 'MGW_DTK' environment variable. The
+// token is required by the restricted API.
+type DeploymentTokenClient struct {
+	client httpClient
+	token  string
+}
+
+func NewDeploymentTokenClient(httpClient httpClient, token string) *DeploymentTokenClient {
+	return &DeploymentTokenClient{
+		client: httpClient,
+		token:  token,
+	}
+}
+
+func (c *DeploymentTokenClient) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set(constants.HttpHeaderAuthorization, "Bearer "+c.token)
+	return c.client.Do(req)
+}
+
 func doJson(client httpClient, req *http.Request, v any) error {
 	res, err := client.Do(req)
 	if err != nil {
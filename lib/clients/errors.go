@@ -74,6 +74,14 @@ func wrapError(err error, errCode string) error {
 		err = errors.Wrap[errors.ErrInvalidInput](err)
 	case "004":
 		err = errors.Wrap[errors.ErrActiveJob](err)
+	case "005":
+		err = errors.Wrap[errors.ErrRateLimited](err)
+	case "006":
+		err = errors.Wrap[errors.ErrInUse](err)
+	case "007":
+		err = errors.Wrap[errors.ErrUnauthorized](err)
+	case "008":
+		err = errors.Wrap[errors.ErrForbidden](err)
 	}
 	return err
 }
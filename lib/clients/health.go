@@ -20,7 +20,9 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
 	"github.com/SENERGY-Platform/mgw-module-manager/lib/models"
@@ -80,3 +82,44 @@ func (c *ClientHealth) DeploymentsHealth(ctx context.Context, filter models.Depl
 	}
 	return res, nil
 }
+
+func appendDeploymentRuntimeEventsQuery(u string, filter models.DeploymentRuntimeEventsFilter) string {
+	var items []string
+	if len(filter.AuxiliaryDeploymentIds) > 0 {
+		items = append(items, "auxiliary_deployment_ids="+queryJoinStrings(filter.AuxiliaryDeploymentIds))
+	}
+	if len(filter.Actions) > 0 {
+		items = append(items, "actions="+queryJoinStrings(filter.Actions))
+	}
+	if !filter.Since.IsZero() {
+		items = append(items, "since="+url.QueryEscape(filter.Since.Format(time.RFC3339Nano)))
+	}
+	if filter.Limit > 0 {
+		items = append(items, "limit="+strconv.Itoa(filter.Limit))
+	}
+	if len(items) > 0 {
+		return u + "?" + strings.Join(items, "&")
+	}
+	return u
+}
+
+func (c *ClientHealth) DeploymentRuntimeEvents(
+	ctx context.Context,
+	deploymentId string,
+	filter models.DeploymentRuntimeEventsFilter,
+) ([]models.DeploymentRuntimeEvent, error) {
+	u, err := url.JoinPath(c.baseUrl, getUrlRelPath(constants.HttpPathDeploymentRuntimeEventsCollection, deploymentId))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, appendDeploymentRuntimeEventsQuery(u, filter), nil)
+	if err != nil {
+		return nil, err
+	}
+	var res []models.DeploymentRuntimeEvent
+	err = doJson(c.client, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
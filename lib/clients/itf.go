@@ -152,4 +152,9 @@ type ClientDeploymentAdvertisementsItf interface {
 
 type ClientHealthItf interface {
 	DeploymentsHealth(ctx context.Context, filter models.DeploymentsHealthInfoFilter) (models.DeploymentsHealthInfo, error)
+	DeploymentRuntimeEvents(
+		ctx context.Context,
+		deploymentId string,
+		filter models.DeploymentRuntimeEventsFilter,
+	) ([]models.DeploymentRuntimeEvent, error)
 }
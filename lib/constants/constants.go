@@ -27,4 +27,49 @@ type DeploymentState = int
 const (
 	DeploymentHealthy DeploymentState = iota + 1
 	DeploymentUnhealthy
+	DeploymentCrashLooping // restarted repeatedly without becoming healthy, automatic restarts suspended
+)
+
+const (
+	EventJobCreated               = "job_created"
+	EventJobProgress              = "job_progress"
+	EventJobCanceled              = "job_canceled"
+	EventJobCompleted             = "job_completed"
+	EventDeploymentState          = "deployment_state"
+	EventAuxiliaryDeploymentState = "auxiliary_deployment_state"
+	EventModuleUpdatesAvailable   = "module_updates_available"
+)
+
+const (
+	JobItemPending = "pending"
+	JobItemRunning = "running"
+	JobItemDone    = "done"
+	JobItemFailed  = "failed"
+)
+
+const (
+	UpdateTypeMajor = "major"
+	UpdateTypeMinor = "minor"
+	UpdateTypePatch = "patch"
+)
+
+const (
+	PlanActionCreate   = "create"
+	PlanActionUpdate   = "update"
+	PlanActionKeep     = "keep"
+	PlanActionRecreate = "recreate"
+	PlanActionRemove   = "remove"
+)
+
+const (
+	RuntimeEventActionStart   = "start"
+	RuntimeEventActionStop    = "stop"
+	RuntimeEventActionRestart = "restart"
+)
+
+const (
+	RuntimeEventReasonNotRunning         = "not_running"         // enabled but not running
+	RuntimeEventReasonDisabled           = "disabled"            // disabled but running
+	RuntimeEventReasonUnhealthy          = "unhealthy"           // running but unhealthy
+	RuntimeEventReasonDeploymentDisabled = "deployment_disabled" // parent deployment of auxiliary deployment disabled
 )
@@ -21,16 +21,30 @@ const (
 	HttpPathModuleResource                       = "modules/:MOD_ID"
 	HttpPathModulesChangeRequestResource         = "modules-change-request"
 	HttpPathModulesAvailableUpdatesCountResource = "modules-available-updates"
+	HttpPathModulesAvailableUpdatesCollection    = "modules-available-updates/list"
+	HttpPathModulesVersionConstraintsCollection  = "modules-version-constraints"
+	HttpPathModuleVersionConstraintResource      = "modules/:MOD_ID/version-constraint"
 
 	HttpPathRepositoriesCollection      = "repositories"
 	HttpPathRepositoryResource          = "repositories/:SOURCE"
 	HttpPathRepositoryModulesCollection = "repository-modules"
+	HttpPathModulePackagesCollection    = "module-packages"
+	HttpPathModulePackageResource       = "module-packages/:MOD_ID"
+	HttpPathModuleBundlesCollection     = "module-bundles"
 
 	HttpPathDeploymentRequestResource = "deployment-request"
 	HttpPathDeploymentsCollection     = "deployments"
 	HttpPathRecreateDeployments       = "deployments-recreate"
 	HttpPathEnableDeployments         = "deployments-enable"
 	HttpPathDisableDeployments        = "deployments-disable"
+	HttpPathDeploymentsPlan           = "deployments-plan"
+
+	HttpPathDeploymentResource            = "deployments/:DEP_ID"
+	HttpPathEnableDeployment              = "deployments/:DEP_ID/enable"
+	HttpPathDisableDeployment             = "deployments/:DEP_ID/disable"
+	HttpPathDeploymentInstancesCollection = "deployment-instances"
+	HttpPathDeploymentSnapshotsCollection = "deployments/:DEP_ID/snapshots"
+	HttpPathRollbackDeployment            = "deployments/:DEP_ID/rollback"
 
 	HttpPathAuxiliaryDeploymentsCollection                 = "deployments/:DEP_ID/auxiliary/deployments"
 	HttpPathAuxiliaryDeploymentResource                    = "deployments/:DEP_ID/auxiliary/deployments/:AUX_DEP_ID"
@@ -48,8 +62,11 @@ const (
 	HttpPathDeploymentAdvertisementResource         = "deployments/:DEP_ID/advertisements/:ADV_REF"
 	HttpPathDeploymentAdvertisementByIdResource     = "deployments/:DEP_ID/advertisements-by-id/:ADV_ID"
 
-	HttpPathGlobalConfigsCollection = "global-configs"
-	HttpPathGlobalConfigResource    = "global-configs/:CFG_ID"
+	HttpPathGlobalConfigsCollection         = "global-configs"
+	HttpPathGlobalConfigResource            = "global-configs/:CFG_ID"
+	HttpPathRecreateGlobalConfigDeployments = "global-configs/:CFG_ID/deployments-recreate"
+
+	HttpPathBackupResource = "backup"
 
 	HttpPathJobsCollection = "jobs"
 	HttpPathJobResource    = "jobs/:JOB_ID"
@@ -63,18 +80,24 @@ const (
 	HttpPathAuxiliaryDeploymentsResultResource      = "results/auxiliary-deployments/:JOB_ID"
 	HttpPathCreateAuxiliaryDeploymentResultResource = "results/auxiliary-deployment-create/:JOB_ID"
 	HttpPathUpdateAuxiliaryDeploymentResultResource = "results/auxiliary-deployment-update/:JOB_ID"
+	HttpPathRestoreBackupResultResource             = "results/backup-restore/:JOB_ID"
+	HttpPathImportBundleResultResource              = "results/bundle-import/:JOB_ID"
+
+	HttpPathDeploymentsHealthCollection       = "health/deployments"
+	HttpPathDeploymentRuntimeEventsCollection = "deployments/:DEP_ID/events"
 
-	HttpPathDeploymentsHealthCollection = "health/deployments"
+	HttpPathEventsStream = "events"
 
 	HttpPathServiceInfoResource = "info"
 )
 
 const (
-	HttpHeaderCoreId    = "X-Core-Id"
-	HttpHeaderManagerId = "X-Manager-Id"
-	HttpHeaderRuntimeId = "X-Runtime-Id"
-	HttpHeaderRequestId = "X-Request-Id"
-	HttpHeaderErrorCode = "X-Err-Code"
-	HttpHeaderApiVer    = "X-Version"
-	HttpHeaderSrvName   = "X-Service"
+	HttpHeaderAuthorization = "Authorization"
+	HttpHeaderCoreId        = "X-Core-Id"
+	HttpHeaderManagerId     = "X-Manager-Id"
+	HttpHeaderRuntimeId     = "X-Runtime-Id"
+	HttpHeaderRequestId     = "X-Request-Id"
+	HttpHeaderErrorCode     = "X-Err-Code"
+	HttpHeaderApiVer        = "X-Version"
+	HttpHeaderSrvName       = "X-Service"
 )
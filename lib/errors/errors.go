@@ -16,6 +16,8 @@
 
 package errors
 
+import "time"
+
 type ErrNotFound struct {
 	errBase
 }
@@ -31,3 +33,20 @@ type ErrActiveJob struct {
 type ErrInvalidInput struct {
 	errBase
 }
+
+type ErrInUse struct {
+	errBase
+}
+
+type ErrUnauthorized struct {
+	errBase
+}
+
+type ErrForbidden struct {
+	errBase
+}
+
+type ErrRateLimited struct {
+	errBase
+	Until time.Time
+}
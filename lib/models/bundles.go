@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+type BundleImportJobResult struct {
+	JobResult
+	Images        []BundleImageResult  `json:"images"`
+	ImagesErrNum  int                  `json:"images_err_num"`
+	Modules       []BundleModuleResult `json:"modules"`
+	ModulesErrNum int                  `json:"modules_err_num"`
+}
+
+type BundleImageResult struct {
+	File string `json:"file"`
+	ErrorResult
+}
+
+// BundleModuleResult contains the images of the module that are neither included in the bundle nor available
+// locally. Such images must be pulled from a registry when the module is installed.
+type BundleModuleResult struct {
+	Id            string   `json:"id"`
+	Source        string   `json:"source"`
+	Channel       string   `json:"channel"`
+	MissingImages []string `json:"missing_images"`
+	ErrorResult
+}
@@ -25,6 +25,13 @@ type GlobalConfig struct {
 	Id   string `json:"id"`
 	Name string `json:"name"`
 	InterfaceValue
+	UsedBy []GlobalConfigReference `json:"used_by,omitempty"`
+}
+
+type GlobalConfigReference struct {
+	DeploymentId string `json:"deployment_id"`
+	ModuleId     string `json:"module_id"`
+	Reference    string `json:"reference"`
 }
 
 type InterfaceValue struct {
@@ -23,37 +23,50 @@ import (
 )
 
 type Deployment struct {
-	Id            string                         `json:"id"`
-	ModuleSource  string                         `json:"module_source"`
-	ModuleChannel string                         `json:"module_channel"`
-	ModuleVersion string                         `json:"module_version"`
-	Enabled       bool                           `json:"enabled"`
-	Created       time.Time                      `json:"created"`
-	Updated       time.Time                      `json:"updated"`
-	Containers    map[string]Container           `json:"containers"`
-	Volumes       map[string]string              `json:"volumes"`        // {reference:name}
-	HostResources map[string]string              `json:"host_resources"` // {reference:hostResourceId}
-	Secrets       map[string]DeploymentSecret    `json:"secrets"`
-	Configs       map[string]InterfaceValue      `json:"configs"`
-	GlobalConfigs map[string]string              `json:"global_configs"` // {reference:globalConfigId}
-	Files         map[string]string              `json:"files"`          // {reference:data}
-	FileGroups    map[string]DeploymentFileGroup `json:"file_groups"`
-	State         int                            `json:"state"` // health state determined by container states
+	Id                string                         `json:"id"`
+	ModuleId          string                         `json:"module_id"`
+	Name              string                         `json:"name"` // instance name, empty for the primary deployment of a module
+	ModuleSource      string                         `json:"module_source"`
+	ModuleChannel     string                         `json:"module_channel"`
+	ModuleVersion     string                         `json:"module_version"`
+	Enabled           bool                           `json:"enabled"`
+	Created           time.Time                      `json:"created"`
+	Updated           time.Time                      `json:"updated"`
+	Containers        map[string]Container           `json:"containers"`
+	Volumes           map[string]string              `json:"volumes"`        // {reference:name}
+	HostResources     map[string]string              `json:"host_resources"` // {reference:hostResourceId}
+	Secrets           map[string]DeploymentSecret    `json:"secrets"`
+	Configs           map[string]InterfaceValue      `json:"configs"`
+	GlobalConfigs     map[string]string              `json:"global_configs"` // {reference:globalConfigId}
+	Files             map[string]string              `json:"files"`          // {reference:data}
+	FileGroups        map[string]DeploymentFileGroup `json:"file_groups"`
+	DependencyTargets map[string]string              `json:"dependency_targets"` // {moduleID:deploymentID}
+	State             int                            `json:"state"`              // health state determined by container states
+	LastStartError    *DeploymentStartError          `json:"last_start_error,omitempty"`
 	ErrorResult
 }
 
 type DeploymentReduced struct {
-	Id            string    `json:"id"`
-	ModuleSource  string    `json:"module_source"`
-	ModuleChannel string    `json:"module_channel"`
-	ModuleVersion string    `json:"module_version"`
-	Enabled       bool      `json:"enabled"`
-	Created       time.Time `json:"created"`
-	Updated       time.Time `json:"updated"`
-	State         int       `json:"state"`
+	Id             string                `json:"id"`
+	ModuleId       string                `json:"module_id"`
+	Name           string                `json:"name"`
+	ModuleSource   string                `json:"module_source"`
+	ModuleChannel  string                `json:"module_channel"`
+	ModuleVersion  string                `json:"module_version"`
+	Enabled        bool                  `json:"enabled"`
+	Created        time.Time             `json:"created"`
+	Updated        time.Time             `json:"updated"`
+	State          int                   `json:"state"`
+	LastStartError *DeploymentStartError `json:"last_start_error,omitempty"`
 	ErrorResult
 }
 
+// DeploymentStartError holds the last error that occurred while the runtime monitor started a deployment.
+type DeploymentStartError struct {
+	Error     string    `json:"error"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 type Container struct {
 	Name    string                    `json:"name"`
 	Alias   string                    `json:"alias"`
@@ -85,13 +98,24 @@ type DeploymentFileGroupFile struct {
 }
 
 type DeploymentUserInput struct {
-	ModuleId      string                                             `json:"module_id"`
-	HostResources map[string]string                                  `json:"host_resources"` // {ref:resourceID}
-	Secrets       map[string]string                                  `json:"secrets"`        // {ref:secretID}
-	Configs       map[string]interface{}                             `json:"configs"`        // {ref:value}
-	GlobalConfigs map[string]string                                  `json:"global_configs"` // {ref:configID}
-	Files         map[string]string                                  `json:"files"`          // {ref:data}
-	FileGroups    map[string]map[string]DeploymentFileGroupUserInput `json:"file_groups"`    // {ref:{path:FileGroupUserInput}}
+	ModuleId          string                                             `json:"module_id"`
+	HostResources     map[string]string                                  `json:"host_resources"`     // {ref:resourceID}
+	Secrets           map[string]string                                  `json:"secrets"`            // {ref:secretID}
+	Configs           map[string]interface{}                             `json:"configs"`            // {ref:value}
+	GlobalConfigs     map[string]string                                  `json:"global_configs"`     // {ref:configID}
+	Files             map[string]string                                  `json:"files"`              // {ref:data}
+	FileGroups        map[string]map[string]DeploymentFileGroupUserInput `json:"file_groups"`        // {ref:{path:FileGroupUserInput}}
+	DependencyTargets map[string]string                                  `json:"dependency_targets"` // {moduleID:deploymentID}, dependencies without target use the primary deployment
+}
+
+// DeploymentInstanceUserInput is used to create an additional named deployment of a module.
+type DeploymentInstanceUserInput struct {
+	Name string `json:"name"`
+	DeploymentUserInput
+}
+
+type DeploymentsFilter struct {
+	ModuleIds []string
 }
 
 type DeploymentFileGroupUserInput struct {
@@ -128,7 +152,13 @@ type DeploymentDeleteResult struct {
 }
 
 type DeploymentResult struct {
-	ModuleId string `json:"module_id"`
-	Id       string `json:"id"`
+	ModuleId string                   `json:"module_id"`
+	Id       string                   `json:"id"`
+	Rollback []DeploymentRollbackStep `json:"rollback,omitempty"` // cleanup steps executed after a failure, in execution order
+	ErrorResult
+}
+
+type DeploymentRollbackStep struct {
+	Name string `json:"name"`
 	ErrorResult
 }
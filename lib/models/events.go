@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import (
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+)
+
+type Event struct {
+	Type                string                         `json:"type"`
+	Timestamp           time.Time                      `json:"timestamp"`
+	Job                 *Job                           `json:"job,omitempty"`
+	Deployment          *DeploymentStateEvent          `json:"deployment,omitempty"`
+	AuxiliaryDeployment *AuxiliaryDeploymentStateEvent `json:"auxiliary_deployment,omitempty"`
+	ModuleUpdates       []ModuleUpdateEvent            `json:"module_updates,omitempty"`
+}
+
+type DeploymentStateEvent struct {
+	Id         string                          `json:"id"`
+	ModuleId   string                          `json:"module_id"`
+	Enabled    bool                            `json:"enabled"`
+	State      constants.DeploymentState       `json:"state"`
+	Containers []DeploymentContainerHealthInfo `json:"containers"`
+}
+
+type AuxiliaryDeploymentStateEvent struct {
+	Id           string              `json:"id"`
+	DeploymentId string              `json:"deployment_id"`
+	Reference    string              `json:"reference"`
+	ModuleId     string              `json:"module_id"`
+	Enabled      bool                `json:"enabled"`
+	Container    ContainerHealthInfo `json:"container"`
+}
+
+// ModuleUpdateEvent provides an installed module and the available update.
+type ModuleUpdateEvent struct {
+	Installed ModuleAbbreviated `json:"installed"`
+	Update    ModuleAbbreviated `json:"update"`
+}
+
+// EventsFilter selects events by type and ids. If ids are provided, events matching any of the job, deployment or
+// module ids are selected. Auxiliary deployment events match the ids of their parent deployment, job events match the
+// id of the deployment the job belongs to and module updates events match if any of the updated modules matches.
+type EventsFilter struct {
+	Types         []string
+	JobIds        []string
+	DeploymentIds []string
+	ModuleIds     []string
+}
@@ -25,12 +25,15 @@ type DeploymentsHealthInfo struct {
 
 type DeploymentHealthInfo struct {
 	ModuleId                         string                          `json:"module_id"`
+	DeploymentId                     string                          `json:"deployment_id"`
+	Name                             string                          `json:"name,omitempty"` // instance name
 	State                            constants.DeploymentState       `json:"state"`
 	Containers                       []DeploymentContainerHealthInfo `json:"containers"`
 	TotalContainers                  int                             `json:"total_containers"`
 	AuxiliaryDeployments             []AuxiliaryDeploymentHealthInfo `json:"auxiliary_deployments"`
 	TotalEnabledAuxiliaryDeployments int                             `json:"total_enabled_auxiliary_deployments"`
 	AuxiliaryDeploymentsState        constants.DeploymentState       `json:"auxiliary_deployments_state"`
+	LastStartError                   *DeploymentStartError           `json:"last_start_error,omitempty"`
 }
 
 type DeploymentContainerHealthInfo struct {
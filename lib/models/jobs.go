@@ -21,10 +21,20 @@ import (
 )
 
 type Job struct {
-	Id          string    `json:"id"`
-	Description string    `json:"description"`
-	Start       time.Time `json:"start"`
-	End         time.Time `json:"end"`
+	Id           string       `json:"id"`
+	Description  string       `json:"description"`
+	DeploymentId string       `json:"deployment_id,omitempty"`
+	Start        time.Time    `json:"start"`
+	End          time.Time    `json:"end"`
+	Interrupted  bool         `json:"interrupted"`
+	Progress     *JobProgress `json:"progress,omitempty"`
+}
+
+type JobProgress struct {
+	Step       int               `json:"step"`
+	StepsTotal int               `json:"steps_total"`
+	Stage      string            `json:"stage"`
+	Items      map[string]string `json:"items,omitempty"` // {item:status}
 }
 
 type JobResult struct {
@@ -83,6 +83,14 @@ type ModulesChangeRequest struct {
 	Created time.Time              `json:"created"`
 }
 
+// ModuleVersionConstraint restricts the versions an installed module is updated to by update all change requests.
+// Constraints use the version range syntax of Modfiles (e.g. ">=v1.4.0;<v2.0.0") or the shorthands "~" and "^", for
+// example "~v1.4" to apply patch updates of version 1.4 only.
+type ModuleVersionConstraint struct {
+	ModuleId   string `json:"module_id"`
+	Constraint string `json:"constraint"`
+}
+
 type ModuleAbbreviated struct {
 	Id   string `json:"id"`
 	Name string `json:"name"`
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+// DeploymentPlan describes the changes a deployment create, update or delete operation would apply. Values of
+// environment variables provided by secrets are redacted.
+type DeploymentPlan struct {
+	ModuleId             string                              `json:"module_id"`
+	Id                   string                              `json:"id"`
+	Action               string                              `json:"action"`
+	Containers           []DeploymentPlanContainer           `json:"containers"`
+	Volumes              []DeploymentPlanItem                `json:"volumes"`
+	Files                []DeploymentPlanItem                `json:"files"`
+	FileGroups           []DeploymentPlanItem                `json:"file_groups"`
+	HttpEndpoints        []DeploymentPlanHttpEndpoint        `json:"http_endpoints"`
+	Images               []DeploymentPlanImage               `json:"images"`
+	AuxiliaryDeployments []DeploymentPlanAuxiliaryDeployment `json:"auxiliary_deployments"`
+	ErrorResult
+}
+
+type DeploymentPlanContainer struct {
+	Reference    string            `json:"reference"`
+	Name         string            `json:"name"` // existing containers only
+	Image        string            `json:"image"`
+	Action       string            `json:"action"`
+	EnvVariables map[string]string `json:"env_variables"` // {name:value}
+}
+
+type DeploymentPlanItem struct {
+	Reference string `json:"reference"`
+	Action    string `json:"action"`
+}
+
+type DeploymentPlanHttpEndpoint struct {
+	Reference string `json:"reference"` // service reference
+	ExtPath   string `json:"ext_path"`
+	Port      int    `json:"port"`
+	Path      string `json:"path"`
+	Action    string `json:"action"`
+}
+
+type DeploymentPlanImage struct {
+	Name      string `json:"name"`
+	Available bool   `json:"available"` // images not available are pulled
+}
+
+type DeploymentPlanAuxiliaryDeployment struct {
+	Id        string `json:"id"`
+	Reference string `json:"reference"`
+	Action    string `json:"action"`
+}
@@ -16,6 +16,8 @@
 
 package models
 
+import "time"
+
 type RepoModule struct {
 	Id                 string                 `json:"id"`
 	Name               string                 `json:"name"`
@@ -26,9 +28,15 @@ type RepoModule struct {
 	InstalledVariant   InstalledModuleVariant `json:"installed_variant"`
 }
 
+// InstalledModuleVariant contains the next version if the repository provides a higher version for the source and
+// channel of the installed module. Updates restricted by the version constraint of the module, or major updates of
+// modules without constraint, are not applied by update all change requests.
 type InstalledModuleVariant struct {
 	ModuleVariant
-	NextVersion string `json:"next_version"`
+	NextVersion       string `json:"next_version"`
+	UpdateType        string `json:"update_type"` // major, minor or patch
+	UpdateRestricted  bool   `json:"update_restricted"`
+	VersionConstraint string `json:"version_constraint"`
 }
 
 type RepoModulesFilter struct {
@@ -56,11 +64,23 @@ type RepoModuleVariantChannel struct {
 	Version  string `json:"version"`
 }
 
+// ModulePackage describes a module provided by an uploaded package.
+type ModulePackage struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Desc    string `json:"description"`
+	Version string `json:"version"`
+	Source  string `json:"source"`
+	Channel string `json:"channel"`
+}
+
 type Repository struct {
-	Type     string
-	Source   string
-	Priority int
-	Channels []RepositoryChannel
+	Type             string
+	Source           string
+	Priority         int
+	Channels         []RepositoryChannel
+	LastRefresh      time.Time
+	LastRefreshError string
 }
 
 type RepositoryChannel struct {
@@ -80,10 +100,11 @@ type RepositoryJobResult struct {
 }
 
 type RepositoryResult struct {
-	Type          string                         `json:"type"`
-	Source        string                         `json:"source"`
-	Refresh       bool                           `json:"refresh"`
-	ChannelErrors []RepositoryChannelErrorResult `json:"channel_errors"`
+	Type             string                         `json:"type"`
+	Source           string                         `json:"source"`
+	Refresh          bool                           `json:"refresh"`
+	RateLimitedUntil *time.Time                     `json:"rate_limited_until,omitempty"`
+	ChannelErrors    []RepositoryChannelErrorResult `json:"channel_errors"`
 	ErrorResult
 }
 
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import "time"
+
+// DeploymentRuntimeEvent records an action of the runtime monitor. Auxiliary deployment events reference the
+// auxiliary deployment and are part of the parent deployment's history.
+type DeploymentRuntimeEvent struct {
+	DeploymentId          string                         `json:"deployment_id"`
+	AuxiliaryDeploymentId string                         `json:"auxiliary_deployment_id,omitempty"`
+	Timestamp             time.Time                      `json:"timestamp"`
+	Action                string                         `json:"action"`
+	Reason                string                         `json:"reason"`
+	ContainersBefore      map[string]ContainerHealthInfo `json:"containers_before"` // {reference:info}
+	ContainersAfter       map[string]ContainerHealthInfo `json:"containers_after"`  // {reference:info}
+	Error                 string                         `json:"error,omitempty"`
+}
+
+type DeploymentRuntimeEventsFilter struct {
+	AuxiliaryDeploymentIds []string
+	Actions                []string
+	Since                  time.Time
+	Limit                  int
+}
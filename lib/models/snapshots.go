@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import "time"
+
+// DeploymentSnapshot holds the module variant and user input of a deployment as created or updated.
+type DeploymentSnapshot struct {
+	Id            string              `json:"id"`
+	DeploymentId  string              `json:"deployment_id"`
+	ModuleId      string              `json:"module_id"`
+	ModuleSource  string              `json:"module_source"`
+	ModuleChannel string              `json:"module_channel"`
+	ModuleVersion string              `json:"module_version"`
+	UserInput     DeploymentUserInput `json:"user_input"`
+	Containers    map[string]string   `json:"containers"` // {reference:name}
+	Created       time.Time           `json:"created"`
+}
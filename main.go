@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"sync"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/SENERGY-Platform/go-service-base/srv-info-hdl"
@@ -17,18 +18,27 @@ import (
 	cm_client "github.com/SENERGY-Platform/mgw-core-manager/client"
 	hm_client "github.com/SENERGY-Platform/mgw-host-manager/client"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/api"
+	handler_auth "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/auth"
 	handler_aux_deployments "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/aux_deployments"
+	handler_backup "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/backup"
+	handler_bundles "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/bundles"
 	handler_database "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database"
-	migration_db_init "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/db_init"
+	migrations_db "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations"
 	migration_db_restructure "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/restructure"
 	handler_dep_advertisements "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/dep_advertisements"
 	handler_deployments "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/deployments"
+	handler_events "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/events"
 	handler_global_configs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/global_configs"
 	handler_jobs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/jobs"
 	handler_modules "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/modules"
 	handler_repositories "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories"
+	handler_repositories_git_api "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/git_api"
 	handler_repositories_github "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/github"
 	handler_repositories_host_dir "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/host_dir"
+	handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+	handler_repositories_tarball "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/tarball"
+	handler_repositories_uploads "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/uploads"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
 	helper_http "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/http"
 	helper_naming "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/naming"
 	helper_os_signal "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/os_signal"
@@ -62,18 +72,29 @@ func main() {
 	}
 
 	// create logger
-	helper_slog.ContextAttributeKeys = []string{helper_naming.RuntimeIdKey, api.ContextKeyRequestId, handler_jobs.ContextKeyJobId}
+	helper_slog.ContextAttributeKeys = []string{
+		helper_naming.RuntimeIdKey,
+		api.ContextKeyRequestId,
+		api.ContextKeyCaller,
+		handler_jobs.ContextKeyJobId,
+	}
 	logger := helper_slog.New(config.Logger.Config, os.Stderr, "", name)
 
 	// init loggers
 	handler_database.InitLogger(logger)
 	handler_repositories.InitLogger(logger)
 	handler_repositories_host_dir.InitLogger(logger)
+	handler_repositories_uploads.InitLogger(logger)
+	handler_repositories_remote.InitLogger(logger)
 	handler_modules.InitLogger(logger)
 	handler_deployments.InitLogger(logger)
 	handler_aux_deployments.InitLogger(logger)
 	handler_global_configs.InitLogger(logger)
+	handler_backup.InitLogger(logger)
+	handler_bundles.InitLogger(logger)
 	handler_dep_advertisements.InitLogger(logger)
+	handler_jobs.InitLogger(logger)
+	handler_events.InitLogger(logger)
 	migration_db_restructure.InitLogger(logger)
 	service.InitLogger(logger)
 	api.InitLogger(logger)
@@ -93,31 +114,106 @@ func main() {
 	helper_time.UTC = config.UseUTC
 
 	// create database handler
-	mySQLConnector, err := handler_database.NewConnector(handler_database.Config{
+	dbConnector, err := handler_database.NewConnector(handler_database.Config{
+		Dialect:  config.Database.Dialect,
 		Address:  config.Database.Address,
 		Database: config.Database.Database,
 		User:     config.Database.User,
 		Password: config.Database.Password.Value(),
+		Path:     config.Database.Path,
 		Timeout:  time.Duration(config.Database.Timeout),
 	})
 	if err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "create mysql connector: %s\n", err)
+		_, _ = fmt.Fprintf(os.Stderr, "create database connector: %s\n", err)
 		ec = 1
 		return
 	}
-	sqlDB := helper_sql_db.NewSQLDatabase(mySQLConnector, helper_sql_db.Config{
+	sqlDB := helper_sql_db.NewSQLDatabase(dbConnector, helper_sql_db.Config{
 		MaxOpenConnections: config.Database.MaxOpenConnections,
 		MaxIdleConnections: config.Database.MaxIdleConnections,
 		ConnMaxLifetime:    time.Duration(config.Database.ConnectionMaxLifetime),
 	})
 	defer sqlDB.Close()
 	databaseHandler := handler_database.New(sqlDB)
+	schemaMigrations := migrations_db.MySQL
+	if config.Database.Dialect == handler_database.DialectSQLite {
+		schemaMigrations = migrations_db.SQLite
+	}
+
+	// print database schema migrations status or revert migrations and exit
+	if configuration.MigrationsStatus {
+		status, err := databaseHandler.MigrationsStatus(context.Background(), schemaMigrations)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "read database schema migrations: %s\n", err)
+			ec = 1
+			return
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "VERSION\tNAME\tSTATE\tAPPLIED\tCHECKSUM")
+		for _, item := range status {
+			var applied string
+			if !item.Applied.IsZero() {
+				applied = item.Applied.Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", item.Version, item.Name, item.State, applied, item.Checksum)
+		}
+		_ = tw.Flush()
+		return
+	}
+	if configuration.MigrateDown >= 0 {
+		if config.Database.Dialect != handler_database.DialectSQLite {
+			if err = databaseHandler.MigrateLegacy(context.Background(), migration_db_restructure.Migration); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "database migration: %s\n", err)
+				ec = 1
+				return
+			}
+		}
+		if err = databaseHandler.MigrateDown(context.Background(), schemaMigrations, configuration.MigrateDown); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "revert database schema migrations: %s\n", err)
+			ec = 1
+		}
+		return
+	}
+
+	// create secret manager client
+	secretManagerClient := sm_client.NewClient(config.MgwCore.SmBaseUrl, helper_http.NewClient(time.Duration(config.MgwCore.Timeout)))
+
+	archiveLimits := helper_archive.Limits{
+		MaxTotalSize: config.ArchiveLimits.MaxTotalSize,
+		MaxFiles:     config.ArchiveLimits.MaxFiles,
+		MaxFileSize:  config.ArchiveLimits.MaxFileSize,
+	}
 
 	// create GitHub repository handler
-	githubRepositoryHandler := handler_repositories_github.New(handler_repositories_github.Config{
-		BaseUrl:     config.GitHubRepositoriesHandler.BaseUrl,
-		WorkdirPath: config.GitHubRepositoriesHandler.WorkdirPath,
-		Timeout:     time.Duration(config.GitHubRepositoriesHandler.Timeout),
+	githubRepositoryHandler := handler_repositories_github.New(
+		handler_repositories_github.Config{
+			BaseUrl:       config.GitHubRepositoriesHandler.BaseUrl,
+			WorkdirPath:   config.GitHubRepositoriesHandler.WorkdirPath,
+			Timeout:       time.Duration(config.GitHubRepositoriesHandler.Timeout),
+			ArchiveLimits: archiveLimits,
+		},
+		secretManagerClient,
+	)
+
+	// create GitLab repository handler
+	gitlabRepositoryHandler := handler_repositories_git_api.NewGitLab(handler_repositories_git_api.Config{
+		WorkdirPath:   config.GitLabRepositoriesHandler.WorkdirPath,
+		Timeout:       time.Duration(config.GitLabRepositoriesHandler.Timeout),
+		ArchiveLimits: archiveLimits,
+	})
+
+	// create Gitea repository handler
+	giteaRepositoryHandler := handler_repositories_git_api.NewGitea(handler_repositories_git_api.Config{
+		WorkdirPath:   config.GiteaRepositoriesHandler.WorkdirPath,
+		Timeout:       time.Duration(config.GiteaRepositoriesHandler.Timeout),
+		ArchiveLimits: archiveLimits,
+	})
+
+	// create tarball repository handler
+	tarballRepositoryHandler := handler_repositories_tarball.New(handler_repositories_tarball.Config{
+		WorkdirPath:   config.TarballRepositoriesHandler.WorkdirPath,
+		Timeout:       time.Duration(config.TarballRepositoriesHandler.Timeout),
+		ArchiveLimits: archiveLimits,
 	})
 
 	// create host directory repository handler
@@ -126,8 +222,23 @@ func main() {
 		config.HostDirRepositoryHandler.Priority,
 	)
 
+	// create uploads repository handler
+	uploadsRepositoryHandler := handler_repositories_uploads.New(handler_repositories_uploads.Config{
+		WorkdirPath:   config.UploadsRepositoryHandler.WorkdirPath,
+		Priority:      config.UploadsRepositoryHandler.Priority,
+		MaxUploadSize: config.UploadsRepositoryHandler.MaxUploadSize,
+		ArchiveLimits: archiveLimits,
+	})
+
 	// create repositories handler
-	repositoriesHandler := handler_repositories.New(hostDirRepositoryHandler, githubRepositoryHandler)
+	repositoriesHandler := handler_repositories.New(
+		hostDirRepositoryHandler,
+		uploadsRepositoryHandler,
+		githubRepositoryHandler,
+		gitlabRepositoryHandler,
+		giteaRepositoryHandler,
+		tarballRepositoryHandler,
+	)
 
 	// create modules handler
 	modulesHandler := handler_modules.New(
@@ -140,13 +251,19 @@ func main() {
 		},
 	)
 
+	// create events handler
+	eventsHandler := handler_events.New(handler_events.Config{
+		BufferSize: config.EventsHandler.BufferSize,
+	})
+
 	// create deployments handler
 	deploymentsHandler := handler_deployments.New(
 		databaseHandler,
 		cew_client.New(helper_http.NewClient(time.Duration(config.MgwCore.Timeout)), config.MgwCore.CewBaseUrl),
 		hm_client.New(helper_http.NewClient(time.Duration(config.MgwCore.Timeout)), config.MgwCore.HmBaseUrl),
-		sm_client.NewClient(config.MgwCore.SmBaseUrl, helper_http.NewClient(time.Duration(config.MgwCore.Timeout))),
+		secretManagerClient,
 		cm_client.New(helper_http.NewClient(time.Duration(config.MgwCore.Timeout)), config.MgwCore.CmBaseUrl),
+		eventsHandler,
 		handler_deployments.Config{
 			WorkdirPath:                config.DeploymentsHandler.WorkdirPath,
 			PathEscapeDepth:            config.ImageNameEscapeDepth,
@@ -155,6 +272,15 @@ func main() {
 			HostSecretsPath:            config.HostSecretsPath,
 			RuntimeMonitorStartupDelay: time.Duration(config.DeploymentsHandler.RuntimeMonitorStartupDelay),
 			RuntimeMonitorLoopDelay:    time.Duration(config.DeploymentsHandler.RuntimeMonitorLoopDelay),
+			RestartBackoffDelay:        time.Duration(config.DeploymentsHandler.RestartBackoffDelay),
+			RestartBackoffMaxDelay:     time.Duration(config.DeploymentsHandler.RestartBackoffMaxDelay),
+			RestartResetDelay:          time.Duration(config.DeploymentsHandler.RestartResetDelay),
+			CrashLoopMaxRestarts:       config.DeploymentsHandler.CrashLoopMaxRestarts,
+			RestartUnhealthy:           config.DeploymentsHandler.RestartUnhealthy,
+			RuntimeEventsMaxEntries:    config.DeploymentsHandler.RuntimeEventsMaxEntries,
+			WaitForHealthyDependencies: config.DeploymentsHandler.WaitForHealthyDependencies,
+			DependencyHealthyTimeout:   time.Duration(config.DeploymentsHandler.DependencyHealthyTimeout),
+			SnapshotsMaxEntries:        config.DeploymentsHandler.SnapshotsMaxEntries,
 		},
 	)
 
@@ -162,12 +288,39 @@ func main() {
 	auxiliaryDeploymentsHandler := handler_aux_deployments.New(
 		databaseHandler,
 		cew_client.New(helper_http.NewClient(time.Duration(config.MgwCore.Timeout)), config.MgwCore.CewBaseUrl),
+		eventsHandler,
 		handler_aux_deployments.Config{
 			PathEscapeDepth:            config.ImageNameEscapeDepth,
 			JobPollInterval:            time.Duration(config.JobPollInterval),
 			HostDeploymentsPath:        config.HostDeploymentsPath,
 			RuntimeMonitorStartupDelay: time.Duration(config.AuxDeploymentsHandler.RuntimeMonitorStartupDelay),
 			RuntimeMonitorLoopDelay:    time.Duration(config.AuxDeploymentsHandler.RuntimeMonitorLoopDelay),
+			RuntimeEventsMaxEntries:    config.DeploymentsHandler.RuntimeEventsMaxEntries,
+		},
+	)
+
+	// create backup handler
+	backupHandler := handler_backup.New(databaseHandler, repositoriesHandler, handler_backup.Config{
+		WorkdirPath:            config.BackupHandler.WorkdirPath,
+		ModulesWorkdirPath:     config.ModulesHandler.WorkdirPath,
+		DeploymentsWorkdirPath: config.DeploymentsHandler.WorkdirPath,
+		ArchiveLimits:          archiveLimits,
+	})
+
+	// create bundles handler
+	bundlesHandler := handler_bundles.New(
+		cew_client.New(helper_http.NewClient(time.Duration(config.BundlesHandler.ImageLoadTimeout)), config.MgwCore.CewBaseUrl),
+		repositoriesHandler,
+		handler_bundles.Config{
+			WorkdirPath:     config.BundlesHandler.WorkdirPath,
+			PathEscapeDepth: config.ImageNameEscapeDepth,
+			JobPollInterval: time.Duration(config.JobPollInterval),
+			MaxUploadSize:   config.BundlesHandler.MaxUploadSize,
+			ArchiveLimits: helper_archive.Limits{
+				MaxTotalSize: config.BundlesHandler.MaxTotalSize,
+				MaxFiles:     config.BundlesHandler.MaxFiles,
+				MaxFileSize:  config.BundlesHandler.MaxFileSize,
+			},
 		},
 	)
 
@@ -179,7 +332,7 @@ func main() {
 	jobsHandler := handler_jobs.New(ctx, handler_jobs.Config{
 		MaxJobAge:        time.Duration(config.JobsHandler.MaxJobAge),
 		CleanupLoopDelay: time.Duration(config.JobsHandler.CleanupLoopDelay),
-	})
+	}, databaseHandler, eventsHandler)
 
 	// create service
 	srv := service.New(
@@ -189,14 +342,14 @@ func main() {
 		auxiliaryDeploymentsHandler,
 		handler_global_configs.New(databaseHandler),
 		handler_dep_advertisements.New(databaseHandler),
+		backupHandler,
+		bundlesHandler,
 		databaseHandler,
 		jobsHandler,
+		eventsHandler,
 		srv_info_hdl.New(name, version),
 	)
 
-	// set job results cleanup callback
-	jobsHandler.SetCleanupHandler(srv.DeleteJobResults)
-
 	// create handler work directories
 	err = modulesHandler.CreateWorkDir()
 	if err != nil {
@@ -211,8 +364,39 @@ func main() {
 		return
 	}
 
+	err = backupHandler.CreateWorkDir()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "create backup handler work directory: %s\n", err)
+		ec = 1
+		return
+	}
+	err = bundlesHandler.CreateWorkDir()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "create bundles handler work directory: %s\n", err)
+		ec = 1
+		return
+	}
+
+	// create authentication handler, the standard api is not protected if disabled
+	var authenticator api.Authenticator
+	if config.Auth.Enabled {
+		authHandler, err := handler_auth.New(handler_auth.Config{
+			ApiKeysPath:      config.Auth.ApiKeysPath,
+			JwtPublicKeyPath: config.Auth.JwtPublicKeyPath,
+			JwtRolesClaim:    config.Auth.JwtRolesClaim,
+			JwtIssuer:        config.Auth.JwtIssuer,
+			JwtAudience:      config.Auth.JwtAudience,
+		})
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "create authentication handler: %s\n", err)
+			ec = 1
+			return
+		}
+		authenticator = authHandler
+	}
+
 	// create http api
-	httpApiHandler, err := api.CreateHandler(srv, name, version, config.Logger.HttpAccessLog)
+	httpApiHandler, err := api.CreateHandler(srv, authenticator, name, version, config.Logger.HttpAccessLog)
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "create http api engine: %s\n", err)
 		ec = 1
@@ -221,6 +405,8 @@ func main() {
 
 	// create http server
 	httpServer := &http.Server{Handler: httpApiHandler}
+	// end event streams, otherwise the shutdown waits for the clients to disconnect
+	httpServer.RegisterOnShutdown(eventsHandler.Close)
 	serverListener, err := net.Listen("tcp", ":"+strconv.FormatInt(int64(config.ServerPort), 10))
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "create server listener: %s\n", err)
@@ -240,31 +426,75 @@ func main() {
 	logger.DebugContext(ctx, "configuration values", slog_keys.Config, configuration.ToBase64EncodedJson(config))
 
 	// run database migrations
-	err = databaseHandler.Migrate(ctx, migration_db_restructure.Migration, migration_db_init.Migration)
-	if err != nil {
+	if config.Database.Dialect != handler_database.DialectSQLite {
+		if err = databaseHandler.MigrateLegacy(ctx, migration_db_restructure.Migration); err != nil {
+			logger.ErrorContext(ctx, "database migration", slog_keys.Error, err)
+			ec = 1
+			return
+		}
+	}
+	if err = databaseHandler.Migrate(ctx, schemaMigrations); err != nil {
 		logger.ErrorContext(ctx, "database migration", slog_keys.Error, err)
 		ec = 1
 		return
 	}
 
+	// init jobs handler
+	err = jobsHandler.Init(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "initialize jobs handler", slog_keys.Error, err)
+		ec = 1
+		return
+	}
+
 	// init host directory repository handler
 	err = hostDirRepositoryHandler.Init()
 	if err != nil {
 		logger.ErrorContext(ctx, "initialize host directory repository handler", slog_keys.Error, err)
 	}
 
+	// init uploads repository handler
+	err = uploadsRepositoryHandler.Init()
+	if err != nil {
+		logger.ErrorContext(ctx, "initialize uploads repository handler", slog_keys.Error, err)
+	}
+
 	// init GitHub repository handler
 	err = githubRepositoryHandler.Init()
 	if err != nil {
 		logger.ErrorContext(ctx, "initialize github repository handler", slog_keys.Error, err)
 	}
 
+	// init GitLab repository handler
+	err = gitlabRepositoryHandler.Init()
+	if err != nil {
+		logger.ErrorContext(ctx, "initialize gitlab repository handler", slog_keys.Error, err)
+	}
+
+	// init Gitea repository handler
+	err = giteaRepositoryHandler.Init()
+	if err != nil {
+		logger.ErrorContext(ctx, "initialize gitea repository handler", slog_keys.Error, err)
+	}
+
+	// init tarball repository handler
+	err = tarballRepositoryHandler.Init()
+	if err != nil {
+		logger.ErrorContext(ctx, "initialize tarball repository handler", slog_keys.Error, err)
+	}
+
 	// init repositories handler
 	err = repositoriesHandler.Init(ctx)
 	if err != nil {
 		logger.ErrorContext(ctx, "initialize repositories handler", slog_keys.Error, err)
 	}
 
+	// recreate deployments without credentials for the restricted api, e.g. after an upgrade
+	err = srv.RecreateDeploymentsWithoutCredentials(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "recreate deployments without credentials", slog_keys.Error, err)
+	}
+
 	// start os signal listener
 	go func() {
 		sig := helper_os_signal.Wait(ctx, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
@@ -301,6 +531,19 @@ func main() {
 		cf()
 	}()
 
+	// start repositories refresh scheduler
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		srv.RepositoriesRefreshScheduler(ctx, service.RepositoriesRefreshSchedulerConfig{
+			StartupDelay:  time.Duration(config.RepositoriesRefreshScheduler.StartupDelay),
+			Interval:      time.Duration(config.RepositoriesRefreshScheduler.Interval),
+			Jitter:        time.Duration(config.RepositoriesRefreshScheduler.Jitter),
+			RetryDelay:    time.Duration(config.RepositoriesRefreshScheduler.RetryDelay),
+			MaxRetryDelay: time.Duration(config.RepositoriesRefreshScheduler.MaxRetryDelay),
+		})
+	}()
+
 	// start http server
 	go func() {
 		logger.InfoContext(ctx, "start http server")
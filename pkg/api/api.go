@@ -23,6 +23,7 @@ import (
 	sb_slog_attributes "github.com/SENERGY-Platform/go-service-base/struct-logger/attributes"
 	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
 	helper_naming "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/naming"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/service"
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-gonic/gin"
@@ -34,7 +35,8 @@ func init() {
 
 const ContextKeyRequestId = "request_id"
 
-func CreateHandler(srv *service.Service, srvName, srvVersion string, accessLog bool) (http.Handler, error) {
+// CreateHandler creates the http handler. Authentication of the standard API is disabled if the authenticator is nil.
+func CreateHandler(srv *service.Service, authenticator Authenticator, srvName, srvVersion string, accessLog bool) (http.Handler, error) {
 	ginEngine := gin.New()
 	ginEngine.RedirectTrailingSlash = false
 	ginEngine.UseEscapedPath = true
@@ -67,11 +69,27 @@ func CreateHandler(srv *service.Service, srvName, srvVersion string, accessLog b
 		gin_mw.StructRecoveryHandler(logger, gin_mw.DefaultRecoveryFunc),
 	)
 	ginEngine.Use(middleware...)
-	err := registerHandlers(ginEngine, srv, append(standardApiHandlers, sharedApiHandlers...)...)
+	err := registerHandlers(ginEngine, srv, publicApiHandlers...)
 	if err != nil {
 		return nil, err
 	}
-	err = registerHandlers(ginEngine.Group("restricted"), srv, append(restrictedApiHandlers, sharedApiHandlers...)...)
+	err = registerHandlers(
+		ginEngine.Group("", roleAuthHandler(authenticator, pkg_models.RoleRead)),
+		srv,
+		append(readApiHandlers, sharedApiHandlers...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = registerHandlers(ginEngine.Group("", roleAuthHandler(authenticator, pkg_models.RoleOperate)), srv, operateApiHandlers...)
+	if err != nil {
+		return nil, err
+	}
+	err = registerHandlers(ginEngine.Group("", roleAuthHandler(authenticator, pkg_models.RoleAdmin)), srv, adminApiHandlers...)
+	if err != nil {
+		return nil, err
+	}
+	err = registerHandlers(ginEngine.Group("restricted", deploymentAuthHandler(srv)), srv, append(restrictedApiHandlers, sharedApiHandlers...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"strings"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/api/handlers"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ContextKeyDeploymentId = handlers.ContextKeyDeploymentId
+	ContextKeyCaller       = "caller"
+)
+
+type Authenticator interface {
+	Authenticate(token string) (pkg_models.Identity, error)
+}
+
+// roleAuthHandler verifies the token provided via the authorization header and checks if the caller has the required
+// role. The caller is added to the request context for logging.
+func roleAuthHandler(authenticator Authenticator, role pkg_models.Role) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		if authenticator == nil {
+			gc.Next()
+			return
+		}
+		identity, err := authenticator.Authenticate(getBearerToken(gc))
+		if err != nil {
+			abortWithError(gc, err)
+			return
+		}
+		gc.Set(ContextKeyCaller, identity.Method+":"+identity.Subject)
+		if !identity.Role.Includes(role) {
+			abortWithError(gc, lib_errors.New[lib_errors.ErrForbidden]("role '"+string(role)+"' required"))
+			return
+		}
+		gc.Next()
+	}
+}
+
+// deploymentAuthHandler verifies the deployment token provided via the authorization header. Requests for resources
+// of a deployment are only permitted if the token was issued to the same deployment.
+func deploymentAuthHandler(srv *service.Service) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		token := getBearerToken(gc)
+		if token == "" {
+			abortWithError(gc, lib_errors.New[lib_errors.ErrUnauthorized]("missing deployment token"))
+			return
+		}
+		deploymentId, err := srv.AuthenticateDeployment(gc, token)
+		if err != nil {
+			abortWithError(gc, err)
+			return
+		}
+		if depId := gc.Param("DEP_ID"); depId != "" && depId != deploymentId {
+			abortWithError(gc, lib_errors.New[lib_errors.ErrForbidden]("deployment token not valid for deployment '"+depId+"'"))
+			return
+		}
+		gc.Set(ContextKeyDeploymentId, deploymentId)
+		gc.Next()
+	}
+}
+
+func getBearerToken(gc *gin.Context) string {
+	token, ok := strings.CutPrefix(gc.GetHeader(lib_constants.HttpHeaderAuthorization), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return token
+}
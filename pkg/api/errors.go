@@ -37,6 +37,14 @@ func getCodes(err error) (int, string) {
 			return http.StatusBadRequest, "003"
 		case *lib_errors.ErrActiveJob:
 			return http.StatusServiceUnavailable, "004"
+		case *lib_errors.ErrRateLimited:
+			return http.StatusTooManyRequests, "005"
+		case *lib_errors.ErrInUse:
+			return http.StatusConflict, "006"
+		case *lib_errors.ErrUnauthorized:
+			return http.StatusUnauthorized, "007"
+		case *lib_errors.ErrForbidden:
+			return http.StatusForbidden, "008"
 		}
 		err = errors.Unwrap(err)
 		if err == nil {
@@ -72,6 +80,18 @@ func errorHandler(format string) gin.HandlerFunc {
 	}
 }
 
+func abortWithError(gc *gin.Context, err error) {
+	statusCode, errCode := getCodes(err)
+	if statusCode == 0 {
+		statusCode = http.StatusInternalServerError
+	}
+	if errCode != "" {
+		gc.Header(lib_constants.HttpHeaderErrorCode, errCode)
+	}
+	gc.String(statusCode, err.Error())
+	gc.Abort()
+}
+
 func combineErrorMessages(format string, errs []error) string {
 	if len(errs) == 0 {
 		return ""
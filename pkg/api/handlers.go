@@ -29,38 +29,75 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-var standardApiHandlers = []handlerFunc[*service.Service]{
+// publicApiHandlers do not require authentication.
+var publicApiHandlers = []handlerFunc[*service.Service]{
+	handlers.ServiceHealth,
+}
+
+// readApiHandlers, operateApiHandlers and adminApiHandlers form the standard API and require the respective role.
+var readApiHandlers = []handlerFunc[*service.Service]{
 	handlers.GetModule,
 	handlers.GetModules,
 	handlers.GetModulesChangeRequest,
-	handlers.CreateModulesChangeRequest,
-	handlers.ExecModulesChangeRequest,
-	handlers.CancelModulesChangeRequest,
 	handlers.GetModulesAvailableUpdatesCount,
-	handlers.RefreshRepositories,
+	handlers.GetModulesAvailableUpdates,
+	handlers.GetModuleVersionConstraints,
 	handlers.GetRepositories,
-	handlers.CreateRepository,
-	handlers.DeleteRepository,
 	handlers.GetRepositoryModules,
-	handlers.CreateGlobalConfig,
 	handlers.GetGlobalConfig,
 	handlers.GetGlobalConfigs,
-	handlers.UpdateGlobalConfig,
-	handlers.DeleteGlobalConfig,
-	handlers.DeleteGlobalConfigs,
 	handlers.GetDeploymentRequest,
-	handlers.CreateDeployments,
-	handlers.UpdateDeployments,
-	handlers.RecreateDeployments,
-	handlers.DeleteDeployments,
-	handlers.EnableDeployments,
-	handlers.DisableDeployments,
+	handlers.GetDeployment,
+	handlers.GetDeployments,
+	handlers.PlanCreateDeployments,
+	handlers.PlanUpdateDeployments,
+	handlers.PlanDeleteDeployments,
+	handlers.GetDeploymentSnapshots,
 	handlers.GetDeploymentsJobResult,
 	handlers.GetUpdateDeploymentsJobResult,
 	handlers.GetDeleteDeploymentsJobResult,
 	handlers.GetModuleChangeJobResult,
 	handlers.GetRefreshRepositoriesJobResult,
-	handlers.ServiceHealth,
+	handlers.GetRestoreBackupJobResult,
+	handlers.GetImportBundleJobResult,
+}
+
+var operateApiHandlers = []handlerFunc[*service.Service]{
+	handlers.RefreshRepositories,
+	handlers.UpdateGlobalConfig,
+	handlers.RecreateGlobalConfigDeployments,
+	handlers.CreateDeployments,
+	handlers.UpdateDeployments,
+	handlers.CreateDeploymentInstance,
+	handlers.UpdateDeployment,
+	handlers.RecreateDeployments,
+	handlers.EnableDeployments,
+	handlers.DisableDeployments,
+	handlers.EnableDeployment,
+	handlers.DisableDeployment,
+	handlers.RollbackDeployment,
+	handlers.CancelJobs,
+	handlers.CancelJob,
+}
+
+var adminApiHandlers = []handlerFunc[*service.Service]{
+	handlers.CreateModulesChangeRequest,
+	handlers.ExecModulesChangeRequest,
+	handlers.CancelModulesChangeRequest,
+	handlers.SetModuleVersionConstraint,
+	handlers.DeleteModuleVersionConstraint,
+	handlers.CreateRepository,
+	handlers.DeleteRepository,
+	handlers.AddModulePackage,
+	handlers.DeleteModulePackage,
+	handlers.ImportModuleBundle,
+	handlers.CreateGlobalConfig,
+	handlers.DeleteGlobalConfig,
+	handlers.DeleteGlobalConfigs,
+	handlers.DeleteDeployments,
+	handlers.DeleteDeployment,
+	handlers.ExportBackup,
+	handlers.RestoreBackup,
 }
 
 var restrictedApiHandlers = []handlerFunc[*service.Service]{
@@ -80,6 +117,8 @@ var restrictedApiHandlers = []handlerFunc[*service.Service]{
 	handlers.PutDeploymentAdvertisements,
 	handlers.DeleteDeploymentAdvertisement,
 	handlers.DeleteDeploymentAdvertisements,
+	handlers.CancelJobs,
+	handlers.CancelJob,
 }
 
 var sharedApiHandlers = []handlerFunc[*service.Service]{
@@ -95,9 +134,9 @@ var sharedApiHandlers = []handlerFunc[*service.Service]{
 	handlers.GetAuxiliaryDeploymentsJobResult,
 	handlers.GetJobs,
 	handlers.GetJob,
-	handlers.CancelJobs,
-	handlers.CancelJob,
 	handlers.DeploymentsHealth,
+	handlers.GetDeploymentRuntimeEvents,
+	handlers.GetEvents,
 	handlers.ServiceInfo,
 }
 
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/service"
+	"github.com/gin-gonic/gin"
+)
+
+func ExportBackup(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathBackupResource, func(gc *gin.Context) {
+		gc.Header("Content-Type", "application/gzip")
+		gc.Header(
+			"Content-Disposition",
+			fmt.Sprintf("attachment; filename=\"module-manager-backup-%s.tar.gz\"", time.Now().UTC().Format("20060102150405")),
+		)
+		err := srv.ExportBackup(gc, gc.Writer)
+		if err != nil {
+			if gc.Writer.Written() {
+				// response already started, abort to prevent writing the error to the archive
+				gc.Abort()
+				return
+			}
+			gc.Header("Content-Type", "")
+			gc.Header("Content-Disposition", "")
+			_ = gc.Error(err)
+		}
+	}
+}
+
+func RestoreBackup(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathBackupResource, func(gc *gin.Context) {
+		defer gc.Request.Body.Close()
+		res, err := srv.RestoreBackup(gc, gc.Request.Body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
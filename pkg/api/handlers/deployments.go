@@ -44,6 +44,35 @@ func GetDeploymentRequest(srv *service.Service) (string, string, gin.HandlerFunc
 	}
 }
 
+func GetDeployment(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathDeploymentResource, func(gc *gin.Context) {
+		res, err := srv.GetDeployment(gc, gc.Param("DEP_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func GetDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathDeploymentsCollection, func(gc *gin.Context) {
+		var query struct {
+			ModuleIds []string `form:"module_ids" collection_format:"csv"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		res, err := srv.GetDeployments(gc, lib_models.DeploymentsFilter{ModuleIds: query.ModuleIds})
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
 func CreateDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodPost, lib_constants.HttpPathDeploymentsCollection, func(gc *gin.Context) {
 		var body []lib_models.DeploymentUserInput
@@ -76,6 +105,38 @@ func UpdateDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
 	}
 }
 
+func CreateDeploymentInstance(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathDeploymentInstancesCollection, func(gc *gin.Context) {
+		var body lib_models.DeploymentInstanceUserInput
+		err := gc.MustBindWith(&body, binding.JSON)
+		if err != nil {
+			return
+		}
+		res, err := srv.CreateDeploymentInstance(gc, body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func UpdateDeployment(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPut, lib_constants.HttpPathDeploymentResource, func(gc *gin.Context) {
+		var body lib_models.DeploymentUserInput
+		err := gc.MustBindWith(&body, binding.JSON)
+		if err != nil {
+			return
+		}
+		res, err := srv.UpdateDeployment(gc, gc.Param("DEP_ID"), body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
 func RecreateDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodPost, lib_constants.HttpPathRecreateDeployments, func(gc *gin.Context) {
 		var body []string
@@ -111,6 +172,17 @@ func DeleteDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
 	}
 }
 
+func DeleteDeployment(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, lib_constants.HttpPathDeploymentResource, func(gc *gin.Context) {
+		res, err := srv.DeleteDeployment(gc, gc.Param("DEP_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
 func EnableDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodPost, lib_constants.HttpPathEnableDeployments, func(gc *gin.Context) {
 		var body []string
@@ -142,3 +214,105 @@ func DisableDeployments(srv *service.Service) (string, string, gin.HandlerFunc)
 		gc.JSON(http.StatusOK, res)
 	}
 }
+
+func EnableDeployment(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathEnableDeployment, func(gc *gin.Context) {
+		err := srv.EnableDeployment(gc, gc.Param("DEP_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+func DisableDeployment(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathDisableDeployment, func(gc *gin.Context) {
+		err := srv.DisableDeployment(gc, gc.Param("DEP_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+func PlanCreateDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathDeploymentsPlan, func(gc *gin.Context) {
+		var body []lib_models.DeploymentUserInput
+		err := gc.MustBindWith(&body, binding.JSON)
+		if err != nil {
+			return
+		}
+		res, err := srv.PlanCreateDeployments(gc, body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func PlanUpdateDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPut, lib_constants.HttpPathDeploymentsPlan, func(gc *gin.Context) {
+		var body []lib_models.DeploymentUserInput
+		err := gc.MustBindWith(&body, binding.JSON)
+		if err != nil {
+			return
+		}
+		res, err := srv.PlanUpdateDeployments(gc, body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func PlanDeleteDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, lib_constants.HttpPathDeploymentsPlan, func(gc *gin.Context) {
+		var query struct {
+			ModuleIds []string `form:"module_ids" collection_format:"csv"`
+			AllowAll  bool     `form:"allow_all"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		res, err := srv.PlanDeleteDeployments(gc, query.ModuleIds, query.AllowAll)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func GetDeploymentSnapshots(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathDeploymentSnapshotsCollection, func(gc *gin.Context) {
+		res, err := srv.GetDeploymentSnapshots(gc, gc.Param("DEP_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func RollbackDeployment(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathRollbackDeployment, func(gc *gin.Context) {
+		var query struct {
+			SnapshotId string `form:"snapshot_id"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		res, err := srv.RollbackDeployment(gc, gc.Param("DEP_ID"), query.SnapshotId)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
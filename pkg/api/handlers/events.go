@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+const eventsKeepAliveInterval = 30 * time.Second
+
+func GetEvents(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathEventsStream, func(gc *gin.Context) {
+		var query struct {
+			Types         []string `form:"types" collection_format:"csv"`
+			JobIds        []string `form:"job_ids" collection_format:"csv"`
+			DeploymentIds []string `form:"deployment_ids" collection_format:"csv"`
+			ModuleIds     []string `form:"module_ids" collection_format:"csv"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		filter := lib_models.EventsFilter{
+			Types:         query.Types,
+			JobIds:        query.JobIds,
+			DeploymentIds: query.DeploymentIds,
+			ModuleIds:     query.ModuleIds,
+		}
+		// deployments calling the restricted API only receive events of their own resources
+		if deploymentId := gc.GetString(ContextKeyDeploymentId); deploymentId != "" {
+			filter.JobIds = nil
+			filter.DeploymentIds = []string{deploymentId}
+			filter.ModuleIds = nil
+		}
+		events, unsubscribe := srv.SubscribeEvents(gc, filter)
+		defer unsubscribe()
+		ticker := time.NewTicker(eventsKeepAliveInterval)
+		defer ticker.Stop()
+		gc.Header("Cache-Control", "no-cache")
+		gc.Header("Content-Type", "text/event-stream")
+		gc.Status(http.StatusOK)
+		gc.Writer.Flush()
+		gc.Stream(func(w io.Writer) bool {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return false
+				}
+				gc.SSEvent(event.Type, event)
+				return true
+			case <-ticker.C:
+				_, err := io.WriteString(w, ": keep-alive\n\n")
+				return err == nil
+			case <-gc.Request.Context().Done():
+				return false
+			}
+		})
+	}
+}
@@ -96,12 +96,13 @@ func DeleteGlobalConfigs(srv *service.Service) (string, string, gin.HandlerFunc)
 		var query struct {
 			Ids      []string `form:"ids" collection_format:"csv"`
 			AllowAll bool     `form:"allow_all"`
+			Force    bool     `form:"force"`
 		}
 		err := gc.MustBindWith(&query, binding.Query)
 		if err != nil {
 			return
 		}
-		err = srv.DeleteGlobalConfigs(gc, query.Ids, query.AllowAll)
+		err = srv.DeleteGlobalConfigs(gc, query.Ids, query.AllowAll, query.Force)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -112,7 +113,14 @@ func DeleteGlobalConfigs(srv *service.Service) (string, string, gin.HandlerFunc)
 
 func DeleteGlobalConfig(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodDelete, lib_constants.HttpPathGlobalConfigResource, func(gc *gin.Context) {
-		err := srv.DeleteGlobalConfig(gc, gc.Param("CFG_ID"))
+		var query struct {
+			Force bool `form:"force"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		err = srv.DeleteGlobalConfig(gc, gc.Param("CFG_ID"), query.Force)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -120,3 +128,14 @@ func DeleteGlobalConfig(srv *service.Service) (string, string, gin.HandlerFunc)
 		gc.Status(http.StatusOK)
 	}
 }
+
+func RecreateGlobalConfigDeployments(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathRecreateGlobalConfigDeployments, func(gc *gin.Context) {
+		res, err := srv.RecreateGlobalConfigDeployments(gc, gc.Param("CFG_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
@@ -18,6 +18,7 @@ package handlers
 
 import (
 	"net/http"
+	"time"
 
 	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
@@ -60,6 +61,40 @@ func getDeploymentsHealthFilter(gc *gin.Context) (lib_models.DeploymentsHealthIn
 	}, nil
 }
 
+func getDeploymentRuntimeEventsFilter(gc *gin.Context) (lib_models.DeploymentRuntimeEventsFilter, error) {
+	var query struct {
+		AuxDeploymentIds []string  `form:"auxiliary_deployment_ids" collection_format:"csv"`
+		Actions          []string  `form:"actions" collection_format:"csv"`
+		Since            time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+		Limit            int       `form:"limit"`
+	}
+	err := gc.MustBindWith(&query, binding.Query)
+	if err != nil {
+		return lib_models.DeploymentRuntimeEventsFilter{}, err
+	}
+	return lib_models.DeploymentRuntimeEventsFilter{
+		AuxiliaryDeploymentIds: query.AuxDeploymentIds,
+		Actions:                query.Actions,
+		Since:                  query.Since,
+		Limit:                  query.Limit,
+	}, nil
+}
+
+func GetDeploymentRuntimeEvents(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathDeploymentRuntimeEventsCollection, func(gc *gin.Context) {
+		filter, err := getDeploymentRuntimeEventsFilter(gc)
+		if err != nil {
+			return
+		}
+		res, err := srv.GetDeploymentRuntimeEvents(gc, gc.Param("DEP_ID"), filter)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
 func DeploymentsHealth(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_constants.HttpPathDeploymentsHealthCollection, func(gc *gin.Context) {
 		filter, err := getDeploymentsHealthFilter(gc)
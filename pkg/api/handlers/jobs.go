@@ -25,6 +25,10 @@ import (
 	"github.com/gin-gonic/gin/binding"
 )
 
+// ContextKeyDeploymentId holds the id of the deployment calling the restricted API. Handlers registered for the
+// restricted API limit access to resources of that deployment.
+const ContextKeyDeploymentId = "deployment_id"
+
 func GetJobs(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_constants.HttpPathJobsCollection, func(gc *gin.Context) {
 		var query struct {
@@ -34,7 +38,7 @@ func GetJobs(srv *service.Service) (string, string, gin.HandlerFunc) {
 		if err != nil {
 			return
 		}
-		res, err := srv.GetJobs(gc, query.Ids)
+		res, err := srv.GetJobs(gc, gc.GetString(ContextKeyDeploymentId), query.Ids)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -45,7 +49,7 @@ func GetJobs(srv *service.Service) (string, string, gin.HandlerFunc) {
 
 func GetJob(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_constants.HttpPathJobResource, func(gc *gin.Context) {
-		res, err := srv.GetJob(gc, gc.Param("JOB_ID"))
+		res, err := srv.GetJob(gc, gc.GetString(ContextKeyDeploymentId), gc.Param("JOB_ID"))
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -61,7 +65,7 @@ func CancelJobs(srv *service.Service) (string, string, gin.HandlerFunc) {
 		if err != nil {
 			return
 		}
-		err = srv.CancelJobs(gc, body)
+		err = srv.CancelJobs(gc, gc.GetString(ContextKeyDeploymentId), body)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -72,7 +76,7 @@ func CancelJobs(srv *service.Service) (string, string, gin.HandlerFunc) {
 
 func CancelJob(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodPatch, lib_constants.HttpPathJobResource, func(gc *gin.Context) {
-		err := srv.CancelJob(gc, gc.Param("JOB_ID"))
+		err := srv.CancelJob(gc, gc.GetString(ContextKeyDeploymentId), gc.Param("JOB_ID"))
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -148,3 +148,54 @@ func GetModulesAvailableUpdatesCount(srv *service.Service) (string, string, gin.
 		gc.JSON(http.StatusOK, res)
 	}
 }
+
+func GetModulesAvailableUpdates(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathModulesAvailableUpdatesCollection, func(gc *gin.Context) {
+		res, err := srv.GetModulesAvailableUpdates(gc)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func GetModuleVersionConstraints(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathModulesVersionConstraintsCollection, func(gc *gin.Context) {
+		res, err := srv.GetModuleVersionConstraints(gc)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func SetModuleVersionConstraint(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPut, lib_constants.HttpPathModuleVersionConstraintResource, func(gc *gin.Context) {
+		var body struct {
+			Constraint string `json:"constraint"`
+		}
+		err := gc.MustBindWith(&body, binding.JSON)
+		if err != nil {
+			return
+		}
+		err = srv.SetModuleVersionConstraint(gc, gc.Param("MOD_ID"), body.Constraint)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
+func DeleteModuleVersionConstraint(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, lib_constants.HttpPathModuleVersionConstraintResource, func(gc *gin.Context) {
+		err := srv.DeleteModuleVersionConstraint(gc, gc.Param("MOD_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
@@ -106,6 +106,43 @@ func DeleteRepository(srv *service.Service) (string, string, gin.HandlerFunc) {
 	}
 }
 
+func AddModulePackage(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathModulePackagesCollection, func(gc *gin.Context) {
+		var query struct {
+			Channel string `form:"channel"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		defer gc.Request.Body.Close()
+		res, err := srv.AddModulePackage(gc, query.Channel, gc.Request.Body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func DeleteModulePackage(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, lib_constants.HttpPathModulePackageResource, func(gc *gin.Context) {
+		var query struct {
+			Channel string `form:"channel"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		err = srv.DeleteModulePackage(gc, query.Channel, gc.Param("MOD_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
+
 func getGetRepositoryModulesFilter(gc *gin.Context) (lib_models.RepoModulesFilter, error) {
 	var query struct {
 		Ids                []string `form:"ids" collection_format:"csv"`
@@ -169,3 +206,22 @@ func GetRepositoryModules(srv *service.Service) (string, string, gin.HandlerFunc
 		gc.JSON(http.StatusOK, res)
 	}
 }
+
+func ImportModuleBundle(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_constants.HttpPathModuleBundlesCollection, func(gc *gin.Context) {
+		var query struct {
+			Channel string `form:"channel"`
+		}
+		err := gc.MustBindWith(&query, binding.Query)
+		if err != nil {
+			return
+		}
+		defer gc.Request.Body.Close()
+		res, err := srv.ImportBundle(gc, query.Channel, gc.Request.Body)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
@@ -81,7 +81,7 @@ func GetRefreshRepositoriesJobResult(srv *service.Service) (string, string, gin.
 
 func GetCreateAuxiliaryDeploymentJobResult(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_constants.HttpPathCreateAuxiliaryDeploymentResultResource, func(gc *gin.Context) {
-		res, err := srv.GetCreateAuxiliaryDeploymentJobResult(gc, gc.Param("JOB_ID"))
+		res, err := srv.GetCreateAuxiliaryDeploymentJobResult(gc, gc.GetString(ContextKeyDeploymentId), gc.Param("JOB_ID"))
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -92,7 +92,7 @@ func GetCreateAuxiliaryDeploymentJobResult(srv *service.Service) (string, string
 
 func GetUpdateAuxiliaryDeploymentJobResult(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_constants.HttpPathUpdateAuxiliaryDeploymentResultResource, func(gc *gin.Context) {
-		res, err := srv.GetUpdateAuxiliaryDeploymentJobResult(gc, gc.Param("JOB_ID"))
+		res, err := srv.GetUpdateAuxiliaryDeploymentJobResult(gc, gc.GetString(ContextKeyDeploymentId), gc.Param("JOB_ID"))
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -103,7 +103,29 @@ func GetUpdateAuxiliaryDeploymentJobResult(srv *service.Service) (string, string
 
 func GetAuxiliaryDeploymentsJobResult(srv *service.Service) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_constants.HttpPathAuxiliaryDeploymentsResultResource, func(gc *gin.Context) {
-		res, err := srv.GetAuxiliaryDeploymentsJobResult(gc, gc.Param("JOB_ID"))
+		res, err := srv.GetAuxiliaryDeploymentsJobResult(gc, gc.GetString(ContextKeyDeploymentId), gc.Param("JOB_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func GetRestoreBackupJobResult(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathRestoreBackupResultResource, func(gc *gin.Context) {
+		res, err := srv.GetRestoreBackupJobResult(gc, gc.Param("JOB_ID"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, res)
+	}
+}
+
+func GetImportBundleJobResult(srv *service.Service) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_constants.HttpPathImportBundleResultResource, func(gc *gin.Context) {
+		res, err := srv.GetImportBundleJobResult(gc, gc.Param("JOB_ID"))
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -0,0 +1,193 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_token "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/token"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+const (
+	MethodApiKey = "api_key"
+	MethodJwt    = "jwt"
+)
+
+type Config struct {
+	ApiKeysPath      string
+	JwtPublicKeyPath string
+	JwtRolesClaim    string
+	JwtIssuer        string // checked against the iss claim if set
+	JwtAudience      string // must be contained in the aud claim if set
+}
+
+// ApiKey defines a static key. Only the SHA-256 hash of the key is configured.
+type ApiKey struct {
+	Name    string          `json:"name"`
+	KeyHash string          `json:"key_hash"`
+	Role    pkg_models.Role `json:"role"`
+}
+
+// Handler authenticates callers via static API keys or JWTs signed by the configured key. Tokens consisting of three
+// dot separated parts are treated as JWTs. JWTs must provide an expiration time.
+type Handler struct {
+	apiKeys      []ApiKey
+	jwtKey       crypto.PublicKey
+	jwtParser    *jwt.Parser
+	jwtRoleClaim string
+}
+
+func New(config Config) (*Handler, error) {
+	h := &Handler{jwtRoleClaim: config.JwtRolesClaim}
+	if config.ApiKeysPath != "" {
+		apiKeys, err := readApiKeys(config.ApiKeysPath)
+		if err != nil {
+			return nil, fmt.Errorf("read api keys: %w", err)
+		}
+		h.apiKeys = apiKeys
+	}
+	if config.JwtPublicKeyPath != "" {
+		key, methods, err := readJwtPublicKey(config.JwtPublicKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("read jwt public key: %w", err)
+		}
+		h.jwtKey = key
+		parserOptions := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+		if config.JwtIssuer != "" {
+			parserOptions = append(parserOptions, jwt.WithIssuer(config.JwtIssuer))
+		}
+		if config.JwtAudience != "" {
+			parserOptions = append(parserOptions, jwt.WithAudience(config.JwtAudience))
+		}
+		h.jwtParser = jwt.NewParser(parserOptions...)
+	}
+	if len(h.apiKeys) == 0 && h.jwtKey == nil {
+		return nil, errors.New("no api keys or jwt public key configured")
+	}
+	return h, nil
+}
+
+func (h *Handler) Authenticate(token string) (pkg_models.Identity, error) {
+	if token == "" {
+		return pkg_models.Identity{}, lib_errors.New[lib_errors.ErrUnauthorized]("missing token")
+	}
+	if strings.Count(token, ".") == 2 && h.jwtKey != nil {
+		return h.authenticateJwt(token)
+	}
+	return h.authenticateApiKey(token)
+}
+
+func (h *Handler) authenticateApiKey(token string) (pkg_models.Identity, error) {
+	hash := helper_token.Hash(token)
+	for _, apiKey := range h.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(apiKey.KeyHash)) == 1 {
+			return pkg_models.Identity{Subject: apiKey.Name, Method: MethodApiKey, Role: apiKey.Role}, nil
+		}
+	}
+	return pkg_models.Identity{}, lib_errors.New[lib_errors.ErrUnauthorized]("invalid token")
+}
+
+func (h *Handler) authenticateJwt(token string) (pkg_models.Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := h.jwtParser.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return h.jwtKey, nil
+	})
+	if err != nil {
+		return pkg_models.Identity{}, lib_errors.Wrap[lib_errors.ErrUnauthorized](fmt.Errorf("invalid token: %w", err))
+	}
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return pkg_models.Identity{}, lib_errors.New[lib_errors.ErrUnauthorized]("invalid token: missing subject")
+	}
+	return pkg_models.Identity{Subject: subject, Method: MethodJwt, Role: getHighestRole(claims[h.jwtRoleClaim])}, nil
+}
+
+// getHighestRole returns the role with the most privileges of a claim containing a role or a list of roles. Unknown
+// roles are ignored.
+func getHighestRole(claim any) pkg_models.Role {
+	var values []any
+	switch v := claim.(type) {
+	case string:
+		values = append(values, v)
+	case []any:
+		values = v
+	}
+	var role pkg_models.Role
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if tmp := pkg_models.Role(str); tmp.Valid() && tmp.Includes(role) {
+			role = tmp
+		}
+	}
+	return role
+}
+
+func readApiKeys(p string) ([]ApiKey, error) {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	var apiKeys []ApiKey
+	if err = json.Unmarshal(b, &apiKeys); err != nil {
+		return nil, err
+	}
+	for i, apiKey := range apiKeys {
+		if apiKey.Name == "" {
+			return nil, fmt.Errorf("api key %d: missing name", i)
+		}
+		if len(apiKey.KeyHash) != 64 {
+			return nil, fmt.Errorf("api key '%s': invalid key hash", apiKey.Name)
+		}
+		if !apiKey.Role.Valid() {
+			return nil, fmt.Errorf("api key '%s': invalid role '%s'", apiKey.Name, apiKey.Role)
+		}
+		apiKeys[i].KeyHash = strings.ToLower(apiKey.KeyHash)
+	}
+	return apiKeys, nil
+}
+
+// readJwtPublicKey reads a PEM encoded RSA, ECDSA or Ed25519 public key and returns the key and the matching signing
+// methods.
+func readJwtPublicKey(p string) (crypto.PublicKey, []string, error) {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(b); err == nil {
+		return key, []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(b); err == nil {
+		return key, []string{"ES256", "ES384", "ES512"}, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(b); err == nil {
+		return key, []string{"EdDSA"}, nil
+	}
+	return nil, nil, errors.New("unsupported key type")
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_token "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/token"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func TestHandler_Authenticate(t *testing.T) {
+	tempDir := t.TempDir()
+	apiKeysPath := path.Join(tempDir, "api_keys.json")
+	err := os.WriteFile(apiKeysPath, []byte(`[{"name":"support","key_hash":"`+helper_token.Hash("test")+`","role":"read"}]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyPath := path.Join(tempDir, "public.pem")
+	err = os.WriteFile(publicKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := New(Config{
+		ApiKeysPath:      apiKeysPath,
+		JwtPublicKeyPath: publicKeyPath,
+		JwtRolesClaim:    "roles",
+		JwtIssuer:        "issuer",
+		JwtAudience:      "module-manager",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("api key", func(t *testing.T) {
+		identity, err := h.Authenticate("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if identity.Subject != "support" || identity.Method != MethodApiKey || identity.Role != pkg_models.RoleRead {
+			t.Errorf("unexpected identity %+v", identity)
+		}
+		_, err = h.Authenticate("invalid")
+		if !lib_errors.IsOf[lib_errors.ErrUnauthorized](err) {
+			t.Errorf("expected unauthorized error, got %v", err)
+		}
+	})
+	t.Run("jwt", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"sub":   "installer",
+			"iss":   "issuer",
+			"aud":   []string{"other", "module-manager"},
+			"roles": []string{"read", "admin", "unknown"},
+			"exp":   time.Now().Add(time.Minute).Unix(),
+		}).SignedString(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		identity, err := h.Authenticate(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if identity.Subject != "installer" || identity.Method != MethodJwt || identity.Role != pkg_models.RoleAdmin {
+			t.Errorf("unexpected identity %+v", identity)
+		}
+	})
+	t.Run("expired jwt", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"sub": "installer",
+			"iss": "issuer",
+			"aud": "module-manager",
+			"exp": time.Now().Add(-time.Minute).Unix(),
+		}).SignedString(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = h.Authenticate(token)
+		if !lib_errors.IsOf[lib_errors.ErrUnauthorized](err) {
+			t.Errorf("expected unauthorized error, got %v", err)
+		}
+	})
+	t.Run("invalid claims", func(t *testing.T) {
+		exp := time.Now().Add(time.Minute).Unix()
+		tests := map[string]jwt.MapClaims{
+			"missing expiration": {"sub": "installer", "iss": "issuer", "aud": "module-manager"},
+			"missing issuer":     {"sub": "installer", "aud": "module-manager", "exp": exp},
+			"other issuer":       {"sub": "installer", "iss": "other", "aud": "module-manager", "exp": exp},
+			"missing audience":   {"sub": "installer", "iss": "issuer", "exp": exp},
+			"other audience":     {"sub": "installer", "iss": "issuer", "aud": "other", "exp": exp},
+			"missing subject":    {"iss": "issuer", "aud": "module-manager", "exp": exp},
+		}
+		for name, claims := range tests {
+			t.Run(name, func(t *testing.T) {
+				token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(privateKey)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = h.Authenticate(token)
+				if !lib_errors.IsOf[lib_errors.ErrUnauthorized](err) {
+					t.Errorf("expected unauthorized error, got %v", err)
+				}
+			})
+		}
+	})
+	t.Run("untrusted jwt", func(t *testing.T) {
+		_, otherKey, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"sub": "installer"}).SignedString(otherKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = h.Authenticate(token)
+		if !lib_errors.IsOf[lib_errors.ErrUnauthorized](err) {
+			t.Errorf("expected unauthorized error, got %v", err)
+		}
+	})
+}
+
+func TestRole_Includes(t *testing.T) {
+	if !pkg_models.RoleAdmin.Includes(pkg_models.RoleOperate) {
+		t.Error("expected admin to include operate")
+	}
+	if pkg_models.RoleRead.Includes(pkg_models.RoleOperate) {
+		t.Error("expected read not to include operate")
+	}
+	if pkg_models.Role("").Includes(pkg_models.RoleRead) {
+		t.Error("expected empty role not to include read")
+	}
+}
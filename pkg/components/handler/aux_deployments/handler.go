@@ -20,6 +20,7 @@ import (
 	"sync"
 	"time"
 
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/mutex_map"
 )
 
@@ -29,23 +30,28 @@ type Config struct {
 	HostDeploymentsPath        string
 	RuntimeMonitorStartupDelay time.Duration
 	RuntimeMonitorLoopDelay    time.Duration
+	RuntimeEventsMaxEntries    int
 }
 
 type Handler struct {
 	databaseHandler              databaseHandler
 	containerEngineWrapperClient containerEngineWrapperClient
+	eventsHandler                eventsHandler
 	config                       Config
 	mutexes                      *mutex_map.RWMutexMap
 	runtimeMonitorJobs           map[string]struct{}
 	runtimeMonitorJobsMu         sync.RWMutex
+	runtimeStates                map[string]lib_models.AuxiliaryDeploymentStateEvent
 }
 
-func New(databaseHandler databaseHandler, containerEngineWrapperClient containerEngineWrapperClient, config Config) *Handler {
+func New(databaseHandler databaseHandler, containerEngineWrapperClient containerEngineWrapperClient, eventsHandler eventsHandler, config Config) *Handler {
 	return &Handler{
 		databaseHandler:              databaseHandler,
 		containerEngineWrapperClient: containerEngineWrapperClient,
+		eventsHandler:                eventsHandler,
 		config:                       config,
 		mutexes:                      mutex_map.New(),
 		runtimeMonitorJobs:           make(map[string]struct{}),
+		runtimeStates:                make(map[string]lib_models.AuxiliaryDeploymentStateEvent),
 	}
 }
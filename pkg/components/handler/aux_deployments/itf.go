@@ -85,6 +85,7 @@ type databaseHandler interface {
 	DeleteAuxiliaryDeployment(ctx context.Context, auxDeploymentId string) error
 	DeleteAuxiliaryDeployments(ctx context.Context, auxiliaryDeploymentsIds []string) error
 	DeleteAuxiliaryDeploymentVolumes(ctx context.Context, deploymentId string, references []string) error
+	CreateDeploymentRuntimeEvent(ctx context.Context, event lib_models.DeploymentRuntimeEvent, maxEntries int) error
 }
 
 type containerEngineWrapperClient interface {
@@ -103,3 +104,7 @@ type containerEngineWrapperClient interface {
 	GetJob(ctx context.Context, id string) (external_models.JobLibJob, error)
 	CancelJob(ctx context.Context, id string) error
 }
+
+type eventsHandler interface {
+	Publish(event lib_models.Event)
+}
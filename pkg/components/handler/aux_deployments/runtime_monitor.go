@@ -22,7 +22,9 @@ import (
 	"time"
 
 	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_containers "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/containers"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
@@ -48,11 +50,12 @@ func (h *Handler) checkDeployments(ctx context.Context) {
 		rmLogger.ErrorContext(ctx, "get auxiliary deployments", slog_keys.Error, err)
 		return
 	}
+	h.publishStateChanges(auxDepsByParent, cewContainersMap)
 	filteredAuxDepsByParent := h.runtimeMonitorJobsFilter(auxDepsByParent)
 	for parentId, parent := range filteredAuxDepsByParent {
 		if parent.Enabled {
-			var toStart []string
-			var toStop []string
+			var toStart []pkg_models.AuxiliaryDeployment
+			var toStop []pkg_models.AuxiliaryDeployment
 			for _, auxDep := range parent.AuxiliaryDeployments {
 				container, ok := cewContainersMap[auxDep.Container.Name]
 				if !ok || container.State == lib_constants.ContainerRemoving {
@@ -60,38 +63,38 @@ func (h *Handler) checkDeployments(ctx context.Context) {
 				}
 				if auxDep.Enabled {
 					if getContainerState(container.State) < 0 {
-						toStart = append(toStart, container.Name)
+						toStart = append(toStart, auxDep)
 					}
 				} else {
 					if getContainerState(container.State) > 0 {
-						toStop = append(toStop, container.Name)
+						toStop = append(toStop, auxDep)
 					}
 				}
 			}
 			if len(toStart) > 0 || len(toStop) > 0 {
 				h.runtimeMonitorJobsAdd(parentId)
-				go func(pId string, tSrt, tStp []string) {
+				go func(pId string, tSrt, tStp []pkg_models.AuxiliaryDeployment) {
 					defer h.runtimeMonitorJobsRemove(pId)
-					h.startContainers(ctx, tSrt)
-					h.stopContainers(ctx, tStp)
+					h.startContainers(ctx, tSrt, cewContainersMap)
+					h.stopContainers(ctx, tStp, cewContainersMap, lib_constants.RuntimeEventReasonDisabled)
 				}(parentId, toStart, toStop)
 			}
 		} else {
-			var toStop []string
+			var toStop []pkg_models.AuxiliaryDeployment
 			for _, auxDep := range parent.AuxiliaryDeployments {
 				container, ok := cewContainersMap[auxDep.Container.Name]
 				if !ok || container.State == lib_constants.ContainerRemoving {
 					continue
 				}
 				if getContainerState(container.State) > 0 {
-					toStop = append(toStop, container.Name)
+					toStop = append(toStop, auxDep)
 				}
 			}
 			if len(toStop) > 0 {
 				h.runtimeMonitorJobsAdd(parentId)
-				go func(pId string, ts []string) {
+				go func(pId string, ts []pkg_models.AuxiliaryDeployment) {
 					defer h.runtimeMonitorJobsRemove(pId)
-					h.stopContainers(ctx, ts)
+					h.stopContainers(ctx, ts, cewContainersMap, lib_constants.RuntimeEventReasonDeploymentDisabled)
 				}(parentId, toStop)
 			}
 		}
@@ -120,26 +123,115 @@ func (h *Handler) getCurrentRuntimeData(ctx context.Context) (
 
 func (h *Handler) startContainers(
 	ctx context.Context,
-	containerNames []string,
+	auxDeployments []pkg_models.AuxiliaryDeployment,
+	cewContainersMap map[string]external_models.CewContainer,
 ) {
-	rmLogger.DebugContext(ctx, "start containers", slog_keys.Containers, containerNames)
-	for _, name := range containerNames {
-		err := h.containerEngineWrapperClient.StartContainer(ctx, name)
+	for _, auxDep := range auxDeployments {
+		rmLogger.DebugContext(ctx, "start container", slog_keys.AuxDeploymentId, auxDep.Id, slog_keys.ContainerName, auxDep.Container.Name)
+		err := h.containerEngineWrapperClient.StartContainer(ctx, auxDep.Container.Name)
 		if err != nil {
-			rmLogger.ErrorContext(ctx, "start containers", slog_keys.Containers, containerNames, slog_keys.Error, err)
+			rmLogger.ErrorContext(ctx, "start container", slog_keys.AuxDeploymentId, auxDep.Id, slog_keys.ContainerName, auxDep.Container.Name, slog_keys.Error, err)
 		}
+		h.storeRuntimeEvent(ctx, auxDep, lib_constants.RuntimeEventActionStart, lib_constants.RuntimeEventReasonNotRunning, cewContainersMap, err)
 	}
 }
 
 func (h *Handler) stopContainers(
 	ctx context.Context,
-	containerNames []string,
+	auxDeployments []pkg_models.AuxiliaryDeployment,
+	cewContainersMap map[string]external_models.CewContainer,
+	reason string,
 ) {
-	rmLogger.DebugContext(ctx, "stop containers", slog_keys.Containers, containerNames)
-	for _, name := range containerNames {
-		err := helper_containers.Stop(ctx, h.containerEngineWrapperClient, name, h.config.JobPollInterval)
+	for _, auxDep := range auxDeployments {
+		rmLogger.DebugContext(ctx, "stop container", slog_keys.AuxDeploymentId, auxDep.Id, slog_keys.ContainerName, auxDep.Container.Name)
+		err := helper_containers.Stop(ctx, h.containerEngineWrapperClient, auxDep.Container.Name, h.config.JobPollInterval)
 		if err != nil {
-			rmLogger.ErrorContext(ctx, "stop containers", slog_keys.Containers, containerNames, slog_keys.Error, err)
+			rmLogger.ErrorContext(ctx, "stop container", slog_keys.AuxDeploymentId, auxDep.Id, slog_keys.ContainerName, auxDep.Container.Name, slog_keys.Error, err)
+		}
+		h.storeRuntimeEvent(ctx, auxDep, lib_constants.RuntimeEventActionStop, reason, cewContainersMap, err)
+	}
+}
+
+// storeRuntimeEvent stores an action of the runtime monitor in the history of the parent deployment. The container
+// is referenced by the auxiliary deployment reference.
+func (h *Handler) storeRuntimeEvent(
+	ctx context.Context,
+	auxDeployment pkg_models.AuxiliaryDeployment,
+	action string,
+	reason string,
+	cewContainersMap map[string]external_models.CewContainer,
+	actionErr error,
+) {
+	event := lib_models.DeploymentRuntimeEvent{
+		DeploymentId:          auxDeployment.DeploymentId,
+		AuxiliaryDeploymentId: auxDeployment.Id,
+		Timestamp:             helper_time.Now(),
+		Action:                action,
+		Reason:                reason,
+		ContainersBefore:      map[string]lib_models.ContainerHealthInfo{auxDeployment.Reference: getContainerHealthInfo(cewContainersMap[auxDeployment.Container.Name])},
+		ContainersAfter:       make(map[string]lib_models.ContainerHealthInfo),
+	}
+	if actionErr != nil {
+		event.Error = actionErr.Error()
+	}
+	cewContainers, err := h.containerEngineWrapperClient.GetContainers(ctx, external_models.CewContainersFilter{Names: []string{auxDeployment.Container.Name}})
+	if err != nil {
+		rmLogger.WarnContext(ctx, "store runtime event, get container", slog_keys.AuxDeploymentId, auxDeployment.Id, slog_keys.Error, err)
+	}
+	for _, cewContainer := range cewContainers {
+		if cewContainer.Name == auxDeployment.Container.Name {
+			event.ContainersAfter[auxDeployment.Reference] = getContainerHealthInfo(cewContainer)
+		}
+	}
+	if err = h.databaseHandler.CreateDeploymentRuntimeEvent(ctx, event, h.config.RuntimeEventsMaxEntries); err != nil {
+		rmLogger.ErrorContext(ctx, "store runtime event", slog_keys.AuxDeploymentId, auxDeployment.Id, slog_keys.Error, err)
+	}
+}
+
+func getContainerHealthInfo(cewContainer external_models.CewContainer) lib_models.ContainerHealthInfo {
+	containerHealthInfo := lib_models.ContainerHealthInfo{State: cewContainer.State}
+	if cewContainer.Health != nil {
+		containerHealthInfo.Health = *cewContainer.Health
+	}
+	return containerHealthInfo
+}
+
+// publishStateChanges publishes the state of auxiliary deployments that changed since the last check. Must only be
+// called by the runtime monitor.
+func (h *Handler) publishStateChanges(
+	auxDepsByParent map[string]pkg_models.AuxiliaryDeploymentParent,
+	cewContainersMap map[string]external_models.CewContainer,
+) {
+	current := make(map[string]struct{})
+	for parentId, parent := range auxDepsByParent {
+		for id, auxDep := range parent.AuxiliaryDeployments {
+			current[id] = struct{}{}
+			state := lib_models.AuxiliaryDeploymentStateEvent{
+				Id:           id,
+				DeploymentId: parentId,
+				Reference:    auxDep.Reference,
+				ModuleId:     parent.ModuleId,
+				Enabled:      auxDep.Enabled,
+			}
+			if container, ok := cewContainersMap[auxDep.Container.Name]; ok {
+				state.Container.State = container.State
+				if container.Health != nil {
+					state.Container.Health = *container.Health
+				}
+			}
+			if lastState, ok := h.runtimeStates[id]; ok && lastState == state {
+				continue
+			}
+			h.runtimeStates[id] = state
+			h.eventsHandler.Publish(lib_models.Event{
+				Type:                lib_constants.EventAuxiliaryDeploymentState,
+				AuxiliaryDeployment: &state,
+			})
+		}
+	}
+	for id := range h.runtimeStates {
+		if _, ok := current[id]; !ok {
+			delete(h.runtimeStates, id)
 		}
 	}
 }
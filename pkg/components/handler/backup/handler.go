@@ -0,0 +1,287 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	helper_file_sys "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/file_sys"
+	helper_stage "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/stage"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// Backup archive layout: the backup file at the archive root, module and deployment files below the respective
+// directories.
+const (
+	backupFile     = "backup.json"
+	modulesDir     = "modules"
+	deploymentsDir = "deployments"
+	stagePrefix    = "restore-"
+	exportPrefix   = "export-"
+)
+
+type Config struct {
+	WorkdirPath            string // uploaded backups are staged here
+	ModulesWorkdirPath     string
+	DeploymentsWorkdirPath string
+	ArchiveLimits          helper_archive.Limits
+}
+
+type Handler struct {
+	databaseHandler     databaseHandler
+	repositoriesHandler repositoriesHandler
+	stageArea           helper_stage.Area
+	config              Config
+}
+
+func New(databaseHandler databaseHandler, repositoriesHandler repositoriesHandler, config Config) *Handler {
+	return &Handler{
+		databaseHandler:     databaseHandler,
+		repositoriesHandler: repositoriesHandler,
+		stageArea:           helper_stage.New(config.WorkdirPath, stagePrefix, "backup"),
+		config:              config,
+	}
+}
+
+// CreateWorkDir creates the working directory and removes backups staged or exported before a restart.
+func (h *Handler) CreateWorkDir() error {
+	if err := os.MkdirAll(h.config.WorkdirPath, 0775); err != nil {
+		return err
+	}
+	return helper_stage.Clean(h.config.WorkdirPath, stagePrefix, exportPrefix)
+}
+
+// Export writes a gzip compressed tar archive containing the backup file and the module and deployment files to a
+// temporary file in the working directory. The returned file is removed on close, thus the archive can be transferred
+// after locks preventing changes have been released.
+func (h *Handler) Export(ctx context.Context) (io.ReadCloser, error) {
+	file, err := os.CreateTemp(h.config.WorkdirPath, exportPrefix)
+	if err != nil {
+		logger.ErrorContext(ctx, "export backup, create file", slog_keys.Error, err)
+		return nil, err
+	}
+	exportFile := &tempFile{File: file}
+	if err = h.export(ctx, file); err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		if e := exportFile.Close(); e != nil {
+			logger.ErrorContext(ctx, "export backup, remove file", slog_keys.Error, e)
+		}
+		return nil, err
+	}
+	return exportFile, nil
+}
+
+// export writes the backup archive to w. The database and repository definitions are read before writing, thus errors
+// occurring afterward are caused by reading files or writing to w.
+func (h *Handler) export(ctx context.Context, w io.Writer) error {
+	backup := pkg_models.Backup{
+		Version: pkg_models.BackupVersion,
+		Created: time.Now().UTC(),
+	}
+	var err error
+	backup.Tables, err = h.databaseHandler.ExportTables(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "export backup, read database", slog_keys.Error, err)
+		return err
+	}
+	backup.Repositories, err = h.repositoriesHandler.GetRepositoryDefinitions(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "export backup, get repository definitions", slog_keys.Error, err)
+		return err
+	}
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return err
+	}
+	tarGzWriter := helper_archive.NewTarGzWriter(w)
+	if err = tarGzWriter.AddFile(backupFile, 0664, data); err != nil {
+		logger.ErrorContext(ctx, "export backup, write backup file", slog_keys.Error, err)
+		return err
+	}
+	if err = tarGzWriter.AddDir(modulesDir, h.config.ModulesWorkdirPath); err != nil {
+		logger.ErrorContext(ctx, "export backup, write module files", slog_keys.Error, err)
+		return err
+	}
+	if err = tarGzWriter.AddDir(deploymentsDir, h.config.DeploymentsWorkdirPath); err != nil {
+		logger.ErrorContext(ctx, "export backup, write deployment files", slog_keys.Error, err)
+		return err
+	}
+	if err = tarGzWriter.Close(); err != nil {
+		logger.ErrorContext(ctx, "export backup", slog_keys.Error, err)
+		return err
+	}
+	return nil
+}
+
+// Stage extracts a backup archive to the working directory and validates the backup file. The returned ID
+// references the staged backup and must be passed to Restore or Discard.
+func (h *Handler) Stage(ctx context.Context, r io.Reader) (string, error) {
+	id, stagePath, err := h.stageArea.Create()
+	if err != nil {
+		logger.ErrorContext(ctx, "stage backup, create directory", slog_keys.Error, err)
+		return "", err
+	}
+	if _, err = helper_archive.ExtractTarGz(r, stagePath, h.config.ArchiveLimits); err == nil {
+		_, err = readBackupFile(stagePath)
+	}
+	if err != nil {
+		if e := os.RemoveAll(stagePath); e != nil {
+			logger.ErrorContext(ctx, "stage backup, remove directory", slog_keys.Error, e)
+		}
+		return "", lib_errors.Wrap[lib_errors.ErrInvalidInput](fmt.Errorf("invalid backup: %w", err))
+	}
+	return id, nil
+}
+
+// Restore copies the module and deployment files of a staged backup to the respective working directories, imports
+// the database tables and recreates the repositories. Existing files are not overwritten and the database tables
+// must be empty. Copied files are removed if the files or the database can't be restored. Repositories that can't be
+// recreated do not cause an error and are reported via the returned results. The staged backup is removed in any
+// case.
+func (h *Handler) Restore(ctx context.Context, id string) ([]lib_models.RepositoryResult, error) {
+	stagePath, err := h.stageArea.Path(id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(stagePath); err != nil {
+			logger.ErrorContext(ctx, "restore backup, remove staged backup", slog_keys.Error, err)
+		}
+	}()
+	backup, err := readBackupFile(stagePath)
+	if err != nil {
+		return nil, lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	copied, err := h.copyFiles(stagePath)
+	if err == nil {
+		err = h.databaseHandler.ImportTables(ctx, backup.Tables)
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "restore backup", slog_keys.Error, err)
+		var errs []error
+		for _, p := range copied {
+			if e := os.RemoveAll(p); e != nil {
+				errs = append(errs, e)
+			}
+		}
+		if len(errs) > 0 {
+			return nil, helper_errors.Join(append([]error{err}, errs...)...)
+		}
+		return nil, err
+	}
+	var results []lib_models.RepositoryResult
+	for _, definition := range backup.Repositories {
+		result := lib_models.RepositoryResult{
+			Type:   definition.Type,
+			Source: definition.Source,
+		}
+		err = h.repositoriesHandler.CreateRepository(ctx, definition.Type, definition.Data)
+		if err != nil && !lib_errors.IsOf[lib_errors.ErrExists](err) {
+			result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Discard removes a staged backup.
+func (h *Handler) Discard(ctx context.Context, id string) error {
+	if err := h.stageArea.Remove(id); err != nil {
+		logger.ErrorContext(ctx, "discard backup", slog_keys.Error, err)
+		return err
+	}
+	return nil
+}
+
+// copyFiles copies the top level entries of the module and deployment directories and returns the paths of the
+// copied entries.
+func (h *Handler) copyFiles(stagePath string) ([]string, error) {
+	var copied []string
+	for dir, dstPath := range map[string]string{
+		modulesDir:     h.config.ModulesWorkdirPath,
+		deploymentsDir: h.config.DeploymentsWorkdirPath,
+	} {
+		dirEntries, err := os.ReadDir(path.Join(stagePath, dir))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return copied, err
+		}
+		for _, dirEntry := range dirEntries {
+			entryDstPath := path.Join(dstPath, dirEntry.Name())
+			if _, err = os.Stat(entryDstPath); err == nil {
+				return copied, lib_errors.New[lib_errors.ErrExists](fmt.Sprintf("'%s' already exists", entryDstPath))
+			}
+			copied = append(copied, entryDstPath)
+			if dirEntry.IsDir() {
+				err = helper_file_sys.CopyAll(os.DirFS(path.Join(stagePath, dir, dirEntry.Name())), entryDstPath)
+			} else {
+				err = helper_file_sys.CopyFile(os.DirFS(path.Join(stagePath, dir)), entryDstPath, dirEntry.Name())
+			}
+			if err != nil {
+				return copied, err
+			}
+		}
+	}
+	return copied, nil
+}
+
+func readBackupFile(stagePath string) (pkg_models.Backup, error) {
+	data, err := os.ReadFile(path.Join(stagePath, backupFile))
+	if err != nil {
+		return pkg_models.Backup{}, err
+	}
+	// numbers are decoded as json.Number to preserve integer values
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var backup pkg_models.Backup
+	if err = decoder.Decode(&backup); err != nil {
+		return pkg_models.Backup{}, err
+	}
+	if backup.Version != pkg_models.BackupVersion {
+		return pkg_models.Backup{}, fmt.Errorf("unsupported backup version %d", backup.Version)
+	}
+	return backup, nil
+}
+
+// tempFile removes the file on close.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	if e := os.Remove(f.Name()); e != nil {
+		return helper_errors.Join(err, e)
+	}
+	return err
+}
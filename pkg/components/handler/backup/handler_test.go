@@ -0,0 +1,181 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func TestHandler_Restore(t *testing.T) {
+	ctx := context.Background()
+	srcConfig := newTestConfig(t)
+	if err := os.MkdirAll(path.Join(srcConfig.ModulesWorkdirPath, "mod/dir"), 0775); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(srcConfig.ModulesWorkdirPath, "mod/dir/Modfile.yml"), []byte("test"), 0664); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(path.Join(srcConfig.DeploymentsWorkdirPath, "dep"), 0775); err != nil {
+		t.Fatal(err)
+	}
+	srcDB := &databaseHandlerMock{Tables: []pkg_models.DatabaseTable{{Name: "modules", Columns: []string{"id"}, Rows: [][]any{{"mod"}}}}}
+	srcRepos := &repositoriesHandlerMock{Definitions: []pkg_models.RepositoryDefinition{
+		{Type: "tarball", Source: "example.com/a", Data: json.RawMessage(`{"url":"a"}`)},
+		{Type: "tarball", Source: "example.com/b", Data: json.RawMessage(`{"url":"b"}`)},
+	}}
+	export, err := New(srcDB, srcRepos, srcConfig).Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err = buf.ReadFrom(export); err != nil {
+		t.Fatal(err)
+	}
+	if err = export.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if dirEntries, err := os.ReadDir(srcConfig.WorkdirPath); err != nil || len(dirEntries) != 0 {
+		t.Errorf("expected exported file to be removed, got %v, %v", dirEntries, err)
+	}
+	dstConfig := newTestConfig(t)
+	dstDB := &databaseHandlerMock{}
+	dstRepos := &repositoriesHandlerMock{Exists: map[string]bool{`{"url":"b"}`: true}}
+	h := New(dstDB, dstRepos, dstConfig)
+	id, err := h.Stage(ctx, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := h.Restore(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].HasError || results[1].HasError {
+		t.Errorf("unexpected results %+v", results)
+	}
+	if len(dstRepos.Created) != 1 {
+		t.Errorf("expected 1 created repository, got %d", len(dstRepos.Created))
+	}
+	if len(dstDB.Tables) != 1 || len(dstDB.Tables[0].Rows) != 1 || dstDB.Tables[0].Rows[0][0] != "mod" {
+		t.Errorf("unexpected tables %+v", dstDB.Tables)
+	}
+	b, err := os.ReadFile(path.Join(dstConfig.ModulesWorkdirPath, "mod/dir/Modfile.yml"))
+	if err != nil {
+		t.Error(err)
+	}
+	if string(b) != "test" {
+		t.Errorf("expected test, got %s", string(b))
+	}
+	if _, err = os.Stat(path.Join(dstConfig.DeploymentsWorkdirPath, "dep")); err != nil {
+		t.Error(err)
+	}
+	if _, err = os.Stat(path.Join(dstConfig.WorkdirPath, id)); !os.IsNotExist(err) {
+		t.Error("expected staged backup to be removed")
+	}
+	t.Run("existing files", func(t *testing.T) {
+		id, err = h.Stage(ctx, bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = h.Restore(ctx, id); err == nil {
+			t.Error("expected error")
+		}
+		if _, err = os.Stat(path.Join(dstConfig.ModulesWorkdirPath, "mod/dir/Modfile.yml")); err != nil {
+			t.Error("expected existing files to be kept")
+		}
+	})
+	t.Run("database error", func(t *testing.T) {
+		h2 := New(&databaseHandlerMock{Err: lib_errors.New[lib_errors.ErrExists]("table not empty")}, dstRepos, newTestConfig(t))
+		id, err = h2.Stage(ctx, bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = h2.Restore(ctx, id); err == nil {
+			t.Error("expected error")
+		}
+		if _, err = os.Stat(path.Join(h2.config.ModulesWorkdirPath, "mod")); !os.IsNotExist(err) {
+			t.Error("expected copied files to be removed")
+		}
+	})
+	t.Run("invalid archive", func(t *testing.T) {
+		if _, err = h.Stage(ctx, bytes.NewReader([]byte("test"))); !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("invalid id", func(t *testing.T) {
+		if _, err = h.Restore(ctx, "../test"); !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func newTestConfig(t *testing.T) Config {
+	tempDir := t.TempDir()
+	config := Config{
+		WorkdirPath:            path.Join(tempDir, "backup"),
+		ModulesWorkdirPath:     path.Join(tempDir, "modules"),
+		DeploymentsWorkdirPath: path.Join(tempDir, "deployments"),
+	}
+	for _, p := range []string{config.WorkdirPath, config.ModulesWorkdirPath, config.DeploymentsWorkdirPath} {
+		if err := os.MkdirAll(p, 0775); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return config
+}
+
+type databaseHandlerMock struct {
+	Tables []pkg_models.DatabaseTable
+	Err    error
+}
+
+func (m *databaseHandlerMock) ExportTables(_ context.Context) ([]pkg_models.DatabaseTable, error) {
+	return m.Tables, nil
+}
+
+func (m *databaseHandlerMock) ImportTables(_ context.Context, tables []pkg_models.DatabaseTable) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Tables = tables
+	return nil
+}
+
+type repositoriesHandlerMock struct {
+	Definitions []pkg_models.RepositoryDefinition
+	Exists      map[string]bool
+	Created     []string
+}
+
+func (m *repositoriesHandlerMock) GetRepositoryDefinitions(_ context.Context) ([]pkg_models.RepositoryDefinition, error) {
+	return m.Definitions, nil
+}
+
+func (m *repositoriesHandlerMock) CreateRepository(_ context.Context, _ string, data []byte) error {
+	if m.Exists[string(data)] {
+		return lib_errors.New[lib_errors.ErrExists]("source already exists")
+	}
+	m.Created = append(m.Created, string(data))
+	return nil
+}
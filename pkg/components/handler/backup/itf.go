@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"context"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+type databaseHandler interface {
+	ExportTables(ctx context.Context) ([]pkg_models.DatabaseTable, error)
+	ImportTables(ctx context.Context, tables []pkg_models.DatabaseTable) error
+}
+
+type repositoriesHandler interface {
+	GetRepositoryDefinitions(ctx context.Context) ([]pkg_models.RepositoryDefinition, error)
+	CreateRepository(ctx context.Context, repositoryType string, data []byte) error
+}
@@ -0,0 +1,283 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bundles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_job "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/job"
+	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_progress "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/progress"
+	helper_stage "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/stage"
+	helper_url "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/url"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+// Bundle archive layout: module directories below the modules directory and exported images (docker or OCI
+// archives) below the images directory. The layout may be located at the archive root or in the single directory at
+// the archive root.
+const (
+	modulesDir  = "modules"
+	imagesDir   = "images"
+	stagePrefix = "import-"
+	archiveFile = "bundle"
+	extractDir  = "extracted"
+)
+
+type Config struct {
+	WorkdirPath     string // uploaded bundles are staged here
+	PathEscapeDepth int
+	JobPollInterval time.Duration
+	MaxUploadSize   int64 // zero disables the limit
+	ArchiveLimits   helper_archive.Limits
+}
+
+type Handler struct {
+	containerEngineWrapperClient containerEngineWrapperClient
+	repositoriesHandler          repositoriesHandler
+	stageArea                    helper_stage.Area
+	config                       Config
+}
+
+func New(
+	containerEngineWrapperClient containerEngineWrapperClient,
+	repositoriesHandler repositoriesHandler,
+	config Config,
+) *Handler {
+	return &Handler{
+		containerEngineWrapperClient: containerEngineWrapperClient,
+		repositoriesHandler:          repositoriesHandler,
+		stageArea:                    helper_stage.New(config.WorkdirPath, stagePrefix, "bundle"),
+		config:                       config,
+	}
+}
+
+// CreateWorkDir creates the working directory and removes bundles staged before a restart.
+func (h *Handler) CreateWorkDir() error {
+	if err := os.MkdirAll(h.config.WorkdirPath, 0775); err != nil {
+		return err
+	}
+	return h.stageArea.Clean()
+}
+
+// Stage extracts a bundle archive (tar.gz or zip) to the working directory and validates the layout. The returned ID
+// references the staged bundle and must be passed to Import or Discard.
+func (h *Handler) Stage(ctx context.Context, r io.Reader) (string, error) {
+	id, stagePath, err := h.stageArea.Create()
+	if err != nil {
+		logger.ErrorContext(ctx, "stage bundle, create directory", slog_keys.Error, err)
+		return "", err
+	}
+	if err = helper_stage.WriteFile(path.Join(stagePath, archiveFile), r, h.config.MaxUploadSize); err != nil {
+		logger.ErrorContext(ctx, "stage bundle, write archive", slog_keys.Error, err)
+		if e := os.RemoveAll(stagePath); e != nil {
+			logger.ErrorContext(ctx, "stage bundle, remove directory", slog_keys.Error, e)
+		}
+		return "", err
+	}
+	_, err = helper_archive.ExtractFile(path.Join(stagePath, archiveFile), path.Join(stagePath, extractDir), h.config.ArchiveLimits)
+	if err == nil {
+		_, err = getBundlePath(path.Join(stagePath, extractDir))
+	}
+	if e := os.Remove(path.Join(stagePath, archiveFile)); e != nil {
+		logger.ErrorContext(ctx, "stage bundle, remove archive", slog_keys.Error, e)
+	}
+	if err != nil {
+		if e := os.RemoveAll(stagePath); e != nil {
+			logger.ErrorContext(ctx, "stage bundle, remove directory", slog_keys.Error, e)
+		}
+		return "", lib_errors.Wrap[lib_errors.ErrInvalidInput](fmt.Errorf("invalid bundle: %w", err))
+	}
+	return id, nil
+}
+
+// Import loads the images of a staged bundle via the container engine wrapper and afterward stores the modules in the
+// channel of the repository for module packages. Images are loaded first so that installing a module does not require
+// a registry. Images and modules that can't be imported do not cause an error and are reported via the returned
+// results, the same applies to images of modules neither included in the bundle nor available locally. The staged
+// bundle is removed in any case.
+func (h *Handler) Import(ctx context.Context, id, channel string) ([]lib_models.BundleImageResult, []lib_models.BundleModuleResult, error) {
+	stagePath, err := h.stageArea.Path(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(stagePath); err != nil {
+			logger.ErrorContext(ctx, "import bundle, remove staged bundle", slog_keys.Error, err)
+		}
+	}()
+	bundlePath, err := getBundlePath(path.Join(stagePath, extractDir))
+	if err != nil {
+		return nil, nil, lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	imageFiles, err := readDirNames(path.Join(bundlePath, imagesDir), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	modDirs, err := readDirNames(path.Join(bundlePath, modulesDir), true)
+	if err != nil {
+		return nil, nil, err
+	}
+	helper_progress.SetSteps(ctx, len(imageFiles)+len(modDirs))
+	var imageResults []lib_models.BundleImageResult
+	for _, name := range imageFiles {
+		helper_progress.NextStep(ctx, fmt.Sprintf("loading image '%s'", name))
+		result := lib_models.BundleImageResult{File: name}
+		if err = h.loadImage(ctx, path.Join(bundlePath, imagesDir, name)); err != nil {
+			if ctx.Err() != nil {
+				return imageResults, nil, err
+			}
+			logger.ErrorContext(ctx, "import bundle, load image", slog_keys.Name, name, slog_keys.Error, err)
+			result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		imageResults = append(imageResults, result)
+	}
+	var moduleResults []lib_models.BundleModuleResult
+	for _, name := range modDirs {
+		helper_progress.NextStep(ctx, fmt.Sprintf("adding module '%s'", name))
+		if ctx.Err() != nil {
+			return imageResults, moduleResults, ctx.Err()
+		}
+		moduleResults = append(moduleResults, h.addModule(ctx, path.Join(bundlePath, modulesDir, name), channel))
+	}
+	return imageResults, moduleResults, nil
+}
+
+// Discard removes a staged bundle.
+func (h *Handler) Discard(ctx context.Context, id string) error {
+	if err := h.stageArea.Remove(id); err != nil {
+		logger.ErrorContext(ctx, "discard bundle", slog_keys.Error, err)
+		return err
+	}
+	return nil
+}
+
+func (h *Handler) loadImage(ctx context.Context, filePath string) error {
+	loader, ok := h.containerEngineWrapperClient.(imageLoader)
+	if !ok {
+		return errors.New("loading images not supported by container engine wrapper client")
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	jobId, err := loader.LoadImage(ctx, file)
+	if err != nil {
+		return err
+	}
+	job, err := helper_job.Await(ctx, h.containerEngineWrapperClient, jobId, h.config.JobPollInterval)
+	if err != nil {
+		return err
+	}
+	if job.Error != nil {
+		return errors.New(job.Error.Message)
+	}
+	return nil
+}
+
+func (h *Handler) addModule(ctx context.Context, modPath, channel string) lib_models.BundleModuleResult {
+	result := lib_models.BundleModuleResult{Id: path.Base(modPath)}
+	mod, err := helper_modfile.GetModule(os.DirFS(modPath))
+	if err != nil {
+		result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		return result
+	}
+	result.Id = mod.ID
+	repoMod, err := h.repositoriesHandler.AddModulePackageFS(ctx, channel, os.DirFS(modPath))
+	if err != nil {
+		result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		return result
+	}
+	result.Source = repoMod.Source
+	result.Channel = repoMod.Channel
+	result.MissingImages, err = h.getMissingImages(ctx, getModuleServiceImages(mod.Services))
+	if err != nil {
+		result.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("check images: %s", err))
+	}
+	return result
+}
+
+func (h *Handler) getMissingImages(ctx context.Context, images []string) ([]string, error) {
+	var missing []string
+	for _, image := range images {
+		_, err := h.containerEngineWrapperClient.GetImage(ctx, helper_url.EscapePath(image, h.config.PathEscapeDepth))
+		if err != nil {
+			var notFoundErr *external_models.CewNotFoundErr
+			if !errors.As(err, &notFoundErr) {
+				return missing, err
+			}
+			missing = append(missing, image)
+		}
+	}
+	return missing, nil
+}
+
+// getBundlePath returns the directory containing the modules directory, either the archive root or the single
+// directory at the archive root.
+func getBundlePath(extractPath string) (string, error) {
+	bundlePath, ok, err := helper_stage.FindRoot(extractPath, func(dirPath string) bool {
+		info, err := os.Lstat(path.Join(dirPath, modulesDir))
+		return err == nil && info.IsDir()
+	})
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("'%s' directory not found at archive root", modulesDir)
+	}
+	return bundlePath, nil
+}
+
+// readDirNames returns the sorted names of the directories or regular files in a directory. A missing directory
+// yields no names.
+func readDirNames(dirPath string, dirs bool) ([]string, error) {
+	dirEntries, err := os.ReadDir(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, dirEntry := range dirEntries {
+		if (dirs && dirEntry.IsDir()) || (!dirs && dirEntry.Type().IsRegular()) {
+			names = append(names, dirEntry.Name())
+		}
+	}
+	return names, nil
+}
+
+func getModuleServiceImages(services map[string]external_models.ModuleLibService) []string {
+	images := make(map[string]struct{})
+	for _, service := range services {
+		images[service.Image] = struct{}{}
+	}
+	return slices.Sorted(maps.Keys(images))
+}
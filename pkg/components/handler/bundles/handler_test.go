@@ -0,0 +1,234 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bundles
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"testing"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_stage "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/stage"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+const testModfile = `modfileVersion: "v1"
+id: github.com/org/%s
+name: Test Module
+description: Module for tests.
+license: Apache-2.0 license
+author: Tester
+version: v1.0.0
+type: add-on
+deploymentType: single
+services:
+  test-srv:
+    name: Test Service
+    image: %s
+`
+
+func newTestBundle(t *testing.T, files map[string]string) *bytes.Buffer {
+	var buf bytes.Buffer
+	w := helper_archive.NewTarGzWriter(&buf)
+	for name, data := range files {
+		if err := w.AddFile(name, 0664, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func newTestHandler(t *testing.T) (*Handler, *cewClientMock, *repositoriesHandlerMock) {
+	mockCew := &cewClientMock{Images: make(map[string]struct{})}
+	mockRepos := &repositoriesHandlerMock{}
+	config := Config{
+		WorkdirPath:     t.TempDir(),
+		JobPollInterval: time.Millisecond,
+	}
+	h := &Handler{
+		containerEngineWrapperClient: mockCew,
+		repositoriesHandler:          mockRepos,
+		stageArea:                    helper_stage.New(config.WorkdirPath, stagePrefix, "bundle"),
+		config:                       config,
+	}
+	if err := h.CreateWorkDir(); err != nil {
+		t.Fatal(err)
+	}
+	return h, mockCew, mockRepos
+}
+
+func TestHandler_Import(t *testing.T) {
+	h, mockCew, mockRepos := newTestHandler(t)
+	id, err := h.Stage(context.Background(), newTestBundle(t, map[string]string{
+		"bundle/modules/mod-a/Modfile.yml": fmt.Sprintf(testModfile, "mod-a", "ghcr.io/org/mod-a:test"),
+		"bundle/modules/mod-b/Modfile.yml": fmt.Sprintf(testModfile, "mod-b", "ghcr.io/org/mod-b:test"),
+		"bundle/images/mod-a.tar":          "ghcr.io/org/mod-a:test",
+		"bundle/images/broken.tar":         "fail",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imageResults, moduleResults, err := h.Import(context.Background(), id, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imageResults) != 2 || !imageResults[0].HasError || imageResults[0].File != "broken.tar" || imageResults[1].HasError {
+		t.Errorf("unexpected image results %+v", imageResults)
+	}
+	if _, ok := mockCew.Images["ghcr.io/org/mod-a:test"]; !ok {
+		t.Error("expected image to be loaded")
+	}
+	if len(moduleResults) != 2 {
+		t.Fatalf("expected 2 module results, got %d", len(moduleResults))
+	}
+	if moduleResults[0].Id != "github.com/org/mod-a" || moduleResults[0].HasError || len(moduleResults[0].MissingImages) != 0 {
+		t.Errorf("unexpected module result %+v", moduleResults[0])
+	}
+	if moduleResults[1].Id != "github.com/org/mod-b" || len(moduleResults[1].MissingImages) != 1 {
+		t.Errorf("unexpected module result %+v", moduleResults[1])
+	}
+	if len(mockRepos.Added) != 2 || mockRepos.Added[0] != "test/github.com/org/mod-a" {
+		t.Errorf("unexpected added modules %v", mockRepos.Added)
+	}
+	if _, err = os.Stat(h.config.WorkdirPath + "/" + id); !os.IsNotExist(err) {
+		t.Error("expected staged bundle to be removed")
+	}
+	t.Run("not staged", func(t *testing.T) {
+		_, _, err = h.Import(context.Background(), id, "test")
+		if !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func TestHandler_Import_NoImageLoader(t *testing.T) {
+	h, mockCew, mockRepos := newTestHandler(t)
+	h.containerEngineWrapperClient = struct{ containerEngineWrapperClient }{mockCew}
+	id, err := h.Stage(context.Background(), newTestBundle(t, map[string]string{
+		"modules/mod-a/Modfile.yml": fmt.Sprintf(testModfile, "mod-a", "ghcr.io/org/mod-a:test"),
+		"images/mod-a.tar":          "ghcr.io/org/mod-a:test",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imageResults, moduleResults, err := h.Import(context.Background(), id, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imageResults) != 1 || !imageResults[0].HasError {
+		t.Errorf("unexpected image results %+v", imageResults)
+	}
+	if len(moduleResults) != 1 || len(moduleResults[0].MissingImages) != 1 || len(mockRepos.Added) != 1 {
+		t.Errorf("unexpected module results %+v", moduleResults)
+	}
+}
+
+func TestHandler_Stage(t *testing.T) {
+	h, _, _ := newTestHandler(t)
+	t.Run("missing modules directory", func(t *testing.T) {
+		_, err := h.Stage(context.Background(), newTestBundle(t, map[string]string{"images/test.tar": "test"}))
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("invalid archive", func(t *testing.T) {
+		_, err := h.Stage(context.Background(), bytes.NewBufferString("test"))
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("discard", func(t *testing.T) {
+		id, err := h.Stage(context.Background(), newTestBundle(t, map[string]string{"modules/test/Modfile.yml": "test"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = h.Discard(context.Background(), id); err != nil {
+			t.Error(err)
+		}
+		if err = h.Discard(context.Background(), "../"+id); !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+	dirEntries, err := os.ReadDir(h.config.WorkdirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirEntries) != 0 {
+		t.Errorf("expected empty working directory, got %d entries", len(dirEntries))
+	}
+}
+
+type cewClientMock struct {
+	Images map[string]struct{}
+	Jobs   []string
+}
+
+func (m *cewClientMock) GetImage(_ context.Context, id string) (external_models.CewImage, error) {
+	if _, ok := m.Images[id]; !ok {
+		return external_models.CewImage{}, &external_models.CewNotFoundErr{}
+	}
+	return external_models.CewImage{}, nil
+}
+
+func (m *cewClientMock) GetJob(_ context.Context, id string) (external_models.JobLibJob, error) {
+	timestamp := time.Now().UTC()
+	return external_models.JobLibJob{ID: id, Completed: &timestamp}, nil
+}
+
+func (m *cewClientMock) CancelJob(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *cewClientMock) LoadImage(_ context.Context, r io.Reader) (string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	if string(b) == "fail" {
+		return "", errors.New("invalid archive")
+	}
+	m.Images[string(b)] = struct{}{}
+	m.Jobs = append(m.Jobs, string(b))
+	return fmt.Sprintf("%d", len(m.Jobs)), nil
+}
+
+type repositoriesHandlerMock struct {
+	Added []string
+}
+
+func (m *repositoriesHandlerMock) AddModulePackageFS(_ context.Context, channel string, fSys fs.FS) (pkg_models.RepositoryModule, error) {
+	mod, err := helper_modfile.GetModule(fSys)
+	if err != nil {
+		return pkg_models.RepositoryModule{}, err
+	}
+	m.Added = append(m.Added, channel+"/"+mod.ID)
+	return pkg_models.RepositoryModule{
+		RepositoryModuleBase: pkg_models.RepositoryModuleBase{Id: mod.ID, Source: "uploads", Channel: channel},
+	}, nil
+}
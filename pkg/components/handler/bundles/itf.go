@@ -0,0 +1,42 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bundles
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+type repositoriesHandler interface {
+	AddModulePackageFS(ctx context.Context, channel string, fSys fs.FS) (pkg_models.RepositoryModule, error)
+}
+
+type containerEngineWrapperClient interface {
+	GetImage(ctx context.Context, id string) (external_models.CewImage, error)
+	GetJob(ctx context.Context, id string) (external_models.JobLibJob, error)
+	CancelJob(ctx context.Context, id string) error
+}
+
+// imageLoader is implemented by container engine wrapper clients that support loading image archives. Pinned client
+// releases not providing LoadImage are detected at runtime instead of breaking the build.
+type imageLoader interface {
+	LoadImage(ctx context.Context, r io.Reader) (jobId string, err error)
+}
@@ -51,8 +51,8 @@ func (h *Handler) CreateAuxiliaryDeployment(
 		auxiliaryDeployment.Id,
 		auxiliaryDeployment.DeploymentId,
 		auxiliaryDeployment.Image,
-		auxiliaryDeployment.Created,
-		auxiliaryDeployment.Updated,
+		timeValue(auxiliaryDeployment.Created),
+		timeValue(auxiliaryDeployment.Updated),
 		auxiliaryDeployment.Reference,
 		auxiliaryDeployment.Name,
 		auxiliaryDeployment.Enabled,
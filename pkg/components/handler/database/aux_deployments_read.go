@@ -320,7 +320,7 @@ func (h *Handler) ReadAuxiliaryDeploymentsVolumeMounts(
 	return auxDepsVolumeMounts, nil
 }
 
-const selectAuxDeploymentsByParentStmt = `SELECT deployments.id AS dep_id, deployments.enabled AS dep_enabled, aux_deployments.id, aux_deployments.enabled, aux_deployments.ctr_name, aux_deployments.ctr_alias
+const selectAuxDeploymentsByParentStmt = `SELECT deployments.id AS dep_id, deployments.mod_id AS dep_mod_id, deployments.enabled AS dep_enabled, aux_deployments.id, aux_deployments.ref, aux_deployments.enabled, aux_deployments.ctr_name, aux_deployments.ctr_alias
 FROM aux_deployments 
 LEFT JOIN deployments ON aux_deployments.dep_id = deployments.id`
 
@@ -339,12 +339,15 @@ func (h *Handler) ReadAuxDeploymentsByParent(ctx context.Context) (
 	auxDepsByParent := make(map[string]pkg_models.AuxiliaryDeploymentParent)
 	for rows.Next() {
 		var parentId string
+		var parentModuleId string
 		var parentEnabled bool
 		var auxDep pkg_models.AuxiliaryDeployment
 		err = rows.Scan(
 			&parentId,
+			&parentModuleId,
 			&parentEnabled,
 			&auxDep.Id,
+			&auxDep.Reference,
 			&auxDep.Enabled,
 			&auxDep.Container.Name,
 			&auxDep.Container.Alias,
@@ -352,9 +355,11 @@ func (h *Handler) ReadAuxDeploymentsByParent(ctx context.Context) (
 		if err != nil {
 			return nil, err
 		}
+		auxDep.DeploymentId = parentId
 		auxDepParent, ok := auxDepsByParent[parentId]
 		if !ok {
 			auxDepParent.Id = parentId
+			auxDepParent.ModuleId = parentModuleId
 			auxDepParent.Enabled = parentEnabled
 			auxDepParent.AuxiliaryDeployments = make(map[string]pkg_models.AuxiliaryDeployment)
 			auxDepsByParent[parentId] = auxDepParent
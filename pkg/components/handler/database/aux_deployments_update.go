@@ -48,7 +48,7 @@ func (h *Handler) UpdateAuxiliaryDeployment(
 		ctx,
 		"UPDATE aux_deployments SET image = ?, updated = ?, ref = ?, name = ?, ctr_name = ?, ctr_alias = ?, recreate = ?, command = ?, pseudo_tty = ? WHERE dep_id = ? AND id = ?",
 		auxiliaryDeployment.Image,
-		auxiliaryDeployment.Updated,
+		timeValue(auxiliaryDeployment.Updated),
 		auxiliaryDeployment.Reference,
 		auxiliaryDeployment.Name,
 		auxiliaryDeployment.Container.Name,
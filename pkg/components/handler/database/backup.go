@@ -0,0 +1,267 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+const (
+	colString = iota
+	colInt
+	colFloat
+	colBool
+	colBytes
+)
+
+type backupColumn struct {
+	name string
+	kind int
+}
+
+type backupTable struct {
+	name    string
+	columns []backupColumn
+}
+
+// backupTables defines the tables included in backups, ordered by foreign key dependencies. Jobs, job results and
+// runtime events are not included.
+var backupTables = []backupTable{
+	{"global_configs", []backupColumn{{"id", colString}, {"name", colString}, {"data_type", colInt}, {"is_list", colBool}}},
+	{"global_config_values", []backupColumn{{"c_id", colString}, {"v_string", colString}, {"v_int", colInt}, {"v_float", colFloat}, {"v_bool", colBool}, {"ord", colInt}}},
+	{"modules", []backupColumn{{"id", colString}, {"dir", colString}, {"source", colString}, {"channel", colString}, {"added", colString}, {"updated", colString}}},
+	{"mod_version_constraints", []backupColumn{{"mod_id", colString}, {"ver_constraint", colString}, {"updated", colString}}},
+	{"deployments", []backupColumn{{"id", colString}, {"mod_id", colString}, {"name", colString}, {"mod_source", colString}, {"mod_channel", colString}, {"mod_ver", colString}, {"dir", colString}, {"files_dir", colString}, {"enabled", colBool}, {"created", colString}, {"updated", colString}}},
+	{"dep_containers", []backupColumn{{"dep_id", colString}, {"name", colString}, {"srv_ref", colString}, {"alias", colString}}},
+	{"dep_volumes", []backupColumn{{"dep_id", colString}, {"ref", colString}, {"name", colString}}},
+	{"dep_host_resources", []backupColumn{{"dep_id", colString}, {"ref", colString}, {"res_id", colString}}},
+	{"dep_secrets", []backupColumn{{"dep_id", colString}, {"ref", colString}, {"sec_id", colString}, {"item", colString}, {"as_mount", colBool}, {"as_env", colBool}}},
+	{"dep_configs", []backupColumn{{"id", colString}, {"dep_id", colString}, {"ref", colString}, {"data_type", colInt}, {"is_list", colBool}}},
+	{"dep_config_values", []backupColumn{{"c_id", colString}, {"v_string", colString}, {"v_int", colInt}, {"v_float", colFloat}, {"v_bool", colBool}, {"ord", colInt}}},
+	{"dep_global_configs", []backupColumn{{"dep_id", colString}, {"ref", colString}, {"c_id", colString}}},
+	{"dep_files", []backupColumn{{"dep_id", colString}, {"ref", colString}, {"data", colBytes}}},
+	{"dep_file_groups", []backupColumn{{"id", colString}, {"dep_id", colString}, {"ref", colString}}},
+	{"dep_file_group_files", []backupColumn{{"g_id", colString}, {"path", colString}, {"format", colString}, {"data", colBytes}}},
+	{"dep_dependency_targets", []backupColumn{{"dep_id", colString}, {"mod_id", colString}, {"target_dep_id", colString}}},
+	{"dep_snapshots", []backupColumn{{"id", colString}, {"dep_id", colString}, {"mod_id", colString}, {"mod_source", colString}, {"mod_channel", colString}, {"mod_ver", colString}, {"user_input", colString}, {"containers", colString}, {"created", colString}}},
+	{"aux_deployments", []backupColumn{{"id", colString}, {"dep_id", colString}, {"image", colString}, {"created", colString}, {"updated", colString}, {"ref", colString}, {"name", colString}, {"enabled", colBool}, {"ctr_name", colString}, {"ctr_alias", colString}, {"recreate", colBool}, {"command", colString}, {"pseudo_tty", colBool}}},
+	{"aux_dep_labels", []backupColumn{{"aux_dep_id", colString}, {"name", colString}, {"value", colString}}},
+	{"aux_dep_configs", []backupColumn{{"aux_dep_id", colString}, {"name", colString}, {"value", colString}}},
+	{"aux_dep_volumes", []backupColumn{{"id", colString}, {"dep_id", colString}, {"ref", colString}, {"name", colString}}},
+	{"aux_dep_volume_mounts", []backupColumn{{"vol_id", colString}, {"aux_dep_id", colString}, {"mnt_path", colString}}},
+	{"dep_advertisements", []backupColumn{{"id", colString}, {"dep_id", colString}, {"mod_id", colString}, {"ref", colString}, {"timestamp", colString}}},
+	{"dep_adv_items", []backupColumn{{"dep_adv_id", colString}, {"item_key", colString}, {"item_value", colString}}},
+}
+
+// ExportTables reads all rows of the tables included in backups. Timestamps are exported as stored, binary data as
+// byte slices.
+func (h *Handler) ExportTables(ctx context.Context) ([]pkg_models.DatabaseTable, error) {
+	tx, err := h.sqlDB.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var tables []pkg_models.DatabaseTable
+	for _, bTable := range backupTables {
+		table, err := exportTable(ctx, tx, bTable)
+		if err != nil {
+			return nil, fmt.Errorf("export table '%s': %w", bTable.name, err)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// ImportTables inserts the rows of the provided tables in a single transaction. Only tables included in backups
+// are accepted and the tables must be empty.
+func (h *Handler) ImportTables(ctx context.Context, tables []pkg_models.DatabaseTable) error {
+	tablesMap := make(map[string]pkg_models.DatabaseTable)
+	for _, table := range tables {
+		if !slices.ContainsFunc(backupTables, func(bTable backupTable) bool {
+			return bTable.name == table.Name
+		}) {
+			return lib_errors.New[lib_errors.ErrInvalidInput](fmt.Sprintf("unknown table '%s'", table.Name))
+		}
+		tablesMap[table.Name] = table
+	}
+	tx, err := h.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, bTable := range backupTables {
+		var count int
+		if err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+bTable.name+";").Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			return lib_errors.New[lib_errors.ErrExists](fmt.Sprintf("table '%s' not empty", bTable.name))
+		}
+		table, ok := tablesMap[bTable.name]
+		if !ok {
+			continue
+		}
+		if err = importTable(ctx, tx, bTable, table); err != nil {
+			return fmt.Errorf("import table '%s': %w", bTable.name, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func exportTable(ctx context.Context, tx *sql.Tx, bTable backupTable) (pkg_models.DatabaseTable, error) {
+	table := pkg_models.DatabaseTable{Name: bTable.name}
+	for _, column := range bTable.columns {
+		table.Columns = append(table.Columns, column.name)
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT "+strings.Join(table.Columns, ", ")+" FROM "+bTable.name+";")
+	if err != nil {
+		return pkg_models.DatabaseTable{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		dest := make([]any, len(bTable.columns))
+		for i, column := range bTable.columns {
+			switch column.kind {
+			case colInt:
+				dest[i] = &sql.NullInt64{}
+			case colFloat:
+				dest[i] = &sql.NullFloat64{}
+			case colBool:
+				dest[i] = &sql.NullBool{}
+			case colBytes:
+				dest[i] = &[]byte{}
+			default:
+				dest[i] = &sql.NullString{}
+			}
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return pkg_models.DatabaseTable{}, err
+		}
+		row := make([]any, len(dest))
+		for i, d := range dest {
+			switch v := d.(type) {
+			case *sql.NullInt64:
+				if v.Valid {
+					row[i] = v.Int64
+				}
+			case *sql.NullFloat64:
+				if v.Valid {
+					row[i] = v.Float64
+				}
+			case *sql.NullBool:
+				if v.Valid {
+					row[i] = v.Bool
+				}
+			case *[]byte:
+				if *v != nil {
+					row[i] = *v
+				}
+			case *sql.NullString:
+				if v.Valid {
+					row[i] = v.String
+				}
+			}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	if err = rows.Err(); err != nil {
+		return pkg_models.DatabaseTable{}, err
+	}
+	return table, nil
+}
+
+func importTable(ctx context.Context, tx *sql.Tx, bTable backupTable, table pkg_models.DatabaseTable) error {
+	var columns []string
+	for _, column := range bTable.columns {
+		columns = append(columns, column.name)
+	}
+	if !slices.Equal(columns, table.Columns) {
+		return lib_errors.New[lib_errors.ErrInvalidInput]("columns mismatch")
+	}
+	stmt, err := tx.PrepareContext(
+		ctx,
+		"INSERT INTO "+bTable.name+" ("+strings.Join(columns, ", ")+") VALUES ("+genQuestionMarks(len(columns))+");",
+	)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for i, row := range table.Rows {
+		if len(row) != len(bTable.columns) {
+			return lib_errors.New[lib_errors.ErrInvalidInput](fmt.Sprintf("row %d: invalid number of values", i))
+		}
+		args := make([]any, len(row))
+		for j, value := range row {
+			args[j], err = getImportValue(value, bTable.columns[j].kind)
+			if err != nil {
+				return lib_errors.Wrap[lib_errors.ErrInvalidInput](fmt.Errorf("row %d: column '%s': %w", i, bTable.columns[j].name, err))
+			}
+		}
+		if _, err = stmt.ExecContext(ctx, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getImportValue converts values of exported rows and values decoded from JSON with json.Decoder.UseNumber.
+func getImportValue(value any, kind int) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch kind {
+	case colInt:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case json.Number:
+			return v.Int64()
+		}
+	case colFloat:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case json.Number:
+			return v.Float64()
+		}
+	case colBool:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case colBytes:
+		switch v := value.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			return base64.StdEncoding.DecodeString(v)
+		}
+	default:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid value type %T", value)
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants"
+)
+
+func TestHandler_ExportTables(t *testing.T) {
+	ctx := context.Background()
+	h := newSQLiteHandler(t)
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	err := h.CreateModule(ctx, pkg_models.DatabaseModule{Id: "github.com/org/repo", DirName: "test", Added: timestamp, Updated: timestamp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.CreateGlobalConfig(ctx, pkg_models.Config{Id: "cfg", Name: "test", Value: pkg_models.Value{DataType: constants.ValueDataTypeInt64, Int64: 1 << 60}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.CreateDeployment(
+		ctx,
+		pkg_models.DeploymentBase{Id: "dep", ModuleId: "github.com/org/repo", Enabled: true, Created: timestamp, Updated: timestamp},
+		nil,
+		nil,
+		nil,
+		[]pkg_models.DeploymentGlobalConfig{{Id: "cfg", DeploymentId: "dep", Reference: "ref"}},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte{0, 1, 2, 255}
+	if _, err = h.sqlDB.ExecContext(ctx, "INSERT INTO dep_files (dep_id, ref, data) VALUES (?, ?, ?);", "dep", "file", data); err != nil {
+		t.Fatal(err)
+	}
+	tables, err := h.ExportTables(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var decoded []pkg_models.DatabaseTable
+	if err = decoder.Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	h2 := newSQLiteHandler(t)
+	if err = h2.ImportTables(ctx, decoded); err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := h2.ReadDeployment(ctx, "dep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !deployment.Enabled || !deployment.Created.Equal(timestamp) {
+		t.Errorf("unexpected deployment %+v", deployment)
+	}
+	globalConfigs, err := h2.ReadDeploymentGlobalConfigs(ctx, "dep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(globalConfigs) != 1 {
+		t.Errorf("expected 1 global config, got %d", len(globalConfigs))
+	}
+	globalConfig, err := h2.ReadGlobalConfig(ctx, "cfg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if globalConfig.Value.Int64 != 1<<60 {
+		t.Errorf("expected %d, got %d", int64(1<<60), globalConfig.Value.Int64)
+	}
+	var importedData []byte
+	if err = h2.sqlDB.QueryRowContext(ctx, "SELECT data FROM dep_files WHERE dep_id = ?;", "dep").Scan(&importedData); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(importedData, data) {
+		t.Errorf("expected %v, got %v", data, importedData)
+	}
+	t.Run("not empty", func(t *testing.T) {
+		if err = h2.ImportTables(ctx, decoded); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("unknown table", func(t *testing.T) {
+		if err = newSQLiteHandler(t).ImportTables(ctx, []pkg_models.DatabaseTable{{Name: "jobs"}}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
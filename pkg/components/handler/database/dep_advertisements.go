@@ -200,7 +200,7 @@ func (h *Handler) insertDeploymentAdvertisement(
 		deploymentId,
 		advertisement.ModuleId,
 		advertisement.Reference,
-		advertisement.Timestamp,
+		timeValue(advertisement.Timestamp),
 	)
 	if err != nil {
 		return err
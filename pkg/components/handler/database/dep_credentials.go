@@ -0,0 +1,109 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+// SetDeploymentCredential stores the credential of a deployment, an existing credential is replaced.
+func (h *Handler) SetDeploymentCredential(ctx context.Context, credential pkg_models.DeploymentCredential) error {
+	tx, err := h.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, "DELETE FROM dep_credentials WHERE dep_id = ?;", credential.DeploymentId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO dep_credentials (dep_id, token_hash, created) VALUES (?, ?, ?);",
+		credential.DeploymentId,
+		credential.TokenHash,
+		timeValue(credential.Created),
+	)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (h *Handler) ReadDeploymentCredential(ctx context.Context, deploymentId string) (pkg_models.DeploymentCredential, error) {
+	return h.readDeploymentCredential(ctx, "dep_id = ?", deploymentId)
+}
+
+func (h *Handler) ReadDeploymentCredentialByTokenHash(ctx context.Context, tokenHash string) (pkg_models.DeploymentCredential, error) {
+	return h.readDeploymentCredential(ctx, "token_hash = ?", tokenHash)
+}
+
+func (h *Handler) DeleteDeploymentCredential(ctx context.Context, deploymentId string) error {
+	_, err := h.sqlDB.ExecContext(ctx, "DELETE FROM dep_credentials WHERE dep_id = ?;", deploymentId)
+	return err
+}
+
+// ReadDeploymentsWithoutCredential returns the IDs of deployments without a stored credential and the IDs of the
+// respective modules as {deploymentId:moduleId}.
+func (h *Handler) ReadDeploymentsWithoutCredential(ctx context.Context) (map[string]string, error) {
+	rows, err := h.sqlDB.QueryContext(
+		ctx,
+		"SELECT deployments.id, deployments.mod_id FROM deployments LEFT JOIN dep_credentials ON deployments.id = dep_credentials.dep_id WHERE dep_credentials.dep_id IS NULL;",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	deployments := make(map[string]string)
+	for rows.Next() {
+		var deploymentId, moduleId string
+		if err = rows.Scan(&deploymentId, &moduleId); err != nil {
+			return nil, err
+		}
+		deployments[deploymentId] = moduleId
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return deployments, nil
+}
+
+func (h *Handler) readDeploymentCredential(ctx context.Context, fc string, arg any) (pkg_models.DeploymentCredential, error) {
+	var credential pkg_models.DeploymentCredential
+	var ct []uint8
+	err := h.sqlDB.QueryRowContext(
+		ctx,
+		"SELECT dep_id, token_hash, created FROM dep_credentials WHERE "+fc+";",
+		arg,
+	).Scan(&credential.DeploymentId, &credential.TokenHash, &ct)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkg_models.DeploymentCredential{}, lib_errors.New[lib_errors.ErrNotFound]("deployment credential not found")
+		}
+		return pkg_models.DeploymentCredential{}, err
+	}
+	if credential.Created, err = time.Parse(timeLayout, string(ct)); err != nil {
+		return pkg_models.DeploymentCredential{}, err
+	}
+	return credential, nil
+}
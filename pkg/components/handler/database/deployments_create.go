@@ -34,6 +34,7 @@ func (h *Handler) CreateDeployment(
 	fileGroups []pkg_models.DeploymentFileGroup,
 	volumes []pkg_models.DeploymentVolume,
 	containers []pkg_models.DeploymentContainerBase,
+	dependencyTargets []pkg_models.DeploymentDependencyTarget,
 ) error {
 	tx, err := h.sqlDB.BeginTx(ctx, nil)
 	if err != nil {
@@ -42,17 +43,18 @@ func (h *Handler) CreateDeployment(
 	defer tx.Rollback()
 	_, err = tx.ExecContext(
 		ctx,
-		"INSERT INTO deployments (id, mod_id, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		"INSERT INTO deployments (id, mod_id, name, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		deployment.Id,
 		deployment.ModuleId,
+		deployment.Name,
 		deployment.ModuleSource,
 		deployment.ModuleChannel,
 		deployment.ModuleVersion,
 		deployment.DirName,
 		deployment.FilesDirName,
 		deployment.Enabled,
-		deployment.Created,
-		deployment.Updated,
+		timeValue(deployment.Created),
+		timeValue(deployment.Updated),
 	)
 	if err != nil {
 		return err
@@ -69,6 +71,7 @@ func (h *Handler) CreateDeployment(
 		fileGroups,
 		volumes,
 		containers,
+		dependencyTargets,
 	)
 	if err != nil {
 		return err
@@ -92,6 +95,7 @@ func (h *Handler) createDeploymentResourcesAndConfigs(
 	fileGroups []pkg_models.DeploymentFileGroup,
 	volumes []pkg_models.DeploymentVolume,
 	containers []pkg_models.DeploymentContainerBase,
+	dependencyTargets []pkg_models.DeploymentDependencyTarget,
 ) (err error) {
 	for _, hostResource := range hostResources {
 		_, err = tx.ExecContext(
@@ -205,6 +209,18 @@ func (h *Handler) createDeploymentResourcesAndConfigs(
 			return
 		}
 	}
+	for _, target := range dependencyTargets {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO dep_dependency_targets (dep_id, mod_id, target_dep_id) VALUES (?, ?, ?)",
+			deploymentId,
+			target.ModuleId,
+			target.TargetId,
+		)
+		if err != nil {
+			return
+		}
+	}
 	return
 }
 
@@ -70,5 +70,9 @@ func (h *Handler) deleteDeploymentResourcesAndConfigs(ctx context.Context, tx *s
 		return
 	}
 	_, err = tx.ExecContext(ctx, "DELETE FROM dep_containers WHERE dep_id = ?", deploymentId)
+	if err != nil {
+		return
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM dep_dependency_targets WHERE dep_id = ?", deploymentId)
 	return
 }
@@ -45,7 +45,7 @@ func (h *Handler) ReadDeployments(ctx context.Context, filter pkg_models.Deploym
 	fc, val := genDeploymentsFilter(filter)
 	rows, err := h.sqlDB.QueryContext(
 		ctx,
-		"SELECT id, mod_id, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated FROM deployments"+fc+";",
+		"SELECT id, mod_id, name, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated FROM deployments"+fc+";",
 		val...,
 	)
 	if err != nil {
@@ -59,6 +59,7 @@ func (h *Handler) ReadDeployments(ctx context.Context, filter pkg_models.Deploym
 		err = rows.Scan(
 			&dep.Id,
 			&dep.ModuleId,
+			&dep.Name,
 			&dep.ModuleSource,
 			&dep.ModuleChannel,
 			&dep.ModuleVersion,
@@ -465,6 +466,40 @@ func (h *Handler) ReadDeploymentsFileGroups(ctx context.Context, deploymentIds [
 	return depFileGroups, nil
 }
 
+func (h *Handler) ReadDeploymentsDependencyTargets(
+	ctx context.Context,
+	filter pkg_models.DeploymentDependencyTargetsFilter,
+) (map[string]map[string]pkg_models.DeploymentDependencyTarget, error) {
+	fc, val := genDeploymentDependencyTargetsFilter(filter)
+	rows, err := h.sqlDB.QueryContext(
+		ctx,
+		"SELECT dep_id, mod_id, target_dep_id FROM dep_dependency_targets"+fc+";",
+		val...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	depTargets := make(map[string]map[string]pkg_models.DeploymentDependencyTarget)
+	for rows.Next() {
+		var target pkg_models.DeploymentDependencyTarget
+		err = rows.Scan(&target.DeploymentId, &target.ModuleId, &target.TargetId)
+		if err != nil {
+			return nil, err
+		}
+		targets, ok := depTargets[target.DeploymentId]
+		if !ok {
+			targets = make(map[string]pkg_models.DeploymentDependencyTarget)
+			depTargets[target.DeploymentId] = targets
+		}
+		targets[target.ModuleId] = target
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return depTargets, nil
+}
+
 func genDeploymentGlobalConfigsFilter(filter pkg_models.DeploymentGlobalConfigsFilter) (string, []any) {
 	var fc []string
 	var val []any
@@ -615,6 +650,13 @@ func genDeploymentsFilter(filter pkg_models.DeploymentsFilter) (string, []any) {
 			val = append(val, id)
 		}
 	}
+	if len(filter.Names) > 0 {
+		names := helper_slices.RemoveDuplicates(filter.Names)
+		fc = append(fc, "name IN ("+genQuestionMarks(len(names))+")")
+		for _, name := range names {
+			val = append(val, name)
+		}
+	}
 	if filter.Enabled < 0 {
 		fc = append(fc, "enabled = ?")
 		val = append(val, false)
@@ -628,3 +670,26 @@ func genDeploymentsFilter(filter pkg_models.DeploymentsFilter) (string, []any) {
 	}
 	return "", nil
 }
+
+func genDeploymentDependencyTargetsFilter(filter pkg_models.DeploymentDependencyTargetsFilter) (string, []any) {
+	var fc []string
+	var val []any
+	if len(filter.DeploymentIds) > 0 {
+		ids := helper_slices.RemoveDuplicates(filter.DeploymentIds)
+		fc = append(fc, "dep_id IN ("+genQuestionMarks(len(ids))+")")
+		for _, id := range ids {
+			val = append(val, id)
+		}
+	}
+	if len(filter.TargetIds) > 0 {
+		ids := helper_slices.RemoveDuplicates(filter.TargetIds)
+		fc = append(fc, "target_dep_id IN ("+genQuestionMarks(len(ids))+")")
+		for _, id := range ids {
+			val = append(val, id)
+		}
+	}
+	if len(fc) > 0 {
+		return " WHERE " + strings.Join(fc, " AND "), val
+	}
+	return "", nil
+}
@@ -70,6 +70,7 @@ func (h *Handler) UpdateDeployment(
 	fileGroups []pkg_models.DeploymentFileGroup,
 	volumes []pkg_models.DeploymentVolume,
 	containers []pkg_models.DeploymentContainerBase,
+	dependencyTargets []pkg_models.DeploymentDependencyTarget,
 ) error {
 	tx, err := h.sqlDB.BeginTx(ctx, nil)
 	if err != nil {
@@ -85,7 +86,7 @@ func (h *Handler) UpdateDeployment(
 		deployment.DirName,
 		deployment.FilesDirName,
 		deployment.Enabled,
-		deployment.Updated,
+		timeValue(deployment.Updated),
 		deployment.Id,
 	)
 	if err != nil {
@@ -107,6 +108,7 @@ func (h *Handler) UpdateDeployment(
 		fileGroups,
 		volumes,
 		containers,
+		dependencyTargets,
 	)
 	if err != nil {
 		return err
@@ -117,36 +119,3 @@ func (h *Handler) UpdateDeployment(
 	}
 	return nil
 }
-
-func (h *Handler) UpdateDeploymentContainerNames(ctx context.Context, containers []pkg_models.DeploymentContainerBase) error {
-	var db sqlDatabase = h.sqlDB
-	var tx *sql.Tx
-	var err error
-	if len(containers) > 0 {
-		tx, err = h.sqlDB.BeginTx(ctx, nil)
-		if err != nil {
-			return err
-		}
-		defer tx.Rollback()
-		db = tx
-	}
-	for _, container := range containers {
-		_, err = db.ExecContext(
-			ctx,
-			"UPDATE dep_containers SET name = ? WHERE dep_id = ? AND srv_ref = ?",
-			container.Name,
-			container.DeploymentId,
-			container.Reference,
-		)
-		if err != nil {
-			return err
-		}
-	}
-	if tx != nil {
-		err = tx.Commit()
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
@@ -153,13 +153,27 @@ func (h *Handler) UpdateGlobalConfig(ctx context.Context, config pkg_models.Conf
 	return nil
 }
 
-func (h *Handler) DeleteGlobalConfig(ctx context.Context, id string) error {
-	return h.DeleteGlobalConfigs(ctx, []string{id})
+func (h *Handler) DeleteGlobalConfig(ctx context.Context, id string, removeReferences bool) error {
+	return h.DeleteGlobalConfigs(ctx, []string{id}, removeReferences)
 }
 
-func (h *Handler) DeleteGlobalConfigs(ctx context.Context, ids []string) error {
+// DeleteGlobalConfigs deletes the global configs, references of deployments are only removed if removeReferences is
+// true, otherwise deleting referenced global configs fails.
+func (h *Handler) DeleteGlobalConfigs(ctx context.Context, ids []string, removeReferences bool) error {
+	tx, err := h.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if removeReferences {
+		fc, val := genDeploymentGlobalConfigsFilter(pkg_models.DeploymentGlobalConfigsFilter{Ids: ids})
+		_, err = tx.ExecContext(ctx, "DELETE FROM dep_global_configs"+fc+";", val...)
+		if err != nil {
+			return err
+		}
+	}
 	fc, val := genDeleteGlobalConfigsFilter(ids)
-	_, err := h.sqlDB.ExecContext(
+	_, err = tx.ExecContext(
 		ctx,
 		"DELETE FROM global_configs"+fc+";",
 		val...,
@@ -167,6 +181,10 @@ func (h *Handler) DeleteGlobalConfigs(ctx context.Context, ids []string) error {
 	if err != nil {
 		return err
 	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -20,32 +20,75 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
 )
 
 const timeLayout = "2006-01-02 15:04:05.000000"
 
+const (
+	DialectMySQL  = "mysql"
+	DialectSQLite = "sqlite"
+)
+
 type Config struct {
+	Dialect  string
 	Address  string
 	Database string
 	User     string
 	Password string
+	Path     string
 	Timeout  time.Duration
 }
 
+// NewConnector creates a connector for the configured dialect. The SQLite database is stored in the file located at
+// Config.Path, foreign keys are enforced and Config.Timeout is used as busy timeout.
 func NewConnector(config Config) (driver.Connector, error) {
-	cfg := mysql.NewConfig()
-	cfg.Addr = config.Address
-	cfg.User = config.User
-	cfg.Passwd = config.Password
-	cfg.DBName = config.Database
-	cfg.Timeout = config.Timeout
-	cfg.ReadTimeout = cfg.Timeout
-	cfg.WriteTimeout = cfg.Timeout
-	return mysql.NewConnector(cfg)
+	switch config.Dialect {
+	case DialectMySQL, "":
+		cfg := mysql.NewConfig()
+		cfg.Addr = config.Address
+		cfg.User = config.User
+		cfg.Passwd = config.Password
+		cfg.DBName = config.Database
+		cfg.Timeout = config.Timeout
+		cfg.ReadTimeout = cfg.Timeout
+		cfg.WriteTimeout = cfg.Timeout
+		return mysql.NewConnector(cfg)
+	case DialectSQLite:
+		if config.Path == "" {
+			return nil, fmt.Errorf("missing path for dialect '%s'", config.Dialect)
+		}
+		params := url.Values{}
+		params.Set("_foreign_keys", "1")
+		params.Set("_journal_mode", "WAL")
+		params.Set("_txlock", "immediate")
+		params.Set("_busy_timeout", fmt.Sprintf("%d", config.Timeout.Milliseconds()))
+		return &connector{
+			dsn:    "file:" + config.Path + "?" + params.Encode(),
+			driver: &sqlite3.SQLiteDriver{},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported dialect '%s'", config.Dialect)
+	}
+}
+
+type connector struct {
+	dsn    string
+	driver driver.Driver
+}
+
+func (c *connector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.driver
 }
 
 type Handler struct {
@@ -56,7 +99,8 @@ func New(sqlDB *sql.DB) *Handler {
 	return &Handler{sqlDB: sqlDB}
 }
 
-func (h *Handler) Migrate(ctx context.Context, migrations ...migration) error {
+// MigrateLegacy runs migrations of database schemas created before the introduction of versioned schema migrations.
+func (h *Handler) MigrateLegacy(ctx context.Context, migrations ...migration) error {
 	for _, m := range migrations {
 		ok, err := m.Required(ctx, h.sqlDB)
 		if err != nil {
@@ -75,6 +119,12 @@ func (h *Handler) Ping(ctx context.Context) error {
 	return h.sqlDB.PingContext(ctx)
 }
 
+// timeValue formats timestamps independent of the driver. The MySQL driver converts time values to UTC, while the
+// SQLite driver uses a format with time zone offset which can't be parsed with timeLayout.
+func timeValue(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
 func genQuestionMarks(numCol int) string {
 	if numCol <= 0 {
 		return ""
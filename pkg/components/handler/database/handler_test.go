@@ -0,0 +1,279 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"path"
+	"testing"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	migrations_db "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func newSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	connector, err := NewConnector(Config{
+		Dialect: DialectSQLite,
+		Path:    path.Join(t.TempDir(), "test.db"),
+		Timeout: time.Second * 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB := sql.OpenDB(connector)
+	t.Cleanup(func() {
+		sqlDB.Close()
+	})
+	return sqlDB
+}
+
+func newSQLiteHandler(t *testing.T) *Handler {
+	t.Helper()
+	h := New(newSQLiteDB(t))
+	if err := h.Migrate(context.Background(), migrations_db.SQLite); err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestNewConnector(t *testing.T) {
+	if _, err := NewConnector(Config{Dialect: DialectSQLite}); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := NewConnector(Config{Dialect: "test"}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestHandler_SQLite(t *testing.T) {
+	ctx := context.Background()
+	h := newSQLiteHandler(t)
+	t.Run("migrate twice", func(t *testing.T) {
+		if err := h.Migrate(ctx, migrations_db.SQLite); err != nil {
+			t.Error(err)
+		}
+	})
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	err := h.CreateModule(ctx, pkg_models.DatabaseModule{
+		Id:      "github.com/org/repo",
+		DirName: "test",
+		Added:   timestamp,
+		Updated: timestamp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := h.ReadModule(ctx, "github.com/org/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !module.Added.Equal(timestamp) {
+		t.Errorf("expected %s, got %s", timestamp, module.Added)
+	}
+	err = h.CreateGlobalConfig(ctx, pkg_models.Config{
+		Id:    "cfg",
+		Name:  "test",
+		Value: pkg_models.Value{IsSlice: true, StringSlice: []string{"a", "b"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.CreateDeployment(
+		ctx,
+		pkg_models.DeploymentBase{
+			Id:       "dep",
+			ModuleId: "github.com/org/repo",
+			Enabled:  true,
+			Created:  timestamp,
+			Updated:  timestamp,
+		},
+		nil,
+		nil,
+		nil,
+		[]pkg_models.DeploymentGlobalConfig{{Id: "cfg", DeploymentId: "dep", Reference: "ref"}},
+		nil,
+		nil,
+		nil,
+		[]pkg_models.DeploymentContainerBase{{Name: "ctr", DeploymentId: "dep", Reference: "srv", Alias: "alias"}},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployment, err := h.ReadDeployment(ctx, "dep")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !deployment.Enabled || !deployment.Created.Equal(timestamp) {
+		t.Errorf("unexpected deployment %+v", deployment)
+	}
+	t.Run("restricted deletes", func(t *testing.T) {
+		if err = h.DeleteModule(ctx, "github.com/org/repo"); err == nil {
+			t.Error("expected error")
+		}
+		if err = h.DeleteGlobalConfig(ctx, "cfg", false); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("snapshots", func(t *testing.T) {
+		for i := range 3 {
+			_, err = h.CreateDeploymentSnapshot(ctx, pkg_models.DeploymentSnapshot{
+				Id:           string(rune('a' + i)),
+				DeploymentId: "dep",
+				ModuleId:     "github.com/org/repo",
+				Created:      timestamp.Add(time.Duration(i) * time.Second),
+			}, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		snapshots, err := h.ReadDeploymentSnapshots(ctx, "dep")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(snapshots) != 2 || snapshots[0].Id != "c" || snapshots[1].Id != "b" {
+			t.Errorf("unexpected snapshots %+v", snapshots)
+		}
+	})
+	t.Run("credentials", func(t *testing.T) {
+		deployments, err := h.ReadDeploymentsWithoutCredential(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deployments) != 1 || deployments["dep"] != "github.com/org/repo" {
+			t.Errorf("unexpected deployments %+v", deployments)
+		}
+		for _, tokenHash := range []string{"a", "b"} {
+			err = h.SetDeploymentCredential(ctx, pkg_models.DeploymentCredential{DeploymentId: "dep", TokenHash: tokenHash, Created: timestamp})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		credential, err := h.ReadDeploymentCredentialByTokenHash(ctx, "b")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if credential.DeploymentId != "dep" || !credential.Created.Equal(timestamp) {
+			t.Errorf("unexpected credential %+v", credential)
+		}
+		if _, err = h.ReadDeploymentCredentialByTokenHash(ctx, "a"); !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		if deployments, err = h.ReadDeploymentsWithoutCredential(ctx); err != nil || len(deployments) != 0 {
+			t.Errorf("expected no deployments, got %+v, %v", deployments, err)
+		}
+	})
+	t.Run("jobs", func(t *testing.T) {
+		for _, job := range []pkg_models.Job{{Id: "job1", Start: timestamp}, {Id: "job2", Start: timestamp, DeploymentId: "dep"}} {
+			if err = h.CreateJob(ctx, job); err != nil {
+				t.Fatal(err)
+			}
+		}
+		jobs, err := h.ReadJobs(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if jobs["job1"].DeploymentId != "" || jobs["job2"].DeploymentId != "dep" {
+			t.Errorf("unexpected jobs %+v", jobs)
+		}
+	})
+	t.Run("instances", func(t *testing.T) {
+		instance := pkg_models.DeploymentBase{Id: "dep2", ModuleId: "github.com/org/repo", Name: "test", Created: timestamp, Updated: timestamp}
+		targets := []pkg_models.DeploymentDependencyTarget{{DeploymentId: "dep2", ModuleId: "github.com/org/repo", TargetId: "dep"}}
+		if err = h.CreateDeployment(ctx, instance, nil, nil, nil, nil, nil, nil, nil, nil, targets); err != nil {
+			t.Fatal(err)
+		}
+		instance.Id = "dep3"
+		if err = h.CreateDeployment(ctx, instance, nil, nil, nil, nil, nil, nil, nil, nil, nil); err == nil {
+			t.Error("expected error")
+		}
+		deployments, err := h.ReadDeployments(ctx, pkg_models.DeploymentsFilter{ModuleIds: []string{"github.com/org/repo"}, Names: []string{""}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := deployments["dep"]; !ok || len(deployments) != 1 {
+			t.Errorf("unexpected deployments %+v", deployments)
+		}
+		depTargets, err := h.ReadDeploymentsDependencyTargets(ctx, pkg_models.DeploymentDependencyTargetsFilter{TargetIds: []string{"dep"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if depTargets["dep2"]["github.com/org/repo"].TargetId != "dep" {
+			t.Errorf("unexpected targets %+v", depTargets)
+		}
+		if err = h.DeleteDeployment(ctx, "dep"); err == nil {
+			t.Error("expected error")
+		}
+		if err = h.DeleteDeployment(ctx, "dep2"); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("version constraints", func(t *testing.T) {
+		for _, constraint := range []string{"~v1.4", "^v1.4.2"} {
+			err = h.SetModuleVersionConstraint(ctx, pkg_models.ModuleVersionConstraint{ModuleId: "github.com/org/repo", Constraint: constraint, Updated: timestamp})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		constraints, err := h.ReadModuleVersionConstraints(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if constraint := constraints["github.com/org/repo"]; len(constraints) != 1 || constraint.Constraint != "^v1.4.2" || !constraint.Updated.Equal(timestamp) {
+			t.Errorf("unexpected constraints %+v", constraints)
+		}
+	})
+	t.Run("cascade", func(t *testing.T) {
+		if err = h.DeleteDeployment(ctx, "dep"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = h.ReadDeploymentCredential(ctx, "dep"); !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		containers, err := h.ReadDeploymentContainers(ctx, "dep")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(containers) != 0 {
+			t.Error("expected containers to be removed")
+		}
+		snapshots, err := h.ReadDeploymentSnapshots(ctx, "dep")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(snapshots) != 0 {
+			t.Error("expected snapshots to be removed")
+		}
+		if err = h.DeleteGlobalConfig(ctx, "cfg", false); err != nil {
+			t.Error(err)
+		}
+		if err = h.DeleteModule(ctx, "github.com/org/repo"); err != nil {
+			t.Error(err)
+		}
+		constraints, err := h.ReadModuleVersionConstraints(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(constraints) != 0 {
+			t.Error("expected version constraints to be removed")
+		}
+	})
+}
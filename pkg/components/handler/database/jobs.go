@@ -0,0 +1,141 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_slices "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/slices"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+func (h *Handler) CreateJob(ctx context.Context, job pkg_models.Job) error {
+	var depId sql.NullString
+	if job.DeploymentId != "" {
+		depId.String = job.DeploymentId
+		depId.Valid = true
+	}
+	_, err := h.sqlDB.ExecContext(
+		ctx,
+		"INSERT INTO jobs (id, description, started, dep_id) VALUES (?, ?, ?, ?);",
+		job.Id,
+		job.Description,
+		timeValue(job.Start),
+		depId,
+	)
+	return err
+}
+
+func (h *Handler) ReadJobs(ctx context.Context) (map[string]pkg_models.Job, error) {
+	rows, err := h.sqlDB.QueryContext(ctx, "SELECT id, description, started, ended, interrupted, dep_id FROM jobs;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	jobs := make(map[string]pkg_models.Job)
+	for rows.Next() {
+		var job pkg_models.Job
+		var st []uint8
+		var et []uint8
+		var depId sql.NullString
+		err = rows.Scan(&job.Id, &job.Description, &st, &et, &job.Interrupted, &depId)
+		if err != nil {
+			return nil, err
+		}
+		job.DeploymentId = depId.String
+		if job.Start, err = time.Parse(timeLayout, string(st)); err != nil {
+			logger.ErrorContext(ctx, "read jobs", slog_keys.JobId, job.Id, slog_keys.Error, err)
+		}
+		if et != nil {
+			if job.End, err = time.Parse(timeLayout, string(et)); err != nil {
+				logger.ErrorContext(ctx, "read jobs", slog_keys.JobId, job.Id, slog_keys.Error, err)
+			}
+		}
+		jobs[job.Id] = job
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (h *Handler) UpdateJobEnd(ctx context.Context, id string, end time.Time) error {
+	res, err := h.sqlDB.ExecContext(ctx, "UPDATE jobs SET ended = ? WHERE id = ?;", timeValue(end), id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n < 1 {
+		return lib_errors.New[lib_errors.ErrNotFound]("job not found")
+	}
+	return nil
+}
+
+// InterruptJobs sets the end of all jobs without an end and marks them as interrupted.
+func (h *Handler) InterruptJobs(ctx context.Context, end time.Time) error {
+	_, err := h.sqlDB.ExecContext(ctx, "UPDATE jobs SET ended = ?, interrupted = TRUE WHERE ended IS NULL;", timeValue(end))
+	return err
+}
+
+func (h *Handler) DeleteJobs(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := h.sqlDB.ExecContext(
+		ctx,
+		"DELETE FROM jobs WHERE id IN ("+genQuestionMarks(len(ids))+");",
+		helper_slices.ToAny(ids)...,
+	)
+	return err
+}
+
+func (h *Handler) CreateJobResult(ctx context.Context, result pkg_models.JobResult) error {
+	_, err := h.sqlDB.ExecContext(
+		ctx,
+		"INSERT INTO job_results (job_id, type, data) VALUES (?, ?, ?);",
+		result.JobId,
+		result.Type,
+		result.Data,
+	)
+	return err
+}
+
+func (h *Handler) ReadJobResult(ctx context.Context, jobId, resultType string) (pkg_models.JobResult, error) {
+	row := h.sqlDB.QueryRowContext(
+		ctx,
+		"SELECT job_id, type, data FROM job_results WHERE job_id = ? AND type = ?;",
+		jobId,
+		resultType,
+	)
+	var result pkg_models.JobResult
+	err := row.Scan(&result.JobId, &result.Type, &result.Data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkg_models.JobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job result not found")
+		}
+		return pkg_models.JobResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,301 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+const (
+	MigrationStateApplied          = "applied"
+	MigrationStatePending          = "pending"
+	MigrationStateChecksumMismatch = "checksum mismatch"
+	MigrationStateUnknown          = "unknown"
+)
+
+// The applied column is stored as text to use the same statement for all dialects.
+const createSchemaMigrationsTableStmt = `CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    version  INTEGER      NOT NULL,
+    name     VARCHAR(256) NOT NULL,
+    checksum CHAR(64)     NOT NULL,
+    applied  VARCHAR(32)  NOT NULL,
+    PRIMARY KEY (version)
+);`
+
+type appliedMigration struct {
+	Version  int
+	Name     string
+	Checksum string
+	Applied  time.Time
+}
+
+// Migrate applies all pending migrations in order and records them in the schema_migrations table. The migrations
+// are not applied if the database schema is newer than the latest migration or an applied migration was changed.
+func (h *Handler) Migrate(ctx context.Context, migrations []pkg_models.SchemaMigration) error {
+	if err := validateMigrations(migrations); err != nil {
+		return err
+	}
+	applied, err := h.readAppliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	if err = checkAppliedMigrations(migrations, applied); err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		logger.InfoContext(ctx, "apply schema migration", slog_keys.Version, m.Version, slog_keys.Name, m.Name)
+		if err = h.runMigration(ctx, m.Up, m.DisableForeignKeys, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(
+				ctx,
+				"INSERT INTO schema_migrations (version, name, checksum, applied) VALUES (?, ?, ?, ?);",
+				m.Version,
+				m.Name,
+				migrationChecksum(m),
+				timeValue(helper_time.Now()),
+			)
+			return err
+		}); err != nil {
+			return fmt.Errorf("apply schema migration %d: %w", m.Version, err)
+		}
+	}
+	return nil
+}
+
+// MigrateDown reverts all applied migrations with a version greater than the target version in reverse order.
+func (h *Handler) MigrateDown(ctx context.Context, migrations []pkg_models.SchemaMigration, targetVersion int) error {
+	if err := validateMigrations(migrations); err != nil {
+		return err
+	}
+	applied, err := h.readAppliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	if err = checkAppliedMigrations(migrations, applied); err != nil {
+		return err
+	}
+	for _, m := range slices.Backward(migrations) {
+		if m.Version <= targetVersion {
+			break
+		}
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		logger.InfoContext(ctx, "revert schema migration", slog_keys.Version, m.Version, slog_keys.Name, m.Name)
+		if err = h.runMigration(ctx, m.Down, m.DisableForeignKeys, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?;", m.Version)
+			return err
+		}); err != nil {
+			return fmt.Errorf("revert schema migration %d: %w", m.Version, err)
+		}
+	}
+	return nil
+}
+
+// MigrationsStatus returns the state of the provided and applied migrations ordered by version.
+func (h *Handler) MigrationsStatus(ctx context.Context, migrations []pkg_models.SchemaMigration) ([]pkg_models.SchemaMigrationStatus, error) {
+	applied, err := h.readAppliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var status []pkg_models.SchemaMigrationStatus
+	for _, m := range migrations {
+		item := pkg_models.SchemaMigrationStatus{
+			Version:  m.Version,
+			Name:     m.Name,
+			Checksum: migrationChecksum(m),
+			State:    MigrationStatePending,
+		}
+		if am, ok := applied[m.Version]; ok {
+			item.Applied = am.Applied
+			item.State = MigrationStateApplied
+			if am.Checksum != item.Checksum {
+				item.State = MigrationStateChecksumMismatch
+			}
+			delete(applied, m.Version)
+		}
+		status = append(status, item)
+	}
+	for _, am := range applied {
+		status = append(status, pkg_models.SchemaMigrationStatus{
+			Version:  am.Version,
+			Name:     am.Name,
+			Checksum: am.Checksum,
+			Applied:  am.Applied,
+			State:    MigrationStateUnknown,
+		})
+	}
+	slices.SortFunc(status, func(a, b pkg_models.SchemaMigrationStatus) int {
+		return a.Version - b.Version
+	})
+	return status, nil
+}
+
+func (h *Handler) readAppliedMigrations(ctx context.Context) (map[int]appliedMigration, error) {
+	if _, err := h.sqlDB.ExecContext(ctx, createSchemaMigrationsTableStmt); err != nil {
+		return nil, err
+	}
+	rows, err := h.sqlDB.QueryContext(ctx, "SELECT version, name, checksum, applied FROM schema_migrations;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := make(map[int]appliedMigration)
+	for rows.Next() {
+		var am appliedMigration
+		var at string
+		if err = rows.Scan(&am.Version, &am.Name, &am.Checksum, &at); err != nil {
+			return nil, err
+		}
+		if am.Applied, err = time.Parse(timeLayout, at); err != nil {
+			logger.ErrorContext(ctx, "read schema migrations", slog_keys.Version, am.Version, slog_keys.Error, err)
+		}
+		applied[am.Version] = am
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+// runMigration executes the statements and the record function in a transaction. Please note that MySQL commits
+// implicitly after DDL statements, a failed migration may therefore require manual cleanup. Foreign keys can only be
+// disabled outside of transactions, a dedicated connection is used and discarded if they can't be enabled again.
+func (h *Handler) runMigration(ctx context.Context, b []byte, disableForeignKeys bool, record func(tx *sql.Tx) error) error {
+	conn, err := h.sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if disableForeignKeys {
+		if _, err = conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF;"); err != nil {
+			return err
+		}
+		defer func() {
+			if _, e := conn.ExecContext(context.WithoutCancel(ctx), "PRAGMA foreign_keys = ON;"); e != nil {
+				logger.ErrorContext(ctx, "enable foreign keys", slog_keys.Error, e)
+				_ = conn.Raw(func(_ any) error {
+					return driver.ErrBadConn
+				})
+			}
+		}()
+	}
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, stmt := range splitStatements(b) {
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if disableForeignKeys {
+		if err = checkForeignKeys(ctx, tx); err != nil {
+			return err
+		}
+	}
+	if err = record(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func checkForeignKeys(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "PRAGMA foreign_key_check;")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		var table string
+		var rowId sql.NullInt64
+		var parent string
+		var fkId int
+		if err = rows.Scan(&table, &rowId, &parent, &fkId); err != nil {
+			return err
+		}
+		return fmt.Errorf("foreign key violation in table '%s' referencing '%s'", table, parent)
+	}
+	return rows.Err()
+}
+
+func validateMigrations(migrations []pkg_models.SchemaMigration) error {
+	if len(migrations) == 0 {
+		return errors.New("no schema migrations")
+	}
+	for i, m := range migrations {
+		if m.Version < 1 {
+			return fmt.Errorf("invalid schema migration version %d", m.Version)
+		}
+		if i > 0 && m.Version <= migrations[i-1].Version {
+			return fmt.Errorf("schema migration %d not in order", m.Version)
+		}
+	}
+	return nil
+}
+
+func checkAppliedMigrations(migrations []pkg_models.SchemaMigration, applied map[int]appliedMigration) error {
+	latest := migrations[len(migrations)-1].Version
+	for version, am := range applied {
+		if version > latest {
+			return fmt.Errorf("database schema version %d is newer than supported version %d", version, latest)
+		}
+		i := slices.IndexFunc(migrations, func(m pkg_models.SchemaMigration) bool {
+			return m.Version == version
+		})
+		if i < 0 {
+			return fmt.Errorf("applied schema migration %d unknown", version)
+		}
+		if checksum := migrationChecksum(migrations[i]); am.Checksum != checksum {
+			return fmt.Errorf("applied schema migration %d checksum mismatch: expected %s, got %s", version, checksum, am.Checksum)
+		}
+	}
+	return nil
+}
+
+func migrationChecksum(m pkg_models.SchemaMigration) string {
+	hash := sha256.Sum256(m.Up)
+	return hex.EncodeToString(hash[:])
+}
+
+func splitStatements(b []byte) []string {
+	var stmts []string
+	for _, stmt := range bytes.Split(b, []byte(";")) {
+		if s := strings.TrimSpace(string(stmt)); s != "" {
+			stmts = append(stmts, s+";")
+		}
+	}
+	return stmts
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS dep_runtime_events
+(
+    id                BIGINT       NOT NULL AUTO_INCREMENT,
+    dep_id            CHAR(36)     NOT NULL,
+    aux_dep_id        CHAR(36)     NULL,
+    timestamp         TIMESTAMP(6) NOT NULL,
+    action            VARCHAR(32)  NOT NULL,
+    reason            VARCHAR(64)  NOT NULL,
+    containers_before TEXT         NOT NULL,
+    containers_after  TEXT         NOT NULL,
+    error             TEXT         NULL,
+    PRIMARY KEY (id),
+    INDEX i_dep_id_timestamp (dep_id, timestamp),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
\ No newline at end of file
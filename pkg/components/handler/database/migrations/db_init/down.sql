@@ -0,0 +1,25 @@
+DROP TABLE IF EXISTS job_results;
+DROP TABLE IF EXISTS jobs;
+DROP TABLE IF EXISTS dep_snapshots;
+DROP TABLE IF EXISTS dep_runtime_events;
+DROP TABLE IF EXISTS dep_adv_items;
+DROP TABLE IF EXISTS dep_advertisements;
+DROP TABLE IF EXISTS aux_dep_volume_mounts;
+DROP TABLE IF EXISTS aux_dep_volumes;
+DROP TABLE IF EXISTS aux_dep_configs;
+DROP TABLE IF EXISTS aux_dep_labels;
+DROP TABLE IF EXISTS aux_deployments;
+DROP TABLE IF EXISTS dep_file_group_files;
+DROP TABLE IF EXISTS dep_file_groups;
+DROP TABLE IF EXISTS dep_files;
+DROP TABLE IF EXISTS dep_global_configs;
+DROP TABLE IF EXISTS dep_config_values;
+DROP TABLE IF EXISTS dep_configs;
+DROP TABLE IF EXISTS dep_secrets;
+DROP TABLE IF EXISTS dep_host_resources;
+DROP TABLE IF EXISTS dep_volumes;
+DROP TABLE IF EXISTS dep_containers;
+DROP TABLE IF EXISTS deployments;
+DROP TABLE IF EXISTS modules;
+DROP TABLE IF EXISTS global_config_values;
+DROP TABLE IF EXISTS global_configs;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS jobs
+(
+    id          CHAR(36)     NOT NULL,
+    description VARCHAR(256) NOT NULL,
+    started     TIMESTAMP(6) NOT NULL,
+    ended       TIMESTAMP(6) NULL,
+    interrupted BOOLEAN      NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (id),
+    INDEX i_ended (ended)
+);
+CREATE TABLE IF NOT EXISTS job_results
+(
+    job_id CHAR(36)    NOT NULL,
+    type   VARCHAR(64) NOT NULL,
+    data   MEDIUMTEXT  NOT NULL,
+    PRIMARY KEY (job_id),
+    FOREIGN KEY (job_id) REFERENCES jobs (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
\ No newline at end of file
@@ -18,10 +18,9 @@ package db_init
 
 import (
 	"bytes"
-	"context"
-	"database/sql"
 	_ "embed"
-	"io"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 )
 
 //go:embed modules.sql
@@ -36,52 +35,75 @@ var auxDeployments []byte
 //go:embed dep_advertisements.sql
 var depAdvertisements []byte
 
+//go:embed dep_runtime_events.sql
+var depRuntimeEvents []byte
+
+//go:embed dep_snapshots.sql
+var depSnapshots []byte
+
 //go:embed global_configs.sql
 var globalConfigs []byte
 
-var Migration = migration{
-	globalConfigs,
-	modules,
-	deployments,
-	auxDeployments,
-	depAdvertisements,
-}
+//go:embed jobs.sql
+var jobs []byte
 
-type migration [][]byte
+//go:embed down.sql
+var down []byte
 
-func (m migration) Required(_ context.Context, _ *sql.DB) (bool, error) {
-	return true, nil
+// Migration creates the initial database schema.
+var Migration = pkg_models.SchemaMigration{
+	Version: 1,
+	Name:    "init",
+	Up: bytes.Join([][]byte{
+		globalConfigs,
+		modules,
+		deployments,
+		auxDeployments,
+		depAdvertisements,
+		depRuntimeEvents,
+		depSnapshots,
+		jobs,
+	}, []byte("\n")),
+	Down: down,
 }
 
-func (m migration) Run(ctx context.Context, db *sql.DB) error {
-	var stmts []string
-	for _, sm := range m {
-		tmp, err := readStatements(sm)
-		if err != nil {
-			return err
-		}
-		stmts = append(stmts, tmp...)
-	}
-	for _, stmt := range stmts {
-		_, err := db.ExecContext(ctx, stmt)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
+//go:embed sqlite/modules.sql
+var sqliteModules []byte
+
+//go:embed sqlite/deployments.sql
+var sqliteDeployments []byte
+
+//go:embed sqlite/aux_deployments.sql
+var sqliteAuxDeployments []byte
+
+//go:embed sqlite/dep_advertisements.sql
+var sqliteDepAdvertisements []byte
+
+//go:embed sqlite/dep_runtime_events.sql
+var sqliteDepRuntimeEvents []byte
+
+//go:embed sqlite/dep_snapshots.sql
+var sqliteDepSnapshots []byte
+
+//go:embed sqlite/global_configs.sql
+var sqliteGlobalConfigs []byte
+
+//go:embed sqlite/jobs.sql
+var sqliteJobs []byte
 
-func readStatements(b []byte) ([]string, error) {
-	buffer := bytes.NewBuffer(b)
-	var stmts []string
-	for {
-		stmt, err := buffer.ReadString(';')
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
-		stmts = append(stmts, stmt)
-	}
-	return stmts, nil
+// SQLiteMigration creates the initial database schema for the SQLite dialect.
+var SQLiteMigration = pkg_models.SchemaMigration{
+	Version: 1,
+	Name:    "init",
+	Up: bytes.Join([][]byte{
+		sqliteGlobalConfigs,
+		sqliteModules,
+		sqliteDeployments,
+		sqliteAuxDeployments,
+		sqliteDepAdvertisements,
+		sqliteDepRuntimeEvents,
+		sqliteDepSnapshots,
+		sqliteJobs,
+	}, []byte("\n")),
+	Down: down,
 }
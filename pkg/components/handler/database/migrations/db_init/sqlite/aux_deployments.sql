@@ -0,0 +1,59 @@
+CREATE TABLE IF NOT EXISTS aux_deployments
+(
+    id         CHAR(36)     NOT NULL,
+    dep_id     CHAR(36)     NOT NULL,
+    image      VARCHAR(256) NOT NULL,
+    created    TEXT         NOT NULL,
+    updated    TEXT         NOT NULL,
+    ref        VARCHAR(256) NOT NULL,
+    name       VARCHAR(256) NOT NULL,
+    enabled    BOOLEAN      NOT NULL,
+    ctr_name   VARCHAR(256) NOT NULL,
+    ctr_alias  VARCHAR(256) NOT NULL,
+    recreate   BOOLEAN      NOT NULL,
+    command    VARCHAR(512),
+    pseudo_tty BOOLEAN,
+    PRIMARY KEY (id),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_aux_deployments_dep_id ON aux_deployments (dep_id);
+CREATE INDEX IF NOT EXISTS i_aux_deployments_dep_id_ref ON aux_deployments (dep_id, ref);
+CREATE TABLE IF NOT EXISTS aux_dep_labels
+(
+    aux_dep_id CHAR(36)     NOT NULL,
+    name       VARCHAR(256) NOT NULL,
+    value      VARCHAR(512),
+    CONSTRAINT uk_aux_dep_id_name UNIQUE (aux_dep_id, name),
+    FOREIGN KEY (aux_dep_id) REFERENCES aux_deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_aux_dep_labels_aux_dep_id ON aux_dep_labels (aux_dep_id);
+CREATE TABLE IF NOT EXISTS aux_dep_configs
+(
+    aux_dep_id CHAR(36)     NOT NULL,
+    name       VARCHAR(256) NOT NULL,
+    value      VARCHAR(512),
+    CONSTRAINT uk_aux_dep_id_name UNIQUE (aux_dep_id, name),
+    FOREIGN KEY (aux_dep_id) REFERENCES aux_deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_aux_dep_configs_aux_dep_id ON aux_dep_configs (aux_dep_id);
+CREATE TABLE IF NOT EXISTS aux_dep_volumes
+(
+    id     VARCHAR(512) NOT NULL,
+    dep_id CHAR(36)     NOT NULL,
+    ref    VARCHAR(256) NOT NULL,
+    name   VARCHAR(256) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_aux_dep_volumes_dep_id ON aux_dep_volumes (dep_id);
+CREATE TABLE IF NOT EXISTS aux_dep_volume_mounts
+(
+    vol_id     VARCHAR(512) NOT NULL,
+    aux_dep_id CHAR(36)     NOT NULL,
+    mnt_path   VARCHAR(512) NOT NULL,
+    CONSTRAINT uk_aux_dep_id_mnt_path UNIQUE (aux_dep_id, mnt_path),
+    FOREIGN KEY (vol_id) REFERENCES aux_dep_volumes (id) ON DELETE CASCADE ON UPDATE RESTRICT,
+    FOREIGN KEY (aux_dep_id) REFERENCES aux_deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_aux_dep_volume_mounts_aux_dep_id ON aux_dep_volume_mounts (aux_dep_id);
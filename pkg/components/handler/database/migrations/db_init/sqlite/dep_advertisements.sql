@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS dep_advertisements
+(
+    id        CHAR(36)     NOT NULL,
+    dep_id    CHAR(36)     NOT NULL,
+    mod_id    VARCHAR(256) NOT NULL,
+    ref       VARCHAR(256) NOT NULL,
+    timestamp TEXT         NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_advertisements_dep_id ON dep_advertisements (dep_id);
+CREATE INDEX IF NOT EXISTS i_dep_advertisements_mod_id ON dep_advertisements (mod_id);
+CREATE INDEX IF NOT EXISTS i_dep_advertisements_ref ON dep_advertisements (ref);
+CREATE TABLE IF NOT EXISTS dep_adv_items
+(
+    dep_adv_id CHAR(36)     NOT NULL,
+    item_key   VARCHAR(256) NOT NULL,
+    item_value VARCHAR(512),
+    CONSTRAINT uk_dep_adv_id_item_key UNIQUE (dep_adv_id, item_key),
+    FOREIGN KEY (dep_adv_id) REFERENCES dep_advertisements (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_adv_items_dep_adv_id ON dep_adv_items (dep_adv_id);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS dep_runtime_events
+(
+    id                INTEGER      NOT NULL PRIMARY KEY AUTOINCREMENT,
+    dep_id            CHAR(36)     NOT NULL,
+    aux_dep_id        CHAR(36)     NULL,
+    timestamp         TEXT         NOT NULL,
+    action            VARCHAR(32)  NOT NULL,
+    reason            VARCHAR(64)  NOT NULL,
+    containers_before TEXT         NOT NULL,
+    containers_after  TEXT         NOT NULL,
+    error             TEXT         NULL,
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_runtime_events_dep_id_timestamp ON dep_runtime_events (dep_id, timestamp);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS dep_snapshots
+(
+    id          CHAR(36)     NOT NULL,
+    dep_id      CHAR(36)     NOT NULL,
+    mod_id      VARCHAR(256) NOT NULL,
+    mod_source  VARCHAR(512) NOT NULL,
+    mod_channel VARCHAR(256) NOT NULL,
+    mod_ver     VARCHAR(256) NOT NULL,
+    user_input  TEXT         NOT NULL,
+    containers  TEXT         NOT NULL,
+    created     TEXT         NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_snapshots_dep_id_created ON dep_snapshots (dep_id, created);
@@ -0,0 +1,120 @@
+CREATE TABLE IF NOT EXISTS deployments
+(
+    id          CHAR(36)     NOT NULL,
+    mod_id      VARCHAR(256) NOT NULL,
+    mod_source  VARCHAR(512) NOT NULL,
+    mod_channel VARCHAR(256) NOT NULL,
+    mod_ver     VARCHAR(256) NOT NULL,
+    dir         VARCHAR(256) NOT NULL,
+    files_dir   VARCHAR(256) NOT NULL,
+    enabled     BOOLEAN      NOT NULL,
+    created     TEXT         NOT NULL,
+    updated     TEXT         NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_mod_id UNIQUE (mod_id),
+    FOREIGN KEY (mod_id) REFERENCES modules (id) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
+CREATE TABLE IF NOT EXISTS dep_containers
+(
+    dep_id  CHAR(36)     NOT NULL,
+    name    VARCHAR(256) NOT NULL,
+    srv_ref VARCHAR(256) NOT NULL,
+    alias   VARCHAR(256) NOT NULL,
+    CONSTRAINT uk_dep_id_name_srv_ref UNIQUE (dep_id, name, srv_ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_containers_dep_id ON dep_containers (dep_id);
+CREATE INDEX IF NOT EXISTS i_dep_containers_name ON dep_containers (name);
+CREATE TABLE IF NOT EXISTS dep_volumes
+(
+    dep_id CHAR(36)     NOT NULL,
+    ref    VARCHAR(128) NOT NULL,
+    name   VARCHAR(256) NOT NULL,
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_volumes_dep_id ON dep_volumes (dep_id);
+CREATE TABLE IF NOT EXISTS dep_host_resources
+(
+    dep_id CHAR(36)     NOT NULL,
+    ref    VARCHAR(128) NOT NULL,
+    res_id VARCHAR(256) NOT NULL,
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_host_resources_dep_id ON dep_host_resources (dep_id);
+CREATE TABLE IF NOT EXISTS dep_secrets
+(
+    dep_id   CHAR(36)     NOT NULL,
+    ref      VARCHAR(128) NOT NULL,
+    sec_id   VARCHAR(256) NOT NULL,
+    item     VARCHAR(128) NULL, -- e.g. user credentials consist of 'username' and 'password' stored as a single secret
+    as_mount BOOLEAN,
+    as_env   BOOLEAN,
+    CONSTRAINT uk_dep_id_ref_item UNIQUE (dep_id, ref, item),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_secrets_dep_id ON dep_secrets (dep_id);
+CREATE TABLE IF NOT EXISTS dep_configs
+(
+    id        VARCHAR(256) NOT NULL,
+    dep_id    CHAR(36)     NOT NULL,
+    ref       VARCHAR(128) NOT NULL,
+    data_type SMALLINT     NOT NULL,
+    is_list   BOOLEAN      NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_configs_dep_id ON dep_configs (dep_id);
+CREATE TABLE IF NOT EXISTS dep_config_values
+(
+    c_id     VARCHAR(256) NOT NULL,
+    v_string VARCHAR(512),
+    v_int    BIGINT,
+    v_float  DOUBLE,
+    v_bool   BOOLEAN,
+    ord      SMALLINT     NOT NULL,
+    CONSTRAINT uk_c_id_ord UNIQUE (c_id, ord),
+    FOREIGN KEY (c_id) REFERENCES dep_configs (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_config_values_c_id ON dep_config_values (c_id);
+CREATE TABLE IF NOT EXISTS dep_global_configs
+(
+    dep_id CHAR(36)     NOT NULL,
+    ref    VARCHAR(128) NOT NULL,
+    c_id   VARCHAR(256) NOT NULL,
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT,
+    FOREIGN KEY (c_id) REFERENCES global_configs (id) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_global_configs_dep_id ON dep_global_configs (dep_id);
+CREATE TABLE IF NOT EXISTS dep_files
+(
+    dep_id CHAR(36)     NOT NULL,
+    ref    VARCHAR(128) NOT NULL,
+    data   BLOB,
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_files_dep_id ON dep_files (dep_id);
+CREATE TABLE IF NOT EXISTS dep_file_groups
+(
+    id     VARCHAR(256) NOT NULL,
+    dep_id CHAR(36)     NOT NULL,
+    ref    VARCHAR(128) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_dep_id_ref UNIQUE (dep_id, ref),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_file_groups_dep_id ON dep_file_groups (dep_id);
+CREATE TABLE IF NOT EXISTS dep_file_group_files
+(
+    g_id   VARCHAR(256) NOT NULL,
+    path   VARCHAR(512) NOT NULL,
+    format VARCHAR(128) NOT NULL,
+    data   BLOB,
+    CONSTRAINT uk_g_id_path UNIQUE (g_id, path),
+    FOREIGN KEY (g_id) REFERENCES dep_file_groups (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_file_group_files_g_id ON dep_file_group_files (g_id);
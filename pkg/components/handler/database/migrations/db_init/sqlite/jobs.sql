@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS jobs
+(
+    id          CHAR(36)     NOT NULL,
+    description VARCHAR(256) NOT NULL,
+    started     TEXT         NOT NULL,
+    ended       TEXT         NULL,
+    interrupted BOOLEAN      NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS i_jobs_ended ON jobs (ended);
+CREATE TABLE IF NOT EXISTS job_results
+(
+    job_id CHAR(36)    NOT NULL,
+    type   VARCHAR(64) NOT NULL,
+    data   TEXT        NOT NULL,
+    PRIMARY KEY (job_id),
+    FOREIGN KEY (job_id) REFERENCES jobs (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
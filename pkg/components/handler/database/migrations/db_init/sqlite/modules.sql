@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS modules
+(
+    id      VARCHAR(256) NOT NULL,
+    dir     VARCHAR(256) NOT NULL,
+    source  VARCHAR(512) NOT NULL,
+    channel VARCHAR(256) NOT NULL,
+    added   TEXT         NOT NULL,
+    updated TEXT         NOT NULL,
+    PRIMARY KEY (id)
+);
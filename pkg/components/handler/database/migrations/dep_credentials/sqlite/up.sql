@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS dep_credentials
+(
+    dep_id     CHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created    TEXT     NOT NULL,
+    PRIMARY KEY (dep_id),
+    CONSTRAINT uk_token_hash UNIQUE (token_hash),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS dep_credentials
+(
+    dep_id     CHAR(36)     NOT NULL,
+    token_hash CHAR(64)     NOT NULL,
+    created    TIMESTAMP(6) NOT NULL,
+    PRIMARY KEY (dep_id),
+    UNIQUE KEY uk_token_hash (token_hash),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
@@ -0,0 +1,4 @@
+ALTER TABLE deployments ADD UNIQUE KEY uk_mod_id (mod_id);
+DROP TABLE IF EXISTS dep_dependency_targets;
+ALTER TABLE deployments DROP INDEX uk_mod_id_name;
+ALTER TABLE deployments DROP COLUMN name;
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployment_instances
+
+import (
+	_ "embed"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+//go:embed up.sql
+var up []byte
+
+//go:embed down.sql
+var down []byte
+
+//go:embed sqlite/up.sql
+var sqliteUp []byte
+
+//go:embed sqlite/down.sql
+var sqliteDown []byte
+
+// Migration adds instance names to deployments, replacing the unique module constraint, and creates the table holding
+// the deployments targeted by dependencies. Reverting fails if a module has more than one deployment.
+var Migration = pkg_models.SchemaMigration{
+	Version: 3,
+	Name:    "deployment_instances",
+	Up:      up,
+	Down:    down,
+}
+
+// SQLiteMigration rebuilds the deployments table, as SQLite does not support dropping constraints.
+var SQLiteMigration = pkg_models.SchemaMigration{
+	Version:            3,
+	Name:               "deployment_instances",
+	Up:                 sqliteUp,
+	Down:               sqliteDown,
+	DisableForeignKeys: true,
+}
@@ -0,0 +1,22 @@
+DROP TABLE IF EXISTS dep_dependency_targets;
+CREATE TABLE deployments_old
+(
+    id          CHAR(36)     NOT NULL,
+    mod_id      VARCHAR(256) NOT NULL,
+    mod_source  VARCHAR(512) NOT NULL,
+    mod_channel VARCHAR(256) NOT NULL,
+    mod_ver     VARCHAR(256) NOT NULL,
+    dir         VARCHAR(256) NOT NULL,
+    files_dir   VARCHAR(256) NOT NULL,
+    enabled     BOOLEAN      NOT NULL,
+    created     TEXT         NOT NULL,
+    updated     TEXT         NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_mod_id UNIQUE (mod_id),
+    FOREIGN KEY (mod_id) REFERENCES modules (id) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
+INSERT INTO deployments_old (id, mod_id, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated)
+SELECT id, mod_id, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated
+FROM deployments;
+DROP TABLE deployments;
+ALTER TABLE deployments_old RENAME TO deployments;
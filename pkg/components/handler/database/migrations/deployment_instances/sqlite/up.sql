@@ -0,0 +1,32 @@
+CREATE TABLE deployments_new
+(
+    id          CHAR(36)     NOT NULL,
+    mod_id      VARCHAR(256) NOT NULL,
+    name        VARCHAR(256) NOT NULL DEFAULT '',
+    mod_source  VARCHAR(512) NOT NULL,
+    mod_channel VARCHAR(256) NOT NULL,
+    mod_ver     VARCHAR(256) NOT NULL,
+    dir         VARCHAR(256) NOT NULL,
+    files_dir   VARCHAR(256) NOT NULL,
+    enabled     BOOLEAN      NOT NULL,
+    created     TEXT         NOT NULL,
+    updated     TEXT         NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uk_mod_id_name UNIQUE (mod_id, name),
+    FOREIGN KEY (mod_id) REFERENCES modules (id) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
+INSERT INTO deployments_new (id, mod_id, name, mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated)
+SELECT id, mod_id, '', mod_source, mod_channel, mod_ver, dir, files_dir, enabled, created, updated
+FROM deployments;
+DROP TABLE deployments;
+ALTER TABLE deployments_new RENAME TO deployments;
+CREATE TABLE IF NOT EXISTS dep_dependency_targets
+(
+    dep_id        CHAR(36)     NOT NULL,
+    mod_id        VARCHAR(256) NOT NULL,
+    target_dep_id CHAR(36)     NOT NULL,
+    PRIMARY KEY (dep_id, mod_id),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT,
+    FOREIGN KEY (target_dep_id) REFERENCES deployments (id) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS i_dep_dependency_targets_target_dep_id ON dep_dependency_targets (target_dep_id);
@@ -0,0 +1,13 @@
+ALTER TABLE deployments ADD COLUMN name VARCHAR(256) NOT NULL DEFAULT '' AFTER mod_id;
+ALTER TABLE deployments ADD UNIQUE KEY uk_mod_id_name (mod_id, name);
+ALTER TABLE deployments DROP INDEX uk_mod_id;
+CREATE TABLE IF NOT EXISTS dep_dependency_targets
+(
+    dep_id        CHAR(36)     NOT NULL,
+    mod_id        VARCHAR(256) NOT NULL,
+    target_dep_id CHAR(36)     NOT NULL,
+    PRIMARY KEY (dep_id, mod_id),
+    INDEX i_target_dep_id (target_dep_id),
+    FOREIGN KEY (dep_id) REFERENCES deployments (id) ON DELETE CASCADE ON UPDATE RESTRICT,
+    FOREIGN KEY (target_dep_id) REFERENCES deployments (id) ON DELETE RESTRICT ON UPDATE RESTRICT
+);
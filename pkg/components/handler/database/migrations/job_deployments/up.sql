@@ -0,0 +1 @@
+ALTER TABLE jobs ADD COLUMN dep_id CHAR(36) NULL;
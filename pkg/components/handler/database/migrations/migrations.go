@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/db_init"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/dep_credentials"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/deployment_instances"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/job_deployments"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations/mod_version_constraints"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+// MySQL and SQLite contain the schema migrations of the respective dialect ordered by version. Applied migrations
+// must not be changed, schema changes require a new migration with a higher version.
+var (
+	MySQL = []pkg_models.SchemaMigration{
+		db_init.Migration,
+		dep_credentials.Migration,
+		deployment_instances.Migration,
+		mod_version_constraints.Migration,
+		job_deployments.Migration,
+	}
+	SQLite = []pkg_models.SchemaMigration{
+		db_init.SQLiteMigration,
+		dep_credentials.SQLiteMigration,
+		deployment_instances.SQLiteMigration,
+		mod_version_constraints.SQLiteMigration,
+		job_deployments.SQLiteMigration,
+	}
+)
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS mod_version_constraints;
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mod_version_constraints
+
+import (
+	_ "embed"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+//go:embed up.sql
+var up []byte
+
+//go:embed sqlite/up.sql
+var sqliteUp []byte
+
+//go:embed down.sql
+var down []byte
+
+// Migration creates the table holding the version constraints of installed modules.
+var Migration = pkg_models.SchemaMigration{
+	Version: 4,
+	Name:    "mod_version_constraints",
+	Up:      up,
+	Down:    down,
+}
+
+// SQLiteMigration creates the table holding the version constraints of installed modules for the SQLite dialect.
+var SQLiteMigration = pkg_models.SchemaMigration{
+	Version: 4,
+	Name:    "mod_version_constraints",
+	Up:      sqliteUp,
+	Down:    down,
+}
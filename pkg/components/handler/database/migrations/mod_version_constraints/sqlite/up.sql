@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS mod_version_constraints
+(
+    mod_id         VARCHAR(256) NOT NULL,
+    ver_constraint VARCHAR(256) NOT NULL,
+    updated        TEXT         NOT NULL,
+    PRIMARY KEY (mod_id),
+    FOREIGN KEY (mod_id) REFERENCES modules (id) ON DELETE CASCADE ON UPDATE RESTRICT
+);
@@ -42,8 +42,13 @@ var Migration = migration{
 	dropTables,
 }
 
-func (m migration) Required(_ context.Context, _ *sql.DB) (bool, error) {
-	return true, nil
+// Required returns false if the database schema is already versioned.
+func (m migration) Required(ctx context.Context, db *sql.DB) (bool, error) {
+	ok, err := tableExists(ctx, db, "schema_migrations")
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
 }
 
 func (m migration) Run(ctx context.Context, db *sql.DB) error {
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	migrations_db "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/database/migrations"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+var testMigrations = []pkg_models.SchemaMigration{
+	{
+		Version: 1,
+		Name:    "test_a",
+		Up:      []byte("CREATE TABLE test_a (id CHAR(36) NOT NULL, PRIMARY KEY (id));"),
+		Down:    []byte("DROP TABLE test_a;"),
+	},
+	{
+		Version: 2,
+		Name:    "test_b",
+		Up:      []byte("CREATE TABLE test_b (id CHAR(36) NOT NULL, PRIMARY KEY (id));\nINSERT INTO test_b (id) VALUES ('test');"),
+		Down:    []byte("DROP TABLE test_b;"),
+	},
+}
+
+func TestHandler_Migrate(t *testing.T) {
+	ctx := context.Background()
+	h := New(newSQLiteDB(t))
+	if err := h.Migrate(ctx, testMigrations[:1]); err != nil {
+		t.Fatal(err)
+	}
+	status, err := h.MigrationsStatus(ctx, testMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status) != 2 || status[0].State != MigrationStateApplied || status[1].State != MigrationStatePending {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if err = h.Migrate(ctx, testMigrations); err != nil {
+		t.Fatal(err)
+	}
+	var id string
+	if err = h.sqlDB.QueryRowContext(ctx, "SELECT id FROM test_b;").Scan(&id); err != nil || id != "test" {
+		t.Errorf("expected test, got %s, %v", id, err)
+	}
+	t.Run("checksum mismatch", func(t *testing.T) {
+		changed := []pkg_models.SchemaMigration{testMigrations[0], testMigrations[1]}
+		changed[1].Up = []byte("CREATE TABLE test_c (id CHAR(36) NOT NULL, PRIMARY KEY (id));")
+		if err = h.Migrate(ctx, changed); err == nil {
+			t.Error("expected error")
+		}
+		status, err = h.MigrationsStatus(ctx, changed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status[1].State != MigrationStateChecksumMismatch {
+			t.Errorf("expected %s, got %s", MigrationStateChecksumMismatch, status[1].State)
+		}
+	})
+}
+
+func TestHandler_MigrateDown(t *testing.T) {
+	ctx := context.Background()
+	h := New(newSQLiteDB(t))
+	if err := h.Migrate(ctx, testMigrations); err != nil {
+		t.Fatal(err)
+	}
+	t.Run("newer schema", func(t *testing.T) {
+		if err := h.Migrate(ctx, testMigrations[:1]); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("not in order", func(t *testing.T) {
+		if err := h.Migrate(ctx, []pkg_models.SchemaMigration{testMigrations[1], testMigrations[0]}); err == nil {
+			t.Error("expected error")
+		}
+	})
+	if err := h.MigrateDown(ctx, testMigrations, 1); err != nil {
+		t.Fatal(err)
+	}
+	status, err := h.MigrationsStatus(ctx, testMigrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status[0].State != MigrationStateApplied || status[1].State != MigrationStatePending {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if _, err = h.sqlDB.ExecContext(ctx, "SELECT id FROM test_b;"); err == nil {
+		t.Error("expected table to be removed")
+	}
+	if err = h.Migrate(ctx, testMigrations); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHandler_MigrateDown_all(t *testing.T) {
+	ctx := context.Background()
+	h := newSQLiteHandler(t)
+	migrations := migrations_db.SQLite
+	if err := h.MigrateDown(ctx, migrations, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.sqlDB.ExecContext(ctx, "SELECT id FROM deployments;"); err == nil {
+		t.Error("expected table to be removed")
+	}
+	if err := h.Migrate(ctx, migrations); err != nil {
+		t.Error(err)
+	}
+}
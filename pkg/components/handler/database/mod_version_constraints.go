@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"time"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+// SetModuleVersionConstraint stores the version constraint of a module, an existing constraint is replaced.
+func (h *Handler) SetModuleVersionConstraint(ctx context.Context, constraint pkg_models.ModuleVersionConstraint) error {
+	tx, err := h.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, "DELETE FROM mod_version_constraints WHERE mod_id = ?;", constraint.ModuleId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO mod_version_constraints (mod_id, ver_constraint, updated) VALUES (?, ?, ?);",
+		constraint.ModuleId,
+		constraint.Constraint,
+		timeValue(constraint.Updated),
+	)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (h *Handler) ReadModuleVersionConstraints(ctx context.Context) (map[string]pkg_models.ModuleVersionConstraint, error) {
+	rows, err := h.sqlDB.QueryContext(ctx, "SELECT mod_id, ver_constraint, updated FROM mod_version_constraints;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	constraints := make(map[string]pkg_models.ModuleVersionConstraint)
+	for rows.Next() {
+		var constraint pkg_models.ModuleVersionConstraint
+		var ut []uint8
+		if err = rows.Scan(&constraint.ModuleId, &constraint.Constraint, &ut); err != nil {
+			return nil, err
+		}
+		if constraint.Updated, err = time.Parse(timeLayout, string(ut)); err != nil {
+			return nil, err
+		}
+		constraints[constraint.ModuleId] = constraint
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return constraints, nil
+}
+
+func (h *Handler) DeleteModuleVersionConstraint(ctx context.Context, moduleId string) error {
+	_, err := h.sqlDB.ExecContext(ctx, "DELETE FROM mod_version_constraints WHERE mod_id = ?;", moduleId)
+	return err
+}
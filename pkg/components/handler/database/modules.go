@@ -76,8 +76,8 @@ func (h *Handler) CreateModule(ctx context.Context, mod pkg_models.DatabaseModul
 		mod.DirName,
 		mod.Source,
 		mod.Channel,
-		mod.Added,
-		mod.Updated,
+		timeValue(mod.Added),
+		timeValue(mod.Updated),
 	)
 	if err != nil {
 		return err
@@ -86,7 +86,7 @@ func (h *Handler) CreateModule(ctx context.Context, mod pkg_models.DatabaseModul
 }
 
 func (h *Handler) UpdateModule(ctx context.Context, mod pkg_models.DatabaseModule) error {
-	_, err := h.sqlDB.ExecContext(ctx, "UPDATE modules SET dir = ?, source = ?, channel = ?, updated = ? WHERE id = ?;", mod.DirName, mod.Source, mod.Channel, mod.Updated, mod.Id)
+	_, err := h.sqlDB.ExecContext(ctx, "UPDATE modules SET dir = ?, source = ?, channel = ?, updated = ? WHERE id = ?;", mod.DirName, mod.Source, mod.Channel, timeValue(mod.Updated), mod.Id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_slices "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/slices"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// CreateDeploymentRuntimeEvent stores the event and removes the oldest events of the deployment if the number of
+// stored events exceeds maxEntries.
+func (h *Handler) CreateDeploymentRuntimeEvent(ctx context.Context, event lib_models.DeploymentRuntimeEvent, maxEntries int) error {
+	containersBefore, err := json.Marshal(event.ContainersBefore)
+	if err != nil {
+		return err
+	}
+	containersAfter, err := json.Marshal(event.ContainersAfter)
+	if err != nil {
+		return err
+	}
+	tx, err := h.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO dep_runtime_events (dep_id, aux_dep_id, timestamp, action, reason, containers_before, containers_after, error) VALUES (?, ?, ?, ?, ?, ?, ?, ?);",
+		event.DeploymentId,
+		sql.NullString{String: event.AuxiliaryDeploymentId, Valid: event.AuxiliaryDeploymentId != ""},
+		timeValue(event.Timestamp),
+		event.Action,
+		event.Reason,
+		containersBefore,
+		containersAfter,
+		sql.NullString{String: event.Error, Valid: event.Error != ""},
+	)
+	if err != nil {
+		return err
+	}
+	if maxEntries > 0 {
+		var id int64
+		err = tx.QueryRowContext(
+			ctx,
+			"SELECT id FROM dep_runtime_events WHERE dep_id = ? ORDER BY id DESC LIMIT 1 OFFSET ?;",
+			event.DeploymentId,
+			maxEntries,
+		).Scan(&id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil {
+			_, err = tx.ExecContext(ctx, "DELETE FROM dep_runtime_events WHERE dep_id = ? AND id <= ?;", event.DeploymentId, id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// ReadDeploymentRuntimeEvents returns the events of a deployment, the newest event first.
+func (h *Handler) ReadDeploymentRuntimeEvents(
+	ctx context.Context,
+	deploymentId string,
+	filter lib_models.DeploymentRuntimeEventsFilter,
+) ([]lib_models.DeploymentRuntimeEvent, error) {
+	fc := []string{"dep_id = ?"}
+	args := []any{deploymentId}
+	if len(filter.AuxiliaryDeploymentIds) > 0 {
+		fc = append(fc, "aux_dep_id IN ("+genQuestionMarks(len(filter.AuxiliaryDeploymentIds))+")")
+		args = append(args, helper_slices.ToAny(filter.AuxiliaryDeploymentIds)...)
+	}
+	if len(filter.Actions) > 0 {
+		fc = append(fc, "action IN ("+genQuestionMarks(len(filter.Actions))+")")
+		args = append(args, helper_slices.ToAny(filter.Actions)...)
+	}
+	if !filter.Since.IsZero() {
+		fc = append(fc, "timestamp >= ?")
+		args = append(args, timeValue(filter.Since))
+	}
+	query := "SELECT dep_id, aux_dep_id, timestamp, action, reason, containers_before, containers_after, error FROM dep_runtime_events WHERE " + strings.Join(fc, " AND ") + " ORDER BY id DESC"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+	rows, err := h.sqlDB.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var events []lib_models.DeploymentRuntimeEvent
+	for rows.Next() {
+		var event lib_models.DeploymentRuntimeEvent
+		var auxDepId sql.NullString
+		var ts []uint8
+		var containersBefore []byte
+		var containersAfter []byte
+		var eventErr sql.NullString
+		err = rows.Scan(&event.DeploymentId, &auxDepId, &ts, &event.Action, &event.Reason, &containersBefore, &containersAfter, &eventErr)
+		if err != nil {
+			return nil, err
+		}
+		event.AuxiliaryDeploymentId = auxDepId.String
+		event.Error = eventErr.String
+		if event.Timestamp, err = time.Parse(timeLayout, string(ts)); err != nil {
+			logger.ErrorContext(ctx, "read deployment runtime events", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		}
+		if err = json.Unmarshal(containersBefore, &event.ContainersBefore); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(containersAfter, &event.ContainersAfter); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
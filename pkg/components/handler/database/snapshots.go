@@ -0,0 +1,163 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// CreateDeploymentSnapshot stores the snapshot and removes the oldest snapshots of the deployment if the number of
+// stored snapshots exceeds maxEntries. The IDs of the removed snapshots are returned.
+func (h *Handler) CreateDeploymentSnapshot(ctx context.Context, snapshot pkg_models.DeploymentSnapshot, maxEntries int) ([]string, error) {
+	userInput, err := json.Marshal(snapshot.UserInput)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := json.Marshal(snapshot.Containers)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := h.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO dep_snapshots (id, dep_id, mod_id, mod_source, mod_channel, mod_ver, user_input, containers, created) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);",
+		snapshot.Id,
+		snapshot.DeploymentId,
+		snapshot.ModuleId,
+		snapshot.ModuleSource,
+		snapshot.ModuleChannel,
+		snapshot.ModuleVersion,
+		userInput,
+		containers,
+		timeValue(snapshot.Created),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	if maxEntries > 0 {
+		rows, err := tx.QueryContext(
+			ctx,
+			"SELECT id FROM dep_snapshots WHERE dep_id = ? ORDER BY created DESC;",
+			snapshot.DeploymentId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for rows.Next() {
+			var id string
+			if err = rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if count++; count > maxEntries {
+				removed = append(removed, id)
+			}
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+		if len(removed) > 0 {
+			args := []any{snapshot.DeploymentId}
+			for _, id := range removed {
+				args = append(args, id)
+			}
+			_, err = tx.ExecContext(ctx, "DELETE FROM dep_snapshots WHERE dep_id = ? AND id IN ("+genQuestionMarks(len(removed))+");", args...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+func (h *Handler) ReadDeploymentSnapshot(ctx context.Context, id string) (pkg_models.DeploymentSnapshot, error) {
+	snapshots, err := h.readDeploymentSnapshots(ctx, "id = ?", id)
+	if err != nil {
+		return pkg_models.DeploymentSnapshot{}, err
+	}
+	if len(snapshots) == 0 {
+		return pkg_models.DeploymentSnapshot{}, lib_errors.New[lib_errors.ErrNotFound]("deployment snapshot not found")
+	}
+	return snapshots[0], nil
+}
+
+// ReadDeploymentSnapshots returns the snapshots of a deployment, the newest snapshot first.
+func (h *Handler) ReadDeploymentSnapshots(ctx context.Context, deploymentId string) ([]pkg_models.DeploymentSnapshot, error) {
+	return h.readDeploymentSnapshots(ctx, "dep_id = ?", deploymentId)
+}
+
+func (h *Handler) readDeploymentSnapshots(ctx context.Context, fc string, arg any) ([]pkg_models.DeploymentSnapshot, error) {
+	rows, err := h.sqlDB.QueryContext(
+		ctx,
+		"SELECT id, dep_id, mod_id, mod_source, mod_channel, mod_ver, user_input, containers, created FROM dep_snapshots WHERE "+fc+" ORDER BY created DESC;",
+		arg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var snapshots []pkg_models.DeploymentSnapshot
+	for rows.Next() {
+		var snapshot pkg_models.DeploymentSnapshot
+		var userInput, containers []byte
+		var ct []uint8
+		err = rows.Scan(
+			&snapshot.Id,
+			&snapshot.DeploymentId,
+			&snapshot.ModuleId,
+			&snapshot.ModuleSource,
+			&snapshot.ModuleChannel,
+			&snapshot.ModuleVersion,
+			&userInput,
+			&containers,
+			&ct,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot.Created, err = time.Parse(timeLayout, string(ct)); err != nil {
+			logger.ErrorContext(ctx, "read deployment snapshots", slog_keys.DeploymentId, snapshot.DeploymentId, slog_keys.Error, err)
+		}
+		if err = json.Unmarshal(userInput, &snapshot.UserInput); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(containers, &snapshot.Containers); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
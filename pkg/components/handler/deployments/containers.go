@@ -22,6 +22,7 @@ import (
 	"maps"
 	"path"
 	"slices"
+	"strings"
 
 	cew_model "github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
 	helper_configs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/configs"
@@ -39,7 +40,9 @@ func (h *Handler) createContainers(
 	ctx context.Context,
 	moduleConfigs external_models.ModuleLibConfigs,
 	moduleServices map[string]external_models.ModuleLibService,
+	servicesGraph dependencyGraph,
 	deploymentId string,
+	deploymentToken string,
 	deploymentDirName string,
 	deploymentFilesDirName string,
 	userDataSecrets map[string]pkg_models.DeploymentSecret,
@@ -53,14 +56,22 @@ func (h *Handler) createContainers(
 	cacheHostResources map[string]external_models.HmHostResource,
 ) error {
 	var errs []error
-	for reference, service := range moduleServices {
-		envVariables := make(map[string]string)
-		setConfigEnvVariables(envVariables, service.Configs, configsToStrings(moduleConfigs, configs))
-		setSecretValueEnvVariables(envVariables, service.SecretVars, userDataSecrets, cacheSecretValues)
-		setInternalDependencyEnvVariables(envVariables, service.SrvReferences, containers)
-		setExternalDependencyEnvVariables(envVariables, service.ExtDependencies, cacheDeployments)
-		envVariables[constants.EnvVariableCoreId] = helper_naming.CoreId
-		envVariables[constants.EnvVariableDeploymentId] = deploymentId
+	configValues := configsToStrings(moduleConfigs, configs)
+	for _, reference := range servicesGraph.order {
+		service, ok := moduleServices[reference]
+		if !ok {
+			continue
+		}
+		envVariables := getEnvVariables(
+			service,
+			deploymentId,
+			configValues,
+			userDataSecrets,
+			containers,
+			cacheSecretValues,
+			cacheDeployments,
+		)
+		envVariables[constants.EnvVariableDeploymentToken] = deploymentToken
 		var mounts []external_models.CewMount
 		mounts = appendIncludeMounts(mounts, service.BindMounts, deploymentDirName, h.config.HostWorkdirPath)
 		mounts = appendTmpfsMounts(mounts, service.Tmpfs)
@@ -98,9 +109,10 @@ func (h *Handler) createContainers(
 func (h *Handler) removeContainers(
 	ctx context.Context,
 	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 ) error {
 	var errs []error
-	for _, container := range deploymentContainers {
+	for _, container := range slices.Backward(orderContainers(deploymentContainers, servicesGraph.order)) {
 		err := helper_containers.Remove(ctx, h.containerEngineWrapperClient, container.Name)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("'%s' %w", container.Name, err))
@@ -112,14 +124,41 @@ func (h *Handler) removeContainers(
 	return nil
 }
 
+// startContainers starts the containers after their dependencies. If healthy dependencies are required, a container is
+// only started after the containers it depends on are healthy.
 func (h *Handler) startContainers(
 	ctx context.Context,
 	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 ) error {
 	var errs []error
-	for _, container := range deploymentContainers {
+	failed := make(map[string]struct{})
+	for _, container := range orderContainers(deploymentContainers, servicesGraph.order) {
+		var dependencies []pkg_models.DeploymentContainerBase
+		var failedDependencies []string
+		for _, reference := range servicesGraph.dependencies[container.Reference] {
+			if _, ok := failed[reference]; ok {
+				failedDependencies = append(failedDependencies, reference)
+			}
+			if dependency, ok := deploymentContainers[reference]; ok {
+				dependencies = append(dependencies, dependency)
+			}
+		}
+		if len(failedDependencies) > 0 {
+			failed[container.Reference] = struct{}{}
+			errs = append(errs, fmt.Errorf("'%s' dependencies not started: %s", container.Reference, strings.Join(failedDependencies, ", ")))
+			continue
+		}
+		if h.config.WaitForHealthyDependencies {
+			if err := h.awaitHealthyContainers(ctx, dependencies); err != nil {
+				failed[container.Reference] = struct{}{}
+				errs = append(errs, fmt.Errorf("'%s' %w", container.Reference, err))
+				continue
+			}
+		}
 		err := h.containerEngineWrapperClient.StartContainer(ctx, container.Name)
 		if err != nil {
+			failed[container.Reference] = struct{}{}
 			errs = append(errs, fmt.Errorf("'%s' %w", container.Reference, err))
 		}
 	}
@@ -132,6 +171,7 @@ func (h *Handler) startContainers(
 func (h *Handler) stopContainers(
 	ctx context.Context,
 	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 ) error {
 	containers, err := h.containerEngineWrapperClient.GetContainers(ctx, external_models.CewContainersFilter{
 		Names: helper_slices.CollectFunc(maps.Values(deploymentContainers), func(item pkg_models.DeploymentContainerBase) string {
@@ -142,7 +182,7 @@ func (h *Handler) stopContainers(
 		return err
 	}
 	var errs []error
-	for _, container := range deploymentContainers {
+	for _, container := range slices.Backward(orderContainers(deploymentContainers, servicesGraph.order)) {
 		if !slices.ContainsFunc(containers, func(item external_models.CewContainer) bool {
 			return item.Name == container.Name
 		}) {
@@ -197,6 +237,25 @@ func getCewContainer(
 	}
 }
 
+func getEnvVariables(
+	service external_models.ModuleLibService,
+	deploymentId string,
+	configValues map[string]string,
+	userDataSecrets map[string]pkg_models.DeploymentSecret,
+	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	cacheSecretValues map[string]external_models.SmSecretValueVariant,
+	cacheDeployments map[string]deploymentsCacheItem,
+) map[string]string {
+	envVariables := make(map[string]string)
+	setConfigEnvVariables(envVariables, service.Configs, configValues)
+	setSecretValueEnvVariables(envVariables, service.SecretVars, userDataSecrets, cacheSecretValues)
+	setInternalDependencyEnvVariables(envVariables, service.SrvReferences, deploymentContainers)
+	setExternalDependencyEnvVariables(envVariables, service.ExtDependencies, cacheDeployments)
+	envVariables[constants.EnvVariableCoreId] = helper_naming.CoreId
+	envVariables[constants.EnvVariableDeploymentId] = deploymentId
+	return envVariables
+}
+
 func setConfigEnvVariables(
 	envVariables map[string]string,
 	serviceConfigs map[string]string,
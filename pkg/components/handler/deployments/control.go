@@ -51,6 +51,8 @@ func (h *Handler) EnableDeployments(ctx context.Context, moduleIds []string) ([]
 		)
 		return nil, err
 	}
+	// allow crash-looping deployments to be restarted
+	h.restartsRemove(ids...)
 	return ids, nil
 }
 
@@ -82,3 +84,30 @@ func (h *Handler) DisableDeployments(ctx context.Context, moduleIds []string) ([
 	}
 	return ids, nil
 }
+
+func (h *Handler) EnableDeployment(ctx context.Context, id string) error {
+	return h.setDeploymentEnabledState(ctx, id, true)
+}
+
+func (h *Handler) DisableDeployment(ctx context.Context, id string) error {
+	return h.setDeploymentEnabledState(ctx, id, false)
+}
+
+func (h *Handler) setDeploymentEnabledState(ctx context.Context, id string, state bool) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.databaseHandler.ReadDeployment(ctx, id)
+	if err != nil {
+		logger.ErrorContext(ctx, "set deployment enabled state, read from database", slog_keys.DeploymentId, id, slog_keys.Error, err)
+		return err
+	}
+	err = h.databaseHandler.UpdateDeploymentsEnabledState(ctx, []string{id}, state)
+	if err != nil {
+		logger.ErrorContext(ctx, "set deployment enabled state, write to database", slog_keys.DeploymentId, id, slog_keys.Error, err)
+		return err
+	}
+	if state {
+		h.restartsRemove(id)
+	}
+	return nil
+}
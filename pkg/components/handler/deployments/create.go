@@ -26,10 +26,12 @@ import (
 	"path"
 	"slices"
 
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_file_sys "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/file_sys"
 	helper_maps "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/maps"
 	helper_naming "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/naming"
+	helper_progress "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/progress"
 	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	helper_uuid "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/uuid"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
@@ -61,23 +63,42 @@ func (h *Handler) CreateDeployments(
 		logger.ErrorContext(ctx, "create deployments, initialize cache", slog_keys.Error, err)
 		return nil, err
 	}
+	modulesGraph, err := getModulesGraph(selectedModules)
+	if err != nil {
+		logger.ErrorContext(ctx, "create deployments, get modules order", slog_keys.Error, err)
+		return nil, err
+	}
+	helper_progress.SetSteps(ctx, len(selectedModules))
+	for moduleId := range selectedModules {
+		helper_progress.SetItem(ctx, moduleId, lib_constants.JobItemPending)
+	}
 	var results []lib_models.DeploymentResult
-	for moduleId, module := range selectedModules {
+	for _, moduleId := range modulesGraph.order {
+		module := selectedModules[moduleId]
 		cacheItem := cache.Deployments[moduleId]
 		result := lib_models.DeploymentResult{
 			ModuleId: moduleId,
 			Id:       cacheItem.DeploymentId,
 		}
+		helper_progress.NextStep(ctx, fmt.Sprintf("creating deployment of module '%s'", moduleId))
+		helper_progress.SetItem(ctx, moduleId, lib_constants.JobItemRunning)
+		rb := newRollback(cacheItem.DeploymentId)
 		err = h.createDeployment(
 			ctx,
 			module,
+			"",
 			userInputs[moduleId],
 			cacheItem.DeploymentId,
 			cacheItem.Containers,
 			cache,
+			rb,
 		)
 		if err != nil {
 			result.ErrorResult = lib_models.NewErrorResult(err.Error())
+			result.Rollback = rb.run(ctx)
+			helper_progress.SetItem(ctx, moduleId, lib_constants.JobItemFailed)
+		} else {
+			helper_progress.SetItem(ctx, moduleId, lib_constants.JobItemDone)
 		}
 		results = append(results, result)
 	}
@@ -87,12 +108,14 @@ func (h *Handler) CreateDeployments(
 func (h *Handler) createDeployment(
 	ctx context.Context,
 	module pkg_models.Module,
+	name string,
 	userInput pkg_models.DeploymentUserInput,
 	deploymentId string,
 	cacheContainers map[string]containerCacheItem,
 	cache cacheCollection,
+	rb *rollback,
 ) error {
-	newDeployment, err := getDeployment(module, deploymentId)
+	newDeployment, err := getDeployment(module, name, deploymentId)
 	if err != nil {
 		logger.ErrorContext(ctx, "create deployment, generate new deployment", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
@@ -109,9 +132,10 @@ func (h *Handler) createDeployment(
 		logger.ErrorContext(ctx, "create deployment, get user data", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
-	err = h.updateCaches(
+	cache, err = h.updateCaches(
 		ctx,
 		module.Dependencies,
+		userData.DependencyTargets,
 		userData.HostResources,
 		userData.Secrets,
 		userData.GlobalConfigs,
@@ -142,6 +166,11 @@ func (h *Handler) createDeployment(
 		logger.ErrorContext(ctx, "create deployment, generate new containers", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
+	servicesGraph, err := getServicesGraph(module.Services)
+	if err != nil {
+		logger.ErrorContext(ctx, "create deployment, get services order", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
+		return err
+	}
 	newVolumes := getNewVolumes(module.Volumes, deploymentId)
 	err = h.databaseHandler.CreateDeployment(
 		ctx,
@@ -154,11 +183,21 @@ func (h *Handler) createDeployment(
 		slices.Collect(maps.Values(userData.FileGroups)),
 		slices.Collect(maps.Values(newVolumes)),
 		slices.Collect(maps.Values(newContainers)),
+		slices.Collect(maps.Values(userData.DependencyTargets)),
 	)
 	if err != nil {
 		logger.ErrorContext(ctx, "create deployment, write to database", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
+	rb.add("remove database entry", func(ctx context.Context) error {
+		return h.databaseHandler.DeleteDeployment(ctx, deploymentId)
+	})
+	rb.add("remove volumes", func(ctx context.Context) error {
+		return h.removeContainerVolumes(ctx, newVolumes)
+	})
+	rb.add("remove directories", func(ctx context.Context) error {
+		return h.removeDeploymentDirs(newDeployment.DirName, newDeployment.FilesDirName)
+	})
 	err = h.ensureDeploymentEnvironment(
 		ctx,
 		module.Services,
@@ -172,6 +211,9 @@ func (h *Handler) createDeployment(
 		logger.ErrorContext(ctx, "create deployment, ensure environment", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
+	rb.add("remove secret mounts", func(ctx context.Context) error {
+		return h.removeSecretMounts(ctx, deploymentId)
+	})
 	bindMounts, err := h.getBindMounts(
 		ctx,
 		deploymentId,
@@ -184,12 +226,21 @@ func (h *Handler) createDeployment(
 		logger.ErrorContext(ctx, "create deployment, get bind mounts", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
-	// TODO "mount secrets" must be "unloaded" if one of the following steps fail
+	token, err := h.rotateCredential(ctx, deploymentId, rb)
+	if err != nil {
+		logger.ErrorContext(ctx, "create deployment, issue credential", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
+		return err
+	}
+	rb.add("remove containers", func(ctx context.Context) error {
+		return h.removeContainers(ctx, newContainers, servicesGraph)
+	})
 	err = h.createContainers(
 		ctx,
 		module.Configs,
 		module.Services,
+		servicesGraph,
 		deploymentId,
+		token,
 		newDeployment.DirName,
 		newDeployment.FilesDirName,
 		userData.Secrets,
@@ -206,11 +257,15 @@ func (h *Handler) createDeployment(
 		logger.ErrorContext(ctx, "create deployment, create containers", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
+	rb.add("remove http endpoints", func(ctx context.Context) error {
+		return h.removeHttpEndpoints(ctx, deploymentId)
+	})
 	err = h.createHttpEndpoints(ctx, module.Services, module.ID, deploymentId, newContainers)
 	if err != nil {
 		logger.ErrorContext(ctx, "create deployment, create http endpoints", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
 		return err
 	}
+	h.createSnapshot(ctx, module, newDeployment, userInput, newContainers)
 	logger.InfoContext(ctx, "create deployment", slog_keys.ModuleId, module.ID, slog_keys.DeploymentId, deploymentId)
 	return nil
 }
@@ -258,6 +313,7 @@ func (h *Handler) filterSelectedModules(
 ) (map[string]pkg_models.Module, error) {
 	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{
 		ModuleIds: slices.Collect(maps.Keys(selectedModules)),
+		Names:     []string{""},
 	})
 	if err != nil {
 		return nil, err
@@ -315,11 +371,16 @@ func getUserData(
 	data.Configs = getProvidedConfigs(module.Configs, defaultData.Configs, userInput.Configs, deploymentId)
 	data.Files = getProvidedFiles(module.Files, defaultData.Files, userInput.Files, deploymentId)
 	data.FileGroups = getProvidedFileGroups(module.FileGroups, userInput.FileGroups, deploymentId)
+	data.DependencyTargets, err = getSelectedDependencyTargets(module.Dependencies, userInput.DependencyTargets, deploymentId)
+	if err != nil {
+		return userDataCollection{}, err
+	}
 	return data, nil
 }
 
 func getDeployment(
 	module pkg_models.Module,
+	name string,
 	deploymentId string,
 ) (pkg_models.DeploymentBase, error) {
 	if deploymentId == "" {
@@ -332,6 +393,7 @@ func getDeployment(
 	return pkg_models.DeploymentBase{
 		Id:            deploymentId,
 		ModuleId:      module.ID,
+		Name:          name,
 		ModuleSource:  module.Source,
 		ModuleChannel: module.Channel,
 		ModuleVersion: module.Version,
@@ -343,27 +405,35 @@ func getDeployment(
 func initDeploymentsCacheFromModules(modules map[string]pkg_models.Module) (map[string]deploymentsCacheItem, error) {
 	cache := make(map[string]deploymentsCacheItem)
 	for moduleId, module := range modules {
-		id, err := helper_uuid.New()
+		cacheItem, err := newDeploymentsCacheItem(module)
 		if err != nil {
 			return nil, err
 		}
-		containers := make(map[string]containerCacheItem)
-		for reference := range module.Services {
-			name, err := helper_naming.NewContainerName(constants.DeploymentAbbreviation)
-			if err != nil {
-				return nil, err
-			}
-			containers[reference] = containerCacheItem{
-				Name:  name,
-				Alias: helper_naming.NewContainerAlias(id, reference),
-			}
+		cache[moduleId] = cacheItem
+	}
+	return cache, nil
+}
+
+func newDeploymentsCacheItem(module pkg_models.Module) (deploymentsCacheItem, error) {
+	id, err := helper_uuid.New()
+	if err != nil {
+		return deploymentsCacheItem{}, err
+	}
+	containers := make(map[string]containerCacheItem)
+	for reference := range module.Services {
+		name, err := helper_naming.NewContainerName(constants.DeploymentAbbreviation)
+		if err != nil {
+			return deploymentsCacheItem{}, err
 		}
-		cache[moduleId] = deploymentsCacheItem{
-			DeploymentId: id,
-			Containers:   containers,
+		containers[reference] = containerCacheItem{
+			Name:  name,
+			Alias: helper_naming.NewContainerAlias(id, reference),
 		}
 	}
-	return cache, nil
+	return deploymentsCacheItem{
+		DeploymentId: id,
+		Containers:   containers,
+	}, nil
 }
 
 func getNewVolumes(moduleVolumes map[string]struct{}, deploymentId string) map[string]pkg_models.DeploymentVolume {
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"slices"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
+	helper_token "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/token"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+// AuthenticateDeployment returns the ID of the deployment the token was issued to.
+func (h *Handler) AuthenticateDeployment(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", lib_errors.New[lib_errors.ErrUnauthorized]("missing deployment token")
+	}
+	credential, err := h.databaseHandler.ReadDeploymentCredentialByTokenHash(ctx, helper_token.Hash(token))
+	if err != nil {
+		if lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			return "", lib_errors.New[lib_errors.ErrUnauthorized]("invalid deployment token")
+		}
+		return "", err
+	}
+	return credential.DeploymentId, nil
+}
+
+// GetModulesWithoutCredentials returns the IDs of modules with deployments that have no credential. Deployments
+// created before credentials were introduced have to be recreated to receive a token.
+func (h *Handler) GetModulesWithoutCredentials(ctx context.Context) ([]string, error) {
+	deployments, err := h.databaseHandler.ReadDeploymentsWithoutCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var moduleIds []string
+	for _, moduleId := range deployments {
+		if !slices.Contains(moduleIds, moduleId) {
+			moduleIds = append(moduleIds, moduleId)
+		}
+	}
+	return moduleIds, nil
+}
+
+// rotateCredential issues a new token and replaces the stored credential. On rollback the previous credential is
+// restored, as previous containers still use the previous token.
+func (h *Handler) rotateCredential(ctx context.Context, deploymentId string, rb *rollback) (string, error) {
+	previous, err := h.databaseHandler.ReadDeploymentCredential(ctx, deploymentId)
+	if err != nil && !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+		return "", err
+	}
+	token, err := helper_token.New()
+	if err != nil {
+		return "", err
+	}
+	err = h.databaseHandler.SetDeploymentCredential(ctx, pkg_models.DeploymentCredential{
+		DeploymentId: deploymentId,
+		TokenHash:    helper_token.Hash(token),
+		Created:      helper_time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	rb.add("restore previous credential", func(ctx context.Context) error {
+		if previous.DeploymentId == "" {
+			return h.databaseHandler.DeleteDeploymentCredential(ctx, deploymentId)
+		}
+		return h.databaseHandler.SetDeploymentCredential(ctx, previous)
+	})
+	return token, nil
+}
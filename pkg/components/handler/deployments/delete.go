@@ -19,9 +19,12 @@ package deployments
 import (
 	"context"
 	"errors"
+	"fmt"
 	"maps"
 	"slices"
+	"strings"
 
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_job "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/job"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
@@ -64,8 +67,16 @@ func (h *Handler) DeleteDeployments(
 		)
 		return nil, err
 	}
+	// dependents are deleted first, deployments are deleted in lexical order if the dependencies form a cycle
+	order := slices.Sorted(maps.Keys(deployments))
+	if deploymentsGraph, err := h.getDeploymentsGraph(ctx, deployments); err != nil {
+		logger.WarnContext(ctx, "delete deployments, get deployments order", slog_keys.DeploymentIds, deploymentIds, slog_keys.Error, err)
+	} else {
+		order = deploymentsGraph.reversed()
+	}
 	var results []lib_models.DeploymentResult
-	for id, deployment := range deployments {
+	for _, id := range order {
+		deployment := deployments[id]
 		result := lib_models.DeploymentResult{ModuleId: deployment.ModuleId, Id: deployment.Id}
 		err = h.deleteDeployment(
 			ctx,
@@ -73,6 +84,7 @@ func (h *Handler) DeleteDeployments(
 			deployment.DirName,
 			deployment.FilesDirName,
 			deploymentsContainers[id],
+			h.getDeploymentServicesGraph(ctx, deployment),
 			deploymentsVolumes[id],
 		)
 		if err != nil {
@@ -89,9 +101,22 @@ func (h *Handler) deleteDeployment(
 	deploymentDirName string,
 	deploymentFilesDirName string,
 	containers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 	volumes map[string]pkg_models.DeploymentVolume,
 ) error {
-	err := h.removeDeploymentEnvironment(ctx, deploymentId, deploymentDirName, deploymentFilesDirName, containers)
+	dependents, err := h.databaseHandler.ReadDeploymentsDependencyTargets(ctx, pkg_models.DeploymentDependencyTargetsFilter{
+		TargetIds: []string{deploymentId},
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "delete deployment, read dependents from database", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		return err
+	}
+	if len(dependents) > 0 {
+		return lib_errors.New[lib_errors.ErrInUse](
+			fmt.Sprintf("deployment is required by: %s", strings.Join(slices.Sorted(maps.Keys(dependents)), ", ")),
+		)
+	}
+	err = h.removeDeploymentEnvironment(ctx, deploymentId, deploymentDirName, deploymentFilesDirName, containers, servicesGraph)
 	if err != nil {
 		logger.ErrorContext(ctx, "delete deployment, remove environment", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
 		return err
@@ -111,6 +136,9 @@ func (h *Handler) deleteDeployment(
 		logger.ErrorContext(ctx, "delete deployment, remove from database", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
 		return err
 	}
+	if err = h.removeSnapshots(deploymentId); err != nil {
+		logger.ErrorContext(ctx, "delete deployment, remove snapshots", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+	}
 	logger.InfoContext(ctx, "delete deployment", slog_keys.DeploymentId, deploymentId)
 	return nil
 }
@@ -27,13 +27,19 @@ import (
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 )
 
+// updateDeploymentsCache adds the primary deployments of dependencies missing in the cache. Dependencies with a
+// selected target deployment are skipped.
 func (h *Handler) updateDeploymentsCache(
 	ctx context.Context,
 	moduleDependencies map[string]string,
+	userDataDependencyTargets map[string]pkg_models.DeploymentDependencyTarget,
 	cacheDeployments map[string]deploymentsCacheItem,
 ) error {
 	var idsNotInCache []string
 	for moduleId := range moduleDependencies {
+		if _, ok := userDataDependencyTargets[moduleId]; ok {
+			continue
+		}
 		if _, ok := cacheDeployments[moduleId]; !ok {
 			idsNotInCache = append(idsNotInCache, moduleId)
 		}
@@ -41,7 +47,10 @@ func (h *Handler) updateDeploymentsCache(
 	if len(idsNotInCache) == 0 {
 		return nil
 	}
-	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{ModuleIds: idsNotInCache})
+	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{
+		ModuleIds: idsNotInCache,
+		Names:     []string{""},
+	})
 	if err != nil {
 		return err
 	}
@@ -73,3 +82,74 @@ func (h *Handler) updateDeploymentsCache(
 	}
 	return nil
 }
+
+// getDependencyTargetsCache returns a copy of the provided cache with the selected target deployments in place of the
+// primary deployments of the respective dependencies.
+func (h *Handler) getDependencyTargetsCache(
+	ctx context.Context,
+	userDataDependencyTargets map[string]pkg_models.DeploymentDependencyTarget,
+	cacheDeployments map[string]deploymentsCacheItem,
+) (map[string]deploymentsCacheItem, error) {
+	if len(userDataDependencyTargets) == 0 {
+		return cacheDeployments, nil
+	}
+	var targetIds []string
+	for _, target := range userDataDependencyTargets {
+		targetIds = append(targetIds, target.TargetId)
+	}
+	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{Ids: targetIds})
+	if err != nil {
+		return nil, err
+	}
+	deploymentsContainers, err := h.databaseHandler.ReadDeploymentsContainers(ctx, targetIds)
+	if err != nil {
+		return nil, err
+	}
+	cache := maps.Clone(cacheDeployments)
+	for moduleId, target := range userDataDependencyTargets {
+		deployment, ok := deployments[target.TargetId]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("dependency target '%s' not found", target.TargetId))
+		}
+		if deployment.ModuleId != moduleId {
+			return nil, errors.New(fmt.Sprintf("dependency target '%s' is not a deployment of module '%s'", target.TargetId, moduleId))
+		}
+		containers := make(map[string]containerCacheItem)
+		for _, container := range deploymentsContainers[target.TargetId] {
+			containers[container.Reference] = containerCacheItem{
+				Name:  container.Name,
+				Alias: container.Alias,
+			}
+		}
+		cache[moduleId] = deploymentsCacheItem{
+			DeploymentId: target.TargetId,
+			Containers:   containers,
+		}
+	}
+	return cache, nil
+}
+
+func getSelectedDependencyTargets(
+	moduleDependencies map[string]string,
+	userInput map[string]string,
+	deploymentId string,
+) (map[string]pkg_models.DeploymentDependencyTarget, error) {
+	targets := make(map[string]pkg_models.DeploymentDependencyTarget)
+	for moduleId, targetId := range userInput {
+		if _, ok := moduleDependencies[moduleId]; !ok {
+			return nil, errors.New(fmt.Sprintf("'%s' is not a dependency", moduleId))
+		}
+		if targetId == "" {
+			continue
+		}
+		if targetId == deploymentId {
+			return nil, errors.New(fmt.Sprintf("'%s' invalid dependency target", moduleId))
+		}
+		targets[moduleId] = pkg_models.DeploymentDependencyTarget{
+			DeploymentId: deploymentId,
+			ModuleId:     moduleId,
+			TargetId:     targetId,
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"testing"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func Test_getSelectedDependencyTargets(t *testing.T) {
+	targets, err := getSelectedDependencyTargets(
+		map[string]string{"mod_a": "v1.0.0", "mod_b": "v1.0.0"},
+		map[string]string{"mod_a": "dep_a", "mod_b": ""},
+		"dep",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 {
+		t.Fatalf("expected 1 target, got %d", len(targets))
+	}
+	expected := pkg_models.DeploymentDependencyTarget{DeploymentId: "dep", ModuleId: "mod_a", TargetId: "dep_a"}
+	if targets["mod_a"] != expected {
+		t.Errorf("expected %v, got %v", expected, targets["mod_a"])
+	}
+	t.Run("not a dependency", func(t *testing.T) {
+		_, err = getSelectedDependencyTargets(map[string]string{}, map[string]string{"mod_a": "dep_a"}, "dep")
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("self", func(t *testing.T) {
+		_, err = getSelectedDependencyTargets(map[string]string{"mod_a": "v1.0.0"}, map[string]string{"mod_a": "dep"}, "dep")
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func Test_initInstancesCacheFromModulesAndDeployments(t *testing.T) {
+	modules := map[string]pkg_models.Module{"mod": {}}
+	deployments := map[string]pkg_models.DeploymentBase{
+		"dep":   {Id: "dep", ModuleId: "mod"},
+		"dep_b": {Id: "dep_b", ModuleId: "mod", Name: "b"},
+		"dep_a": {Id: "dep_a", ModuleId: "mod", Name: "a"},
+		"dep_x": {Id: "dep_x", ModuleId: "other", Name: "x"},
+	}
+	cache, err := initInstancesCacheFromModulesAndDeployments(modules, deployments, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cache) != 1 || len(cache["mod"]) != 2 {
+		t.Fatalf("expected 2 instances of mod, got %v", cache)
+	}
+	if cache["mod"][0].DeploymentId != "dep_a" || cache["mod"][1].DeploymentId != "dep_b" {
+		t.Errorf("expected [dep_a dep_b], got %v", cache["mod"])
+	}
+	primaryCache, err := initDeploymentsCacheFromModulesAndDeployments(modules, deployments, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(primaryCache) != 1 || primaryCache["mod"].DeploymentId != "dep" {
+		t.Errorf("expected primary deployment dep, got %v", primaryCache)
+	}
+}
@@ -20,6 +20,9 @@ import (
 	"os"
 	"sync"
 	"time"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
 )
 
 type Config struct {
@@ -30,6 +33,15 @@ type Config struct {
 	HostSecretsPath            string
 	RuntimeMonitorStartupDelay time.Duration
 	RuntimeMonitorLoopDelay    time.Duration
+	RestartBackoffDelay        time.Duration
+	RestartBackoffMaxDelay     time.Duration
+	RestartResetDelay          time.Duration
+	CrashLoopMaxRestarts       int
+	RestartUnhealthy           bool
+	RuntimeEventsMaxEntries    int
+	WaitForHealthyDependencies bool
+	DependencyHealthyTimeout   time.Duration
+	SnapshotsMaxEntries        int
 }
 
 type Handler struct {
@@ -38,10 +50,16 @@ type Handler struct {
 	hostManagerClient            hostManagerClient
 	secretManagerClient          secretManagerClient
 	coreManagerClient            coreManagerClient
+	eventsHandler                eventsHandler
 	config                       Config
 	mu                           sync.RWMutex
 	runtimeMonitorJobs           map[string]struct{}
 	runtimeMonitorJobsMu         sync.RWMutex
+	runtimeStates                map[string]lib_models.DeploymentStateEvent
+	restarts                     map[string]restartRecord
+	restartsMu                   sync.RWMutex
+	deploymentModules            map[string]external_models.ModuleLibModule
+	deploymentModulesMu          sync.RWMutex
 }
 
 func New(
@@ -50,6 +68,7 @@ func New(
 	hostManagerClient hostManagerClient,
 	secretManagerClient secretManagerClient,
 	coreManagerClient coreManagerClient,
+	eventsHandler eventsHandler,
 	config Config,
 ) *Handler {
 	return &Handler{
@@ -58,8 +77,12 @@ func New(
 		hostManagerClient:            hostManagerClient,
 		secretManagerClient:          secretManagerClient,
 		coreManagerClient:            coreManagerClient,
+		eventsHandler:                eventsHandler,
 		config:                       config,
 		runtimeMonitorJobs:           make(map[string]struct{}),
+		runtimeStates:                make(map[string]lib_models.DeploymentStateEvent),
+		restarts:                     make(map[string]restartRecord),
+		deploymentModules:            make(map[string]external_models.ModuleLibModule),
 	}
 }
 
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+// CreateDeploymentInstance creates an additional named deployment of a module with deployment type 'multiple'.
+// Dependencies of the instance resolve to the primary deployments of the required modules unless a target deployment
+// is selected.
+func (h *Handler) CreateDeploymentInstance(
+	ctx context.Context,
+	module pkg_models.Module,
+	name string,
+	userInput pkg_models.DeploymentUserInput,
+) (lib_models.DeploymentResult, error) {
+	if module.DeploymentType != external_models.ModuleLibMultipleDeployment {
+		return lib_models.DeploymentResult{}, lib_errors.New[lib_errors.ErrInvalidInput](
+			fmt.Sprintf("module '%s' does not support multiple deployments", module.ID),
+		)
+	}
+	if name == "" {
+		return lib_models.DeploymentResult{}, lib_errors.New[lib_errors.ErrInvalidInput]("empty instance name")
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{
+		ModuleIds: []string{module.ID},
+		Names:     []string{name},
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "create deployment instance, read from database", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
+		return lib_models.DeploymentResult{}, err
+	}
+	if len(deployments) > 0 {
+		return lib_models.DeploymentResult{}, lib_errors.New[lib_errors.ErrExists](
+			fmt.Sprintf("instance '%s' of module '%s' already exists", name, module.ID),
+		)
+	}
+	cacheItem, err := newDeploymentsCacheItem(module)
+	if err != nil {
+		logger.ErrorContext(ctx, "create deployment instance, initialize cache", slog_keys.Error, err)
+		return lib_models.DeploymentResult{}, err
+	}
+	cache := cacheCollection{
+		HostResources: make(map[string]external_models.HmHostResource),
+		GlobalConfigs: make(map[string]pkg_models.Config),
+		SecretValues:  make(map[string]external_models.SmSecretValueVariant),
+		Deployments:   make(map[string]deploymentsCacheItem),
+	}
+	result := lib_models.DeploymentResult{
+		ModuleId: module.ID,
+		Id:       cacheItem.DeploymentId,
+	}
+	rb := newRollback(cacheItem.DeploymentId)
+	err = h.createDeployment(
+		ctx,
+		module,
+		name,
+		userInput,
+		cacheItem.DeploymentId,
+		cacheItem.Containers,
+		cache,
+		rb,
+	)
+	if err != nil {
+		result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		result.Rollback = rb.run(ctx)
+	}
+	return result, nil
+}
+
+// UpdateDeployment updates a single deployment, the deployment must belong to the provided module.
+func (h *Handler) UpdateDeployment(
+	ctx context.Context,
+	module pkg_models.Module,
+	deploymentId string,
+	userInput pkg_models.DeploymentUserInput,
+) (lib_models.DeploymentResult, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	deployment, err := h.databaseHandler.ReadDeployment(ctx, deploymentId)
+	if err != nil {
+		logger.ErrorContext(ctx, "update deployment, read from database", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		return lib_models.DeploymentResult{}, err
+	}
+	if deployment.ModuleId != module.ID {
+		return lib_models.DeploymentResult{}, lib_errors.New[lib_errors.ErrInvalidInput](
+			fmt.Sprintf("deployment '%s' is not a deployment of module '%s'", deploymentId, module.ID),
+		)
+	}
+	deploymentsUserData, err := h.getDeploymentsUserDataFromDB(ctx, []string{deploymentId})
+	if err != nil {
+		logger.ErrorContext(ctx, "update deployment, read user data from database", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		return lib_models.DeploymentResult{}, err
+	}
+	deploymentsVolumes, deploymentsContainers, err := h.getDeploymentsVolumesAndContainersFromDB(ctx, []string{deploymentId})
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"update deployment, read volume and container data from database",
+			slog_keys.DeploymentId, deploymentId,
+			slog_keys.Error, err,
+		)
+		return lib_models.DeploymentResult{}, err
+	}
+	cacheItem, err := newDeploymentsCacheItemFromDeployment(module, deploymentId, deploymentsContainers[deploymentId])
+	if err != nil {
+		logger.ErrorContext(ctx, "update deployment, initialize cache", slog_keys.Error, err)
+		return lib_models.DeploymentResult{}, err
+	}
+	cache := cacheCollection{
+		HostResources: make(map[string]external_models.HmHostResource),
+		GlobalConfigs: make(map[string]pkg_models.Config),
+		SecretValues:  make(map[string]external_models.SmSecretValueVariant),
+		Deployments:   make(map[string]deploymentsCacheItem),
+	}
+	result := lib_models.DeploymentResult{
+		ModuleId: module.ID,
+		Id:       deploymentId,
+	}
+	rb := newRollback(deploymentId)
+	err = h.updateDeployment(
+		ctx,
+		module,
+		userInput,
+		deploymentId,
+		cacheItem.Containers,
+		currentDeploymentData{
+			Deployment: deployment,
+			UserData:   deploymentsUserData[deploymentId],
+			Containers: deploymentsContainers[deploymentId],
+			Volumes:    deploymentsVolumes[deploymentId],
+		},
+		cache,
+		rb,
+	)
+	if err != nil {
+		result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		result.Rollback = rb.run(ctx)
+	}
+	return result, nil
+}
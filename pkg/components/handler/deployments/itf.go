@@ -19,6 +19,7 @@ package deployments
 import (
 	"context"
 
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
 )
@@ -35,6 +36,7 @@ type databaseHandler interface {
 		fileGroups []pkg_models.DeploymentFileGroup,
 		volumes []pkg_models.DeploymentVolume,
 		containers []pkg_models.DeploymentContainerBase,
+		dependencyTargets []pkg_models.DeploymentDependencyTarget,
 	) error
 	ReadDeployment(ctx context.Context, id string) (pkg_models.DeploymentBase, error)
 	ReadDeployments(
@@ -73,9 +75,12 @@ type databaseHandler interface {
 		ctx context.Context,
 		deploymentIds []string,
 	) (map[string]map[string]pkg_models.DeploymentFileGroup, error)
+	ReadDeploymentsDependencyTargets(
+		ctx context.Context,
+		filter pkg_models.DeploymentDependencyTargetsFilter,
+	) (map[string]map[string]pkg_models.DeploymentDependencyTarget, error)
 	ReadGlobalConfigs(ctx context.Context, ids []string) (map[string]pkg_models.Config, error)
 	UpdateDeploymentsEnabledState(ctx context.Context, deploymentIds []string, state bool) error
-	UpdateDeploymentContainerNames(ctx context.Context, containers []pkg_models.DeploymentContainerBase) error
 	UpdateDeployment(
 		ctx context.Context,
 		deployment pkg_models.DeploymentBase,
@@ -87,8 +92,23 @@ type databaseHandler interface {
 		fileGroups []pkg_models.DeploymentFileGroup,
 		volumes []pkg_models.DeploymentVolume,
 		containers []pkg_models.DeploymentContainerBase,
+		dependencyTargets []pkg_models.DeploymentDependencyTarget,
 	) (err error)
 	DeleteDeployment(ctx context.Context, id string) error
+	CreateDeploymentRuntimeEvent(ctx context.Context, event lib_models.DeploymentRuntimeEvent, maxEntries int) error
+	ReadDeploymentRuntimeEvents(
+		ctx context.Context,
+		deploymentId string,
+		filter lib_models.DeploymentRuntimeEventsFilter,
+	) ([]lib_models.DeploymentRuntimeEvent, error)
+	CreateDeploymentSnapshot(ctx context.Context, snapshot pkg_models.DeploymentSnapshot, maxEntries int) ([]string, error)
+	ReadDeploymentSnapshot(ctx context.Context, id string) (pkg_models.DeploymentSnapshot, error)
+	ReadDeploymentSnapshots(ctx context.Context, deploymentId string) ([]pkg_models.DeploymentSnapshot, error)
+	SetDeploymentCredential(ctx context.Context, credential pkg_models.DeploymentCredential) error
+	ReadDeploymentCredential(ctx context.Context, deploymentId string) (pkg_models.DeploymentCredential, error)
+	ReadDeploymentCredentialByTokenHash(ctx context.Context, tokenHash string) (pkg_models.DeploymentCredential, error)
+	DeleteDeploymentCredential(ctx context.Context, deploymentId string) error
+	ReadDeploymentsWithoutCredential(ctx context.Context) (map[string]string, error)
 }
 
 type containerEngineWrapperClient interface {
@@ -125,3 +145,7 @@ type coreManagerClient interface {
 	GetJob(ctx context.Context, id string) (external_models.JobLibJob, error)
 	CancelJob(ctx context.Context, id string) error
 }
+
+type eventsHandler interface {
+	Publish(event lib_models.Event)
+}
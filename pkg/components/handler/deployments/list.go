@@ -68,10 +68,12 @@ func (h *Handler) GetReducedDeployments(
 	return h.getDeploymentsReduced(ctx, filter)
 }
 
+// GetReducedDeploymentsByModuleIds returns the primary deployments of modules keyed by module ID.
 func (h *Handler) GetReducedDeploymentsByModuleIds(
 	ctx context.Context,
 	filter pkg_models.DeploymentsFilterWithState,
 ) (map[string]pkg_models.DeploymentReduced, error) {
+	filter.Names = []string{""}
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 	deployments, err := h.getDeploymentsReduced(ctx, filter)
@@ -102,13 +104,17 @@ func (h *Handler) GetDeployment(ctx context.Context, id string) (pkg_models.Depl
 	return deployments[id], nil
 }
 
+// GetDeploymentByModuleId returns the primary deployment of a module.
 func (h *Handler) GetDeploymentByModuleId(ctx context.Context, moduleId string) (pkg_models.Deployment, error) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 	deployments, err := h.getDeployments(
 		ctx,
 		pkg_models.DeploymentsFilterWithState{
-			DeploymentsFilter: pkg_models.DeploymentsFilter{ModuleIds: []string{moduleId}},
+			DeploymentsFilter: pkg_models.DeploymentsFilter{
+				ModuleIds: []string{moduleId},
+				Names:     []string{""},
+			},
 		},
 	)
 	if err != nil {
@@ -149,10 +155,12 @@ func (h *Handler) GetDeployments(
 	return h.getDeployments(ctx, filter)
 }
 
+// GetDeploymentsByModuleIds returns the primary deployments of modules keyed by module ID.
 func (h *Handler) GetDeploymentsByModuleIds(
 	ctx context.Context,
 	filter pkg_models.DeploymentsFilterWithState,
 ) (map[string]pkg_models.Deployment, error) {
+	filter.Names = []string{""}
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 	deployments, err := h.getDeployments(ctx, filter)
@@ -194,7 +202,8 @@ func (h *Handler) getDeploymentsReduced(
 		}
 		if cewErr == nil {
 			if deployment.Enabled {
-				deployment.State = getDeploymentState(getContainersCombinedState(deploymentContainers, cewContainersMap))
+				deployment.State = h.getDeploymentState(id, getContainersCombinedState(deploymentContainers, cewContainersMap))
+				deployment.LastStartError = h.getStartError(id)
 			}
 			if len(notFound) > 0 {
 				deployment.Err = helper_errors.Join(getContainerNotFoundErrs(notFound)...)
@@ -239,19 +248,21 @@ func (h *Handler) getDeployments(
 		deploymentContainers := deploymentsContainers[id]
 		containers, notFound := getContainers(ctx, deploymentContainers, cewContainersMap)
 		deployment := pkg_models.Deployment{
-			DeploymentBase: stgDep,
-			Containers:     containers,
-			Volumes:        deploymentsVolumes[id],
-			HostResources:  deploymentsUserData[id].HostResources,
-			Secrets:        deploymentsUserData[id].Secrets,
-			Configs:        deploymentsUserData[id].Configs,
-			GlobalConfigs:  deploymentsUserData[id].GlobalConfigs,
-			Files:          deploymentsUserData[id].Files,
-			FileGroups:     deploymentsUserData[id].FileGroups,
+			DeploymentBase:    stgDep,
+			Containers:        containers,
+			Volumes:           deploymentsVolumes[id],
+			HostResources:     deploymentsUserData[id].HostResources,
+			Secrets:           deploymentsUserData[id].Secrets,
+			Configs:           deploymentsUserData[id].Configs,
+			GlobalConfigs:     deploymentsUserData[id].GlobalConfigs,
+			Files:             deploymentsUserData[id].Files,
+			FileGroups:        deploymentsUserData[id].FileGroups,
+			DependencyTargets: deploymentsUserData[id].DependencyTargets,
 		}
 		if cewErr == nil {
 			if deployment.Enabled {
-				deployment.State = getDeploymentState(getContainersCombinedState(deploymentContainers, cewContainersMap))
+				deployment.State = h.getDeploymentState(id, getContainersCombinedState(deploymentContainers, cewContainersMap))
+				deployment.LastStartError = h.getStartError(id)
 			}
 			if len(notFound) > 0 {
 				deployment.Err = helper_errors.Join(getContainerNotFoundErrs(notFound)...)
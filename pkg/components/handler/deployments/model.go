@@ -36,12 +36,13 @@ type defaultDataCollection struct {
 	Files   map[string][]byte
 }
 type userDataCollection struct {
-	GlobalConfigs map[string]pkg_models.DeploymentGlobalConfig
-	HostResources map[string]pkg_models.DeploymentHostResource
-	Secrets       map[string]pkg_models.DeploymentSecret
-	Configs       map[string]pkg_models.DeploymentUserConfig
-	Files         map[string]pkg_models.DeploymentFile
-	FileGroups    map[string]pkg_models.DeploymentFileGroup
+	GlobalConfigs     map[string]pkg_models.DeploymentGlobalConfig
+	HostResources     map[string]pkg_models.DeploymentHostResource
+	Secrets           map[string]pkg_models.DeploymentSecret
+	Configs           map[string]pkg_models.DeploymentUserConfig
+	Files             map[string]pkg_models.DeploymentFile
+	FileGroups        map[string]pkg_models.DeploymentFileGroup
+	DependencyTargets map[string]pkg_models.DeploymentDependencyTarget
 }
 
 type bindMountDataCollection struct {
@@ -0,0 +1,301 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	helper_graph "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/graph"
+	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_slices "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/slices"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+// dependencyGraph holds the dependencies of services or deployments and an order in which each item follows its
+// dependencies. Items are started in this order and stopped or removed in reverse order.
+type dependencyGraph struct {
+	order        []string
+	dependencies map[string][]string
+}
+
+func newDependencyGraph(nodes map[string][]string) (dependencyGraph, error) {
+	order, err := helper_graph.TopologicalOrder(nodes)
+	if err != nil {
+		return dependencyGraph{}, err
+	}
+	return dependencyGraph{order: order, dependencies: nodes}, nil
+}
+
+func (g dependencyGraph) reversed() []string {
+	order := slices.Clone(g.order)
+	slices.Reverse(order)
+	return order
+}
+
+// getServicesGraph uses required services and service references as dependencies.
+func getServicesGraph(moduleServices map[string]external_models.ModuleLibService) (dependencyGraph, error) {
+	nodes := make(map[string][]string)
+	for reference, service := range moduleServices {
+		nodes[reference] = append(nodes[reference], slices.Collect(maps.Keys(service.RequiredSrv))...)
+		for _, target := range service.SrvReferences {
+			nodes[reference] = append(nodes[reference], target.Ref)
+		}
+		for requiredBy := range service.RequiredBySrv {
+			if _, ok := moduleServices[requiredBy]; ok {
+				nodes[requiredBy] = append(nodes[requiredBy], reference)
+			}
+		}
+	}
+	graph, err := newDependencyGraph(nodes)
+	if err != nil {
+		return dependencyGraph{}, fmt.Errorf("services: %w", err)
+	}
+	return graph, nil
+}
+
+func getModulesGraph(modules map[string]pkg_models.Module) (dependencyGraph, error) {
+	nodes := make(map[string][]string)
+	for moduleId, module := range modules {
+		nodes[moduleId] = slices.Collect(maps.Keys(module.Dependencies))
+	}
+	graph, err := newDependencyGraph(nodes)
+	if err != nil {
+		return dependencyGraph{}, fmt.Errorf("modules: %w", err)
+	}
+	return graph, nil
+}
+
+// getDeploymentsGraph returns a graph of deployment IDs based on the dependencies of the deployed modules. Dependencies
+// resolve to the selected target deployment or the primary deployment of the module. Deployments with an unreadable
+// module are added without dependencies.
+func (h *Handler) getDeploymentsGraph(
+	ctx context.Context,
+	deployments map[string]pkg_models.DeploymentBase,
+) (dependencyGraph, error) {
+	moduleDeployments := make(map[string]string)
+	for id, deployment := range deployments {
+		if deployment.Name == "" {
+			moduleDeployments[deployment.ModuleId] = id
+		}
+	}
+	dependencyTargets, err := h.databaseHandler.ReadDeploymentsDependencyTargets(ctx, pkg_models.DeploymentDependencyTargetsFilter{
+		DeploymentIds: slices.Collect(maps.Keys(deployments)),
+	})
+	if err != nil {
+		return dependencyGraph{}, err
+	}
+	nodes := make(map[string][]string)
+	for id, deployment := range deployments {
+		nodes[id] = nil
+		module, err := h.getDeploymentModule(deployment.DirName)
+		if err != nil {
+			logger.WarnContext(ctx, "get deployments graph, read module", slog_keys.DeploymentId, id, slog_keys.Error, err)
+			continue
+		}
+		for moduleId := range module.Dependencies {
+			if target, ok := dependencyTargets[id][moduleId]; ok {
+				if _, ok = deployments[target.TargetId]; ok {
+					nodes[id] = append(nodes[id], target.TargetId)
+				}
+				continue
+			}
+			if dependencyId, ok := moduleDeployments[moduleId]; ok {
+				nodes[id] = append(nodes[id], dependencyId)
+			}
+		}
+	}
+	graph, err := newDependencyGraph(nodes)
+	if err != nil {
+		return dependencyGraph{}, fmt.Errorf("deployments: %w", err)
+	}
+	return graph, nil
+}
+
+// getDeploymentServicesGraph returns the services graph of the module copied to the deployment directory. An empty
+// graph is returned on error, the containers are then handled in lexical order.
+func (h *Handler) getDeploymentServicesGraph(ctx context.Context, deployment pkg_models.DeploymentBase) dependencyGraph {
+	module, err := h.getDeploymentModule(deployment.DirName)
+	if err != nil {
+		logger.WarnContext(ctx, "get services graph, read module", slog_keys.DeploymentId, deployment.Id, slog_keys.Error, err)
+		return dependencyGraph{}
+	}
+	graph, err := getServicesGraph(module.Services)
+	if err != nil {
+		logger.WarnContext(ctx, "get services graph", slog_keys.DeploymentId, deployment.Id, slog_keys.Error, err)
+		return dependencyGraph{}
+	}
+	return graph
+}
+
+// getDeploymentModule reads the modfile from the deployment directory, as it reflects the module version a deployment
+// was created with. Modules are cached by directory name, a new directory is created for each change of a deployment.
+func (h *Handler) getDeploymentModule(deploymentDirName string) (external_models.ModuleLibModule, error) {
+	h.deploymentModulesMu.RLock()
+	module, ok := h.deploymentModules[deploymentDirName]
+	h.deploymentModulesMu.RUnlock()
+	if ok {
+		return module, nil
+	}
+	if deploymentDirName == "" {
+		return external_models.ModuleLibModule{}, fmt.Errorf("empty directory name")
+	}
+	module, err := helper_modfile.GetModule(os.DirFS(path.Join(h.config.WorkdirPath, deploymentDirName)))
+	if err != nil {
+		return external_models.ModuleLibModule{}, err
+	}
+	h.deploymentModulesMu.Lock()
+	defer h.deploymentModulesMu.Unlock()
+	h.deploymentModules[deploymentDirName] = module
+	return module, nil
+}
+
+func (h *Handler) deploymentModulesRetain(deployments map[string]pkg_models.DeploymentBase) {
+	dirNames := make(map[string]struct{})
+	for _, deployment := range deployments {
+		dirNames[deployment.DirName] = struct{}{}
+	}
+	h.deploymentModulesMu.Lock()
+	defer h.deploymentModulesMu.Unlock()
+	for dirName := range h.deploymentModules {
+		if _, ok := dirNames[dirName]; !ok {
+			delete(h.deploymentModules, dirName)
+		}
+	}
+}
+
+// orderContainers returns the containers in the order of the graph. Containers not contained in the graph are
+// appended in lexical order of their references.
+func orderContainers(
+	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	order []string,
+) []pkg_models.DeploymentContainerBase {
+	containers := make([]pkg_models.DeploymentContainerBase, 0, len(deploymentContainers))
+	for _, reference := range order {
+		if container, ok := deploymentContainers[reference]; ok {
+			containers = append(containers, container)
+		}
+	}
+	var rest []pkg_models.DeploymentContainerBase
+	for reference, container := range deploymentContainers {
+		if !slices.Contains(order, reference) {
+			rest = append(rest, container)
+		}
+	}
+	slices.SortFunc(rest, func(a, b pkg_models.DeploymentContainerBase) int {
+		return strings.Compare(a.Reference, b.Reference)
+	})
+	return append(containers, rest...)
+}
+
+// awaitHealthyContainers waits until all containers are running and, if a health check is defined, healthy.
+func (h *Handler) awaitHealthyContainers(ctx context.Context, containers []pkg_models.DeploymentContainerBase) error {
+	if len(containers) == 0 {
+		return nil
+	}
+	names := helper_slices.CollectFunc(slices.Values(containers), func(item pkg_models.DeploymentContainerBase) string {
+		return item.Name
+	})
+	ctx, cf := context.WithTimeout(ctx, h.config.DependencyHealthyTimeout)
+	defer cf()
+	ticker := time.NewTicker(h.config.JobPollInterval)
+	defer ticker.Stop()
+	for {
+		cewContainers, err := h.containerEngineWrapperClient.GetContainers(ctx, external_models.CewContainersFilter{Names: names})
+		if err != nil {
+			return err
+		}
+		var healthyCount int
+		for _, cewContainer := range cewContainers {
+			if isContainerHealthy(cewContainer) {
+				healthyCount++
+			}
+		}
+		if healthyCount == len(names) {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("dependencies not healthy: %w", ctx.Err())
+		}
+	}
+}
+
+func isContainerHealthy(cewContainer external_models.CewContainer) bool {
+	return cewContainer.State == lib_constants.ContainerRunning && (cewContainer.Health == nil || *cewContainer.Health == lib_constants.ContainerHealthy)
+}
+
+// dependenciesReady checks if the enabled dependencies of a deployment are running. If healthy dependencies are
+// required, all containers of the dependencies must be healthy.
+func (h *Handler) dependenciesReady(
+	id string,
+	graph dependencyGraph,
+	deployments map[string]pkg_models.DeploymentBase,
+	deploymentsContainers map[string]map[string]pkg_models.DeploymentContainerBase,
+	cewContainersMap map[string]external_models.CewContainer,
+) bool {
+	for _, dependencyId := range graph.dependencies[id] {
+		dependency, ok := deployments[dependencyId]
+		if !ok || !dependency.Enabled {
+			continue
+		}
+		if h.config.WaitForHealthyDependencies {
+			for _, container := range deploymentsContainers[dependencyId] {
+				cewContainer, ok := cewContainersMap[container.Name]
+				if !ok || !isContainerHealthy(cewContainer) {
+					return false
+				}
+			}
+			continue
+		}
+		state := getContainersCombinedState(deploymentsContainers[dependencyId], cewContainersMap)
+		if state != containersStateRunning && state != containersStateUnhealthy {
+			return false
+		}
+	}
+	return true
+}
+
+// dependentsStopped checks if the disabled dependents of a deployment are no longer running.
+func dependentsStopped(
+	id string,
+	graph dependencyGraph,
+	deployments map[string]pkg_models.DeploymentBase,
+	deploymentsContainers map[string]map[string]pkg_models.DeploymentContainerBase,
+	cewContainersMap map[string]external_models.CewContainer,
+) bool {
+	for dependentId, dependencies := range graph.dependencies {
+		if !slices.Contains(dependencies, id) || deployments[dependentId].Enabled {
+			continue
+		}
+		state := getContainersCombinedState(deploymentsContainers[dependentId], cewContainersMap)
+		if state == containersStateRunning || state == containersStatePartial {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	helper_graph "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/graph"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+func Test_getServicesGraph(t *testing.T) {
+	graph, err := getServicesGraph(map[string]external_models.ModuleLibService{
+		"a": {RequiredSrv: map[string]struct{}{"b": {}}},
+		"b": {SrvReferences: map[string]external_models.ModuleLibSrvRefTarget{"B_HOST": {Ref: "c"}}},
+		"c": {RequiredBySrv: map[string]struct{}{"d": {}, "x": {}}},
+		"d": {},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(graph.order, []string{"c", "b", "a", "d"}) {
+		t.Errorf("expected [c b a d], got %v", graph.order)
+	}
+	if !slices.Equal(graph.reversed(), []string{"d", "a", "b", "c"}) {
+		t.Errorf("expected [d a b c], got %v", graph.reversed())
+	}
+	t.Run("cycle", func(t *testing.T) {
+		_, err = getServicesGraph(map[string]external_models.ModuleLibService{
+			"a": {RequiredSrv: map[string]struct{}{"b": {}}},
+			"b": {SrvReferences: map[string]external_models.ModuleLibSrvRefTarget{"A_HOST": {Ref: "a"}}},
+		})
+		var cycleErr *helper_graph.CycleError
+		if !errors.As(err, &cycleErr) {
+			t.Errorf("expected cycle error, got %v", err)
+		}
+	})
+}
+
+func Test_orderContainers(t *testing.T) {
+	containers := orderContainers(map[string]pkg_models.DeploymentContainerBase{
+		"a": {Reference: "a"},
+		"b": {Reference: "b"},
+		"c": {Reference: "c"},
+		"d": {Reference: "d"},
+	}, []string{"c", "x", "a"})
+	var references []string
+	for _, container := range containers {
+		references = append(references, container.Reference)
+	}
+	if !slices.Equal(references, []string{"c", "a", "b", "d"}) {
+		t.Errorf("expected [c a b d], got %v", references)
+	}
+}
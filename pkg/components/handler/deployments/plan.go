@@ -0,0 +1,429 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_url "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/url"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+const redactedValue = "<redacted>"
+
+// PlanCreateDeployments validates the user inputs like CreateDeployments without applying any changes. Deployment IDs
+// and container aliases are generated during execution, aliases contained in environment variables differ from the plan.
+func (h *Handler) PlanCreateDeployments(
+	ctx context.Context,
+	selectedModules map[string]pkg_models.Module,
+	userInputs map[string]pkg_models.DeploymentUserInput,
+) ([]lib_models.DeploymentPlan, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	cache := cacheCollection{
+		HostResources: make(map[string]external_models.HmHostResource),
+		GlobalConfigs: make(map[string]pkg_models.Config),
+		SecretValues:  make(map[string]external_models.SmSecretValueVariant),
+	}
+	var err error
+	selectedModules, err = h.filterSelectedModules(ctx, selectedModules)
+	if err != nil {
+		logger.ErrorContext(ctx, "plan create deployments, filter selected modules", slog_keys.Error, err)
+		return nil, err
+	}
+	cache.Deployments, err = initDeploymentsCacheFromModules(selectedModules)
+	if err != nil {
+		logger.ErrorContext(ctx, "plan create deployments, initialize cache", slog_keys.Error, err)
+		return nil, err
+	}
+	modulesGraph, err := getModulesGraph(selectedModules)
+	if err != nil {
+		return nil, err
+	}
+	images := make(map[string]bool)
+	var plans []lib_models.DeploymentPlan
+	for _, moduleId := range modulesGraph.order {
+		cacheItem := cache.Deployments[moduleId]
+		plan, err := h.getDeploymentPlan(
+			ctx,
+			selectedModules[moduleId],
+			userInputs[moduleId],
+			cacheItem.DeploymentId,
+			cacheItem.Containers,
+			currentDeploymentData{},
+			cache,
+			images,
+		)
+		plan.Id = ""
+		plan.Action = lib_constants.PlanActionCreate
+		if err != nil {
+			plan.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// PlanUpdateDeployments validates the user inputs like UpdateDeployments without applying any changes.
+func (h *Handler) PlanUpdateDeployments(
+	ctx context.Context,
+	selectedModules map[string]pkg_models.Module,
+	userInputs map[string]pkg_models.DeploymentUserInput,
+) ([]lib_models.DeploymentPlan, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	moduleIds := slices.Collect(maps.Keys(selectedModules))
+	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{
+		ModuleIds: moduleIds,
+		Names:     []string{""},
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "plan update deployments, read from database", slog_keys.ModuleIds, moduleIds, slog_keys.Error, err)
+		return nil, err
+	}
+	deploymentIds := slices.Collect(maps.Keys(deployments))
+	deploymentsUserData, err := h.getDeploymentsUserDataFromDB(ctx, deploymentIds)
+	if err != nil {
+		logger.ErrorContext(ctx, "plan update deployments, read user data from database", slog_keys.DeploymentIds, deploymentIds, slog_keys.Error, err)
+		return nil, err
+	}
+	deploymentsVolumes, deploymentsContainers, err := h.getDeploymentsVolumesAndContainersFromDB(ctx, deploymentIds)
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"plan update deployments, read volume and container data from database",
+			slog_keys.DeploymentIds, deploymentIds,
+			slog_keys.Error, err,
+		)
+		return nil, err
+	}
+	cache := cacheCollection{
+		HostResources: make(map[string]external_models.HmHostResource),
+		GlobalConfigs: make(map[string]pkg_models.Config),
+		SecretValues:  make(map[string]external_models.SmSecretValueVariant),
+	}
+	cache.Deployments, err = initDeploymentsCacheFromModulesAndDeployments(selectedModules, deployments, deploymentsContainers)
+	if err != nil {
+		logger.ErrorContext(ctx, "plan update deployments, initialize cache", slog_keys.Error, err)
+		return nil, err
+	}
+	modulesGraph, err := getModulesGraph(selectedModules)
+	if err != nil {
+		return nil, err
+	}
+	images := make(map[string]bool)
+	var plans []lib_models.DeploymentPlan
+	for _, moduleId := range modulesGraph.order {
+		cacheItem, ok := cache.Deployments[moduleId]
+		if !ok {
+			plans = append(plans, lib_models.DeploymentPlan{
+				ModuleId:    moduleId,
+				Action:      lib_constants.PlanActionUpdate,
+				ErrorResult: lib_models.NewErrorResult("deployment not found"),
+			})
+			continue
+		}
+		plan, err := h.getDeploymentPlan(
+			ctx,
+			selectedModules[moduleId],
+			userInputs[moduleId],
+			cacheItem.DeploymentId,
+			cacheItem.Containers,
+			currentDeploymentData{
+				Deployment: deployments[cacheItem.DeploymentId],
+				UserData:   deploymentsUserData[cacheItem.DeploymentId],
+				Containers: deploymentsContainers[cacheItem.DeploymentId],
+				Volumes:    deploymentsVolumes[cacheItem.DeploymentId],
+			},
+			cache,
+			images,
+		)
+		plan.Action = lib_constants.PlanActionUpdate
+		if err != nil {
+			plan.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// PlanDeleteDeployments returns the deployments DeleteDeployments would remove in the order of removal.
+func (h *Handler) PlanDeleteDeployments(
+	ctx context.Context,
+	filter pkg_models.DeploymentsFilterWithState,
+	allowAll bool,
+) ([]lib_models.DeploymentPlan, error) {
+	if !allowAll && filterEmpty(filter) {
+		return nil, nil
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	deployments, err := h.databaseHandler.ReadDeployments(ctx, filter.DeploymentsFilter)
+	if err != nil {
+		logger.ErrorContext(ctx, "plan delete deployments, read from database", slog_keys.Filter, filter, slog_keys.Error, err)
+		return nil, err
+	}
+	deploymentIds := slices.Collect(maps.Keys(deployments))
+	deploymentsVolumes, deploymentsContainers, err := h.getDeploymentsVolumesAndContainersFromDB(ctx, deploymentIds)
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"plan delete deployments, read volume and container data from database",
+			slog_keys.DeploymentIds, deploymentIds,
+			slog_keys.Error, err,
+		)
+		return nil, err
+	}
+	order := slices.Sorted(maps.Keys(deployments))
+	if deploymentsGraph, err := h.getDeploymentsGraph(ctx, deployments); err == nil {
+		order = deploymentsGraph.reversed()
+	}
+	var plans []lib_models.DeploymentPlan
+	for _, id := range order {
+		deployment := deployments[id]
+		plan := lib_models.DeploymentPlan{
+			ModuleId: deployment.ModuleId,
+			Id:       deployment.Id,
+			Action:   lib_constants.PlanActionRemove,
+			Volumes:  getPlanItems(map[string]struct{}{}, deploymentsVolumes[id], lib_constants.PlanActionRemove),
+		}
+		servicesGraph := h.getDeploymentServicesGraph(ctx, deployment)
+		for _, container := range slices.Backward(orderContainers(deploymentsContainers[id], servicesGraph.order)) {
+			plan.Containers = append(plan.Containers, lib_models.DeploymentPlanContainer{
+				Reference: container.Reference,
+				Name:      container.Name,
+				Action:    lib_constants.PlanActionRemove,
+			})
+		}
+		if module, err := h.getDeploymentModule(deployment.DirName); err == nil {
+			plan.Files = getPlanItems(map[string]struct{}{}, module.Files, lib_constants.PlanActionRemove)
+			plan.FileGroups = getPlanItems(map[string]struct{}{}, module.FileGroups, lib_constants.PlanActionRemove)
+			plan.HttpEndpoints = getHttpEndpointsPlan(nil, module.Services)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// getDeploymentPlan runs the validation steps of a deployment create or update. Previous containers, files and http
+// endpoints are determined by the module the current deployment was created with.
+func (h *Handler) getDeploymentPlan(
+	ctx context.Context,
+	module pkg_models.Module,
+	userInput pkg_models.DeploymentUserInput,
+	deploymentId string,
+	cacheContainers map[string]containerCacheItem,
+	current currentDeploymentData,
+	cache cacheCollection,
+	images map[string]bool,
+) (lib_models.DeploymentPlan, error) {
+	plan := lib_models.DeploymentPlan{
+		ModuleId: module.ID,
+		Id:       deploymentId,
+	}
+	defaultData, err := getDefaultData(module)
+	if err != nil {
+		return plan, err
+	}
+	userData, err := getUserData(module, defaultData, userInput, deploymentId)
+	if err != nil {
+		return plan, err
+	}
+	cache, err = h.updateCaches(
+		ctx,
+		module.Dependencies,
+		userData.DependencyTargets,
+		userData.HostResources,
+		userData.Secrets,
+		userData.GlobalConfigs,
+		cache,
+	)
+	if err != nil {
+		return plan, err
+	}
+	globalConfigs, err := getGlobalConfigs(module.Configs, userData.GlobalConfigs, cache.GlobalConfigs)
+	if err != nil {
+		return plan, err
+	}
+	mergedConfigs, mergedFiles, err := mergeDefaultAndUserData(
+		module,
+		defaultData,
+		userData.Configs,
+		userData.Files,
+		globalConfigs,
+	)
+	if err != nil {
+		return plan, err
+	}
+	newContainers, err := getNewContainers(module.Services, cacheContainers, deploymentId)
+	if err != nil {
+		return plan, err
+	}
+	servicesGraph, err := getServicesGraph(module.Services)
+	if err != nil {
+		return plan, err
+	}
+	plan.Images, err = h.getImagesPlan(ctx, module.Services, images)
+	if err != nil {
+		return plan, err
+	}
+	configValues := configsToStrings(module.Configs, mergedConfigs)
+	for _, reference := range servicesGraph.order {
+		service := module.Services[reference]
+		envVariables := getEnvVariables(
+			service,
+			deploymentId,
+			configValues,
+			userData.Secrets,
+			newContainers,
+			cache.SecretValues,
+			cache.Deployments,
+		)
+		for envVarName := range service.SecretVars {
+			if _, ok := envVariables[envVarName]; ok {
+				envVariables[envVarName] = redactedValue
+			}
+		}
+		plan.Containers = append(plan.Containers, lib_models.DeploymentPlanContainer{
+			Reference:    reference,
+			Image:        service.Image,
+			Action:       lib_constants.PlanActionCreate,
+			EnvVariables: envVariables,
+		})
+	}
+	for _, reference := range slices.Sorted(maps.Keys(current.Containers)) {
+		plan.Containers = append(plan.Containers, lib_models.DeploymentPlanContainer{
+			Reference: reference,
+			Name:      current.Containers[reference].Name,
+			Action:    lib_constants.PlanActionRemove,
+		})
+	}
+	var currentModule external_models.ModuleLibModule
+	if current.Deployment.Id != "" {
+		currentModule, err = h.getDeploymentModule(current.Deployment.DirName)
+		if err != nil {
+			logger.WarnContext(ctx, "plan deployment, get current module", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		}
+	}
+	plan.Volumes = getPlanItems(module.Volumes, current.Volumes, lib_constants.PlanActionKeep)
+	plan.Files = getPlanItems(mergedFiles, currentModule.Files, lib_constants.PlanActionUpdate)
+	plan.FileGroups = getPlanItems(userData.FileGroups, currentModule.FileGroups, lib_constants.PlanActionUpdate)
+	plan.HttpEndpoints = getHttpEndpointsPlan(module.Services, currentModule.Services)
+	return plan, nil
+}
+
+// getImagesPlan checks if the images of the services are available. Results are stored in the provided map to avoid
+// repeated requests for images shared by multiple modules.
+func (h *Handler) getImagesPlan(
+	ctx context.Context,
+	moduleServices map[string]external_models.ModuleLibService,
+	images map[string]bool,
+) ([]lib_models.DeploymentPlanImage, error) {
+	imageNames := make(map[string]struct{})
+	for _, service := range moduleServices {
+		imageNames[service.Image] = struct{}{}
+	}
+	var planImages []lib_models.DeploymentPlanImage
+	for _, imageName := range slices.Sorted(maps.Keys(imageNames)) {
+		available, ok := images[imageName]
+		if !ok {
+			_, err := h.containerEngineWrapperClient.GetImage(ctx, helper_url.EscapePath(imageName, h.config.PathEscapeDepth))
+			if err != nil {
+				var notFoundErr *external_models.CewNotFoundErr
+				if !errors.As(err, &notFoundErr) {
+					return nil, err
+				}
+			}
+			available = err == nil
+			images[imageName] = available
+		}
+		planImages = append(planImages, lib_models.DeploymentPlanImage{
+			Name:      imageName,
+			Available: available,
+		})
+	}
+	return planImages, nil
+}
+
+// getPlanItems compares the references of the new and current items. Items contained in both are marked with the
+// provided action.
+func getPlanItems[T, U any](items map[string]T, currentItems map[string]U, existingAction string) []lib_models.DeploymentPlanItem {
+	var planItems []lib_models.DeploymentPlanItem
+	for _, reference := range slices.Sorted(maps.Keys(items)) {
+		action := lib_constants.PlanActionCreate
+		if _, ok := currentItems[reference]; ok {
+			action = existingAction
+		}
+		planItems = append(planItems, lib_models.DeploymentPlanItem{Reference: reference, Action: action})
+	}
+	for _, reference := range slices.Sorted(maps.Keys(currentItems)) {
+		if _, ok := items[reference]; !ok {
+			planItems = append(planItems, lib_models.DeploymentPlanItem{Reference: reference, Action: lib_constants.PlanActionRemove})
+		}
+	}
+	return planItems
+}
+
+func getHttpEndpointsPlan(
+	moduleServices map[string]external_models.ModuleLibService,
+	currentModuleServices map[string]external_models.ModuleLibService,
+) []lib_models.DeploymentPlanHttpEndpoint {
+	newExtPaths := make(map[string]struct{})
+	var endpoints []lib_models.DeploymentPlanHttpEndpoint
+	for _, reference := range slices.Sorted(maps.Keys(moduleServices)) {
+		service := moduleServices[reference]
+		for _, extPath := range slices.Sorted(maps.Keys(service.HttpEndpoints)) {
+			endpoint := service.HttpEndpoints[extPath]
+			newExtPaths[extPath] = struct{}{}
+			endpoints = append(endpoints, lib_models.DeploymentPlanHttpEndpoint{
+				Reference: reference,
+				ExtPath:   extPath,
+				Port:      endpoint.Port,
+				Path:      endpoint.Path,
+				Action:    lib_constants.PlanActionCreate,
+			})
+		}
+	}
+	for _, reference := range slices.Sorted(maps.Keys(currentModuleServices)) {
+		service := currentModuleServices[reference]
+		for _, extPath := range slices.Sorted(maps.Keys(service.HttpEndpoints)) {
+			if _, ok := newExtPaths[extPath]; ok {
+				i := slices.IndexFunc(endpoints, func(item lib_models.DeploymentPlanHttpEndpoint) bool {
+					return item.ExtPath == extPath
+				})
+				endpoints[i].Action = lib_constants.PlanActionUpdate
+				continue
+			}
+			endpoint := service.HttpEndpoints[extPath]
+			endpoints = append(endpoints, lib_models.DeploymentPlanHttpEndpoint{
+				Reference: reference,
+				ExtPath:   extPath,
+				Port:      endpoint.Port,
+				Path:      endpoint.Path,
+				Action:    lib_constants.PlanActionRemove,
+			})
+		}
+	}
+	return endpoints
+}
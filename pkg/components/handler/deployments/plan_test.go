@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"reflect"
+	"testing"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+func Test_getPlanItems(t *testing.T) {
+	items := getPlanItems(
+		map[string]struct{}{"a": {}, "b": {}},
+		map[string]int{"b": 1, "c": 1},
+		lib_constants.PlanActionKeep,
+	)
+	expected := []lib_models.DeploymentPlanItem{
+		{Reference: "a", Action: lib_constants.PlanActionCreate},
+		{Reference: "b", Action: lib_constants.PlanActionKeep},
+		{Reference: "c", Action: lib_constants.PlanActionRemove},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+}
+
+func Test_getHttpEndpointsPlan(t *testing.T) {
+	endpoints := getHttpEndpointsPlan(
+		map[string]external_models.ModuleLibService{
+			"a": {HttpEndpoints: map[string]external_models.ModuleLibHttpEndpoint{
+				"api":  {Port: 80, Path: "/"},
+				"docs": {Port: 80, Path: "/docs"},
+			}},
+		},
+		map[string]external_models.ModuleLibService{
+			"a": {HttpEndpoints: map[string]external_models.ModuleLibHttpEndpoint{
+				"api": {Port: 8080, Path: "/"},
+				"old": {Port: 8080, Path: "/old"},
+			}},
+		},
+	)
+	expected := []lib_models.DeploymentPlanHttpEndpoint{
+		{Reference: "a", ExtPath: "api", Port: 80, Path: "/", Action: lib_constants.PlanActionUpdate},
+		{Reference: "a", ExtPath: "docs", Port: 80, Path: "/docs", Action: lib_constants.PlanActionCreate},
+		{Reference: "a", ExtPath: "old", Port: 8080, Path: "/old", Action: lib_constants.PlanActionRemove},
+	}
+	if !reflect.DeepEqual(endpoints, expected) {
+		t.Errorf("expected %v, got %v", expected, endpoints)
+	}
+}
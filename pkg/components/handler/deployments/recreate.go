@@ -30,21 +30,25 @@ import (
 	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
 )
 
+// RecreateDeployments recreates the deployments of the selected modules. Deployment IDs restrict the recreated
+// deployments, all deployments of the modules are recreated if no IDs are provided.
 func (h *Handler) RecreateDeployments(
 	ctx context.Context,
 	selectedModules map[string]pkg_models.Module,
+	deploymentIds []string,
 ) ([]lib_models.DeploymentResult, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	moduleIds := slices.Collect(maps.Keys(selectedModules))
 	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{
+		Ids:       deploymentIds,
 		ModuleIds: moduleIds,
 	})
 	if err != nil {
 		logger.ErrorContext(ctx, "recreate deployments, read from database", slog_keys.ModuleIds, moduleIds, slog_keys.Error, err)
 		return nil, err
 	}
-	deploymentIds := slices.Collect(maps.Keys(deployments))
+	deploymentIds = slices.Collect(maps.Keys(deployments))
 	deploymentsUserData, err := h.getDeploymentsUserDataFromDB(ctx, deploymentIds)
 	if err != nil {
 		logger.ErrorContext(
@@ -75,31 +79,57 @@ func (h *Handler) RecreateDeployments(
 		logger.ErrorContext(ctx, "recreate deployments, initialize cache", slog_keys.Error, err)
 		return nil, err
 	}
+	instancesCache, err := initInstancesCacheFromModulesAndDeployments(selectedModules, deployments, deploymentsContainers)
+	if err != nil {
+		logger.ErrorContext(ctx, "recreate deployments, initialize instances cache", slog_keys.Error, err)
+		return nil, err
+	}
+	modulesGraph, err := getModulesGraph(selectedModules)
+	if err != nil {
+		logger.ErrorContext(ctx, "recreate deployments, get modules order", slog_keys.Error, err)
+		return nil, err
+	}
 	var results []lib_models.DeploymentResult
-	for moduleId, module := range selectedModules {
-		result := lib_models.DeploymentResult{ModuleId: moduleId}
-		cacheItem, ok := cache.Deployments[moduleId]
-		if !ok {
-			result.ErrorResult = lib_models.NewErrorResult("module not found")
-			results = append(results, result)
+	for _, moduleId := range modulesGraph.order {
+		module := selectedModules[moduleId]
+		var cacheItems []deploymentsCacheItem
+		if cacheItem, ok := cache.Deployments[moduleId]; ok {
+			cacheItems = append(cacheItems, cacheItem)
+		}
+		cacheItems = append(cacheItems, instancesCache[moduleId]...)
+		if len(cacheItems) == 0 {
+			results = append(results, lib_models.DeploymentResult{
+				ModuleId:    moduleId,
+				ErrorResult: lib_models.NewErrorResult("module not found"),
+			})
 			continue
 		}
-		result.Id = cacheItem.DeploymentId
-		err = h.recreateDeployment(
-			ctx,
-			module,
-			deploymentsUserData[cacheItem.DeploymentId],
-			cacheItem.DeploymentId,
-			cacheItem.Containers,
-			deployments[cacheItem.DeploymentId],
-			deploymentsContainers[cacheItem.DeploymentId],
-			deploymentsVolumes[cacheItem.DeploymentId],
-			cache,
-		)
-		if err != nil {
-			result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		for _, cacheItem := range cacheItems {
+			result := lib_models.DeploymentResult{
+				ModuleId: moduleId,
+				Id:       cacheItem.DeploymentId,
+			}
+			rb := newRollback(cacheItem.DeploymentId)
+			err = h.recreateDeployment(
+				ctx,
+				module,
+				cacheItem.DeploymentId,
+				cacheItem.Containers,
+				currentDeploymentData{
+					Deployment: deployments[cacheItem.DeploymentId],
+					UserData:   deploymentsUserData[cacheItem.DeploymentId],
+					Containers: deploymentsContainers[cacheItem.DeploymentId],
+					Volumes:    deploymentsVolumes[cacheItem.DeploymentId],
+				},
+				cache,
+				rb,
+			)
+			if err != nil {
+				result.ErrorResult = lib_models.NewErrorResult(err.Error())
+				result.Rollback = rb.run(ctx)
+			}
+			results = append(results, result)
 		}
-		results = append(results, result)
 	}
 	return results, nil
 }
@@ -107,15 +137,13 @@ func (h *Handler) RecreateDeployments(
 func (h *Handler) recreateDeployment(
 	ctx context.Context,
 	module pkg_models.Module,
-	userData userDataCollection,
 	deploymentId string,
 	cacheContainers map[string]containerCacheItem,
-	currentDeployment pkg_models.DeploymentBase,
-	currentContainers map[string]pkg_models.DeploymentContainerBase,
-	currentVolumes map[string]pkg_models.DeploymentVolume,
+	current currentDeploymentData,
 	cache cacheCollection,
+	rb *rollback,
 ) error {
-	if currentDeployment.ModuleSource+currentDeployment.ModuleChannel+currentDeployment.ModuleVersion != module.Source+module.Channel+module.Version {
+	if current.Deployment.ModuleSource+current.Deployment.ModuleChannel+current.Deployment.ModuleVersion != module.Source+module.Channel+module.Version {
 		msg := fmt.Sprintf("module '%s' has changed, deployment must be updated first", module.ID)
 		logger.ErrorContext(ctx, "recreate deployment", slog_keys.ModuleId, module.ID, slog_keys.DeploymentId, deploymentId, slog_keys.Error, msg)
 		return errors.New(msg)
@@ -131,12 +159,13 @@ func (h *Handler) recreateDeployment(
 		)
 		return err
 	}
-	err = h.updateCaches(
+	cache, err = h.updateCaches(
 		ctx,
 		module.Dependencies,
-		userData.HostResources,
-		userData.Secrets,
-		userData.GlobalConfigs,
+		current.UserData.DependencyTargets,
+		current.UserData.HostResources,
+		current.UserData.Secrets,
+		current.UserData.GlobalConfigs,
 		cache,
 	)
 	if err != nil {
@@ -149,7 +178,7 @@ func (h *Handler) recreateDeployment(
 		)
 		return err
 	}
-	globalConfigs, err := getGlobalConfigs(module.Configs, userData.GlobalConfigs, cache.GlobalConfigs)
+	globalConfigs, err := getGlobalConfigs(module.Configs, current.UserData.GlobalConfigs, cache.GlobalConfigs)
 	if err != nil {
 		logger.ErrorContext(
 			ctx,
@@ -163,8 +192,8 @@ func (h *Handler) recreateDeployment(
 	mergedConfigs, mergedFiles, err := mergeDefaultAndUserData(
 		module,
 		defaultData,
-		userData.Configs,
-		userData.Files,
+		current.UserData.Configs,
+		current.UserData.Files,
 		globalConfigs,
 	)
 	if err != nil {
@@ -188,122 +217,34 @@ func (h *Handler) recreateDeployment(
 		)
 		return err
 	}
-	err = h.stopContainers(ctx, currentContainers)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"recreate deployment, stop containers",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.removeDeploymentEnvironment(
-		ctx,
-		deploymentId,
-		currentDeployment.DirName,
-		currentDeployment.FilesDirName,
-		currentContainers,
-	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"recreate deployment, remove environment",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.databaseHandler.UpdateDeploymentContainerNames(ctx, slices.Collect(maps.Values(newContainers)))
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"recreate deployment, write container names to database",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.ensureDeploymentEnvironment(
-		ctx,
-		module.Services,
-		module.FileSystem,
-		deploymentId,
-		currentDeployment.DirName,
-		currentDeployment.FilesDirName,
-		currentVolumes,
-	)
+	newDeployment, err := getDeployment(module, current.Deployment.Name, deploymentId)
 	if err != nil {
 		logger.ErrorContext(
 			ctx,
-			"recreate deployment, ensure environment",
+			"recreate deployment, generate new deployment",
 			slog_keys.ModuleId, module.ID,
 			slog_keys.DeploymentId, deploymentId,
 			slog_keys.Error, err,
 		)
 		return err
 	}
-	bindMounts, err := h.getBindMounts(
+	newDeployment.Enabled = current.Deployment.Enabled
+	newDeployment.Created = current.Deployment.Created
+	newDeployment.Updated = current.Deployment.Updated
+	return h.replaceDeployment(
 		ctx,
-		deploymentId,
-		currentDeployment.FilesDirName,
-		userData.FileGroups,
-		userData.Secrets,
-		mergedFiles,
-	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"recreate deployment, get bind mounts",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	// TODO "mount secrets" must be "unloaded" if one of the following steps fail
-	err = h.createContainers(
-		ctx,
-		module.Configs,
-		module.Services,
-		deploymentId,
-		currentDeployment.DirName,
-		currentDeployment.FilesDirName,
-		userData.Secrets,
-		userData.HostResources,
+		"recreate deployment",
+		module,
+		newDeployment,
+		current.UserData,
 		newContainers,
-		currentVolumes,
+		current.Volumes,
 		mergedConfigs,
-		bindMounts,
-		cache.SecretValues,
-		cache.Deployments,
-		cache.HostResources,
+		mergedFiles,
+		current,
+		cache,
+		rb,
 	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"recreate deployment, create containers",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.createHttpEndpoints(ctx, module.Services, module.ID, deploymentId, newContainers)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"recreate deployment, create http endpoints",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	return nil
 }
 
 func (h *Handler) getDeploymentsUserDataFromDB(ctx context.Context, deploymentIds []string) (map[string]userDataCollection, error) {
@@ -337,15 +278,22 @@ func (h *Handler) getDeploymentsUserDataFromDB(ctx context.Context, deploymentId
 	if err != nil {
 		return nil, err
 	}
+	deploymentsDependencyTargets, err := h.databaseHandler.ReadDeploymentsDependencyTargets(ctx, pkg_models.DeploymentDependencyTargetsFilter{
+		DeploymentIds: deploymentIds,
+	})
+	if err != nil {
+		return nil, err
+	}
 	deploymentsData := make(map[string]userDataCollection)
 	for _, deploymentId := range deploymentIds {
 		deploymentsData[deploymentId] = userDataCollection{
-			GlobalConfigs: deploymentsGlobalConfigs[deploymentId],
-			HostResources: deploymentsHostResources[deploymentId],
-			Secrets:       deploymentsSecrets[deploymentId],
-			Configs:       deploymentsConfigs[deploymentId],
-			Files:         deploymentsFiles[deploymentId],
-			FileGroups:    deploymentsFileGroups[deploymentId],
+			GlobalConfigs:     deploymentsGlobalConfigs[deploymentId],
+			HostResources:     deploymentsHostResources[deploymentId],
+			Secrets:           deploymentsSecrets[deploymentId],
+			Configs:           deploymentsConfigs[deploymentId],
+			Files:             deploymentsFiles[deploymentId],
+			FileGroups:        deploymentsFileGroups[deploymentId],
+			DependencyTargets: deploymentsDependencyTargets[deploymentId],
 		}
 	}
 	return deploymentsData, nil
@@ -370,28 +318,33 @@ func (h *Handler) getDeploymentsVolumesAndContainersFromDB(ctx context.Context,
 func (h *Handler) updateCaches(
 	ctx context.Context,
 	moduleDependencies map[string]string,
+	userDataDependencyTargets map[string]pkg_models.DeploymentDependencyTarget,
 	userDataHostResources map[string]pkg_models.DeploymentHostResource,
 	userDataSecrets map[string]pkg_models.DeploymentSecret,
 	userDataGlobalConfigs map[string]pkg_models.DeploymentGlobalConfig,
 	cache cacheCollection,
-) error {
-	err := h.updateDeploymentsCache(ctx, moduleDependencies, cache.Deployments)
+) (cacheCollection, error) {
+	err := h.updateDeploymentsCache(ctx, moduleDependencies, userDataDependencyTargets, cache.Deployments)
 	if err != nil {
-		return err
+		return cacheCollection{}, err
+	}
+	cache.Deployments, err = h.getDependencyTargetsCache(ctx, userDataDependencyTargets, cache.Deployments)
+	if err != nil {
+		return cacheCollection{}, err
 	}
 	err = h.updateGlobalConfigsCache(ctx, userDataGlobalConfigs, cache.GlobalConfigs)
 	if err != nil {
-		return err
+		return cacheCollection{}, err
 	}
 	err = h.updateHostResourcesCache(ctx, userDataHostResources, cache.HostResources)
 	if err != nil {
-		return err
+		return cacheCollection{}, err
 	}
 	err = h.updateSecretValuesCache(ctx, userDataSecrets, cache.SecretValues)
 	if err != nil {
-		return err
+		return cacheCollection{}, err
 	}
-	return nil
+	return cache, nil
 }
 
 func (h *Handler) ensureDeploymentEnvironment(
@@ -420,8 +373,9 @@ func (h *Handler) removeDeploymentEnvironment(
 	deploymentDirName string,
 	deploymentFilesDirName string,
 	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 ) error {
-	err := h.removeContainers(ctx, deploymentContainers)
+	err := h.removeContainers(ctx, deploymentContainers, servicesGraph)
 	if err != nil {
 		return err
 	}
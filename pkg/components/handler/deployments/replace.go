@@ -0,0 +1,222 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+type currentDeploymentData struct {
+	Deployment pkg_models.DeploymentBase
+	UserData   userDataCollection
+	Containers map[string]pkg_models.DeploymentContainerBase
+	Volumes    map[string]pkg_models.DeploymentVolume
+}
+
+// replaceDeployment replaces the containers and directories of an existing deployment. The new containers and
+// directories are created alongside the current ones, which are only removed after the database has been updated.
+// Each completed step registers a compensating action, so the previous state can be restored if a later step fails.
+// Pulled images are not removed as they may be used by other deployments.
+func (h *Handler) replaceDeployment(
+	ctx context.Context,
+	logMsg string,
+	module pkg_models.Module,
+	newDeployment pkg_models.DeploymentBase,
+	userData userDataCollection,
+	newContainers map[string]pkg_models.DeploymentContainerBase,
+	volumes map[string]pkg_models.DeploymentVolume,
+	mergedConfigs map[string]pkg_models.Value,
+	mergedFiles map[string][]byte,
+	current currentDeploymentData,
+	cache cacheCollection,
+	rb *rollback,
+) error {
+	deploymentId := newDeployment.Id
+	logErr := func(step string, err error) {
+		logger.ErrorContext(
+			ctx,
+			logMsg+", "+step,
+			slog_keys.ModuleId, module.ID,
+			slog_keys.DeploymentId, deploymentId,
+			slog_keys.Error, err,
+		)
+	}
+	servicesGraph, err := getServicesGraph(module.Services)
+	if err != nil {
+		logErr("get services order", err)
+		return err
+	}
+	currentServicesGraph := h.getDeploymentServicesGraph(ctx, current.Deployment)
+	if current.Deployment.Enabled {
+		rb.add("start previous containers", func(ctx context.Context) error {
+			err := h.loadDeploymentMountSecrets(ctx, deploymentId, current.UserData.Secrets)
+			if err != nil {
+				return err
+			}
+			return h.startContainers(ctx, current.Containers, currentServicesGraph)
+		})
+	}
+	err = h.stopContainers(ctx, current.Containers, currentServicesGraph)
+	if err != nil {
+		logErr("stop containers", err)
+		return err
+	}
+	err = h.ensureContainerImages(ctx, module.Services)
+	if err != nil {
+		logErr("ensure images", err)
+		return err
+	}
+	createdVolumes, err := h.createMissingContainerVolumes(ctx, volumes, deploymentId)
+	if len(createdVolumes) > 0 {
+		rb.add("remove new volumes", func(ctx context.Context) error {
+			return h.removeContainerVolumes(ctx, createdVolumes)
+		})
+	}
+	if err != nil {
+		logErr("create volumes", err)
+		return err
+	}
+	rb.add("remove new directories", func(ctx context.Context) error {
+		return h.removeDeploymentDirs(newDeployment.DirName, newDeployment.FilesDirName)
+	})
+	err = h.createDeploymentDirs(module.FileSystem, newDeployment.DirName, newDeployment.FilesDirName)
+	if err != nil {
+		logErr("create directories", err)
+		return err
+	}
+	rb.add("restore previous secret mounts", func(ctx context.Context) error {
+		err := h.removeSecretMounts(ctx, deploymentId)
+		if err != nil {
+			return err
+		}
+		_, err = h.createSecretMounts(ctx, deploymentId, current.UserData.Secrets)
+		return err
+	})
+	err = h.removeSecretMounts(ctx, deploymentId)
+	if err != nil {
+		logErr("remove secret mounts", err)
+		return err
+	}
+	bindMounts, err := h.getBindMounts(
+		ctx,
+		deploymentId,
+		newDeployment.FilesDirName,
+		userData.FileGroups,
+		userData.Secrets,
+		mergedFiles,
+	)
+	if err != nil {
+		logErr("get bind mounts", err)
+		return err
+	}
+	token, err := h.rotateCredential(ctx, deploymentId, rb)
+	if err != nil {
+		logErr("issue credential", err)
+		return err
+	}
+	rb.add("remove new containers", func(ctx context.Context) error {
+		return h.removeContainers(ctx, newContainers, servicesGraph)
+	})
+	err = h.createContainers(
+		ctx,
+		module.Configs,
+		module.Services,
+		servicesGraph,
+		deploymentId,
+		token,
+		newDeployment.DirName,
+		newDeployment.FilesDirName,
+		userData.Secrets,
+		userData.HostResources,
+		newContainers,
+		volumes,
+		mergedConfigs,
+		bindMounts,
+		cache.SecretValues,
+		cache.Deployments,
+		cache.HostResources,
+	)
+	if err != nil {
+		logErr("create containers", err)
+		return err
+	}
+	err = h.databaseHandler.UpdateDeployment(
+		ctx,
+		newDeployment,
+		slices.Collect(maps.Values(userData.HostResources)),
+		slices.Collect(maps.Values(userData.Secrets)),
+		slices.Collect(maps.Values(userData.Configs)),
+		slices.Collect(maps.Values(userData.GlobalConfigs)),
+		slices.Collect(maps.Values(userData.Files)),
+		slices.Collect(maps.Values(userData.FileGroups)),
+		slices.Collect(maps.Values(volumes)),
+		slices.Collect(maps.Values(newContainers)),
+		slices.Collect(maps.Values(userData.DependencyTargets)),
+	)
+	if err != nil {
+		logErr("write to database", err)
+		return err
+	}
+	rb.add("restore database entry", func(ctx context.Context) error {
+		return h.databaseHandler.UpdateDeployment(
+			ctx,
+			current.Deployment,
+			slices.Collect(maps.Values(current.UserData.HostResources)),
+			slices.Collect(maps.Values(current.UserData.Secrets)),
+			slices.Collect(maps.Values(current.UserData.Configs)),
+			slices.Collect(maps.Values(current.UserData.GlobalConfigs)),
+			slices.Collect(maps.Values(current.UserData.Files)),
+			slices.Collect(maps.Values(current.UserData.FileGroups)),
+			slices.Collect(maps.Values(current.Volumes)),
+			slices.Collect(maps.Values(current.Containers)),
+			slices.Collect(maps.Values(current.UserData.DependencyTargets)),
+		)
+	})
+	// endpoints are set by a single core manager job, the previous endpoints remain if the job fails
+	err = h.createHttpEndpoints(ctx, module.Services, module.ID, deploymentId, newContainers)
+	if err != nil {
+		logErr("create http endpoints", err)
+		return err
+	}
+	h.removePreviousEnvironment(ctx, logMsg, current, currentServicesGraph, volumes)
+	return nil
+}
+
+// removePreviousEnvironment removes the containers, directories and unused volumes of the replaced deployment. The
+// change is already committed at this point, failures are logged only.
+func (h *Handler) removePreviousEnvironment(
+	ctx context.Context,
+	logMsg string,
+	current currentDeploymentData,
+	currentServicesGraph dependencyGraph,
+	volumes map[string]pkg_models.DeploymentVolume,
+) {
+	if err := h.removeContainers(ctx, current.Containers, currentServicesGraph); err != nil {
+		logger.ErrorContext(ctx, logMsg+", remove previous containers", slog_keys.DeploymentId, current.Deployment.Id, slog_keys.Error, err)
+	}
+	if err := h.removeDeploymentDirs(current.Deployment.DirName, current.Deployment.FilesDirName); err != nil {
+		logger.ErrorContext(ctx, logMsg+", remove previous directories", slog_keys.DeploymentId, current.Deployment.Id, slog_keys.Error, err)
+	}
+	if err := h.removeUnusedContainerVolumes(ctx, volumes, current.Deployment.Id); err != nil {
+		logger.ErrorContext(ctx, logMsg+", remove unused volumes", slog_keys.DeploymentId, current.Deployment.Id, slog_keys.Error, err)
+	}
+}
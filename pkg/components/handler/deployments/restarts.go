@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"time"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+type restartRecord struct {
+	count     int
+	deferred  int // failed starts that did not reach the containers, only used to calculate the backoff delay
+	last      time.Time
+	next      time.Time
+	crashLoop bool
+	startErr  *pkg_models.DeploymentStartError
+}
+
+// restartAllowed checks if the runtime monitor may restart a deployment and records the restart. Restarts are delayed
+// with an exponential backoff, after the max number of restarts the deployment is flagged as crash-looping.
+func (h *Handler) restartAllowed(ctx context.Context, id string, now time.Time) bool {
+	h.restartsMu.Lock()
+	defer h.restartsMu.Unlock()
+	record := h.restarts[id]
+	if record.crashLoop || now.Before(record.next) {
+		return false
+	}
+	if h.config.CrashLoopMaxRestarts > 0 && record.count >= h.config.CrashLoopMaxRestarts {
+		record.crashLoop = true
+		h.restarts[id] = record
+		rmLogger.WarnContext(ctx, "deployment crash-looping, restarts suspended", slog_keys.DeploymentId, id, slog_keys.Restarts, record.count)
+		return false
+	}
+	record.count++
+	record.last = now
+	record.next = now.Add(getRestartDelay(h.config.RestartBackoffDelay, h.config.RestartBackoffMaxDelay, record.count+record.deferred))
+	h.restarts[id] = record
+	return true
+}
+
+// startFailed records the error of a failed deployment start. Starts that failed before the containers have been
+// started are not counted towards the crash-loop limit but still increase the backoff delay.
+func (h *Handler) startFailed(id string, err error, now time.Time, containersStarted bool) {
+	h.restartsMu.Lock()
+	defer h.restartsMu.Unlock()
+	record := h.restarts[id]
+	if !containersStarted && record.count > 0 {
+		record.count--
+		record.deferred++
+	}
+	record.startErr = &pkg_models.DeploymentStartError{Err: err, Time: now}
+	h.restarts[id] = record
+}
+
+func (h *Handler) startSucceeded(id string) {
+	h.restartsMu.Lock()
+	defer h.restartsMu.Unlock()
+	if record, ok := h.restarts[id]; ok {
+		record.startErr = nil
+		h.restarts[id] = record
+	}
+}
+
+func (h *Handler) getStartError(id string) *pkg_models.DeploymentStartError {
+	h.restartsMu.RLock()
+	defer h.restartsMu.RUnlock()
+	return h.restarts[id].startErr
+}
+
+// restartsReset removes the restart record of a deployment if it has been running since the reset delay.
+func (h *Handler) restartsReset(id string, now time.Time) {
+	h.restartsMu.Lock()
+	defer h.restartsMu.Unlock()
+	record, ok := h.restarts[id]
+	if ok && now.Sub(record.last) >= h.config.RestartResetDelay {
+		delete(h.restarts, id)
+	}
+}
+
+func (h *Handler) restartsRemove(ids ...string) {
+	h.restartsMu.Lock()
+	defer h.restartsMu.Unlock()
+	for _, id := range ids {
+		delete(h.restarts, id)
+	}
+}
+
+// restartsRetain removes the restart records of deployments that no longer exist.
+func (h *Handler) restartsRetain(deployments map[string]pkg_models.DeploymentBase) {
+	h.restartsMu.Lock()
+	defer h.restartsMu.Unlock()
+	for id := range h.restarts {
+		if _, ok := deployments[id]; !ok {
+			delete(h.restarts, id)
+		}
+	}
+}
+
+func (h *Handler) isCrashLooping(id string) bool {
+	h.restartsMu.RLock()
+	defer h.restartsMu.RUnlock()
+	return h.restarts[id].crashLoop
+}
+
+func (h *Handler) getDeploymentState(id string, containersState int) int {
+	if h.isCrashLooping(id) {
+		return lib_constants.DeploymentCrashLooping
+	}
+	return getDeploymentState(containersState)
+}
+
+func getRestartDelay(delay, maxDelay time.Duration, count int) time.Duration {
+	for i := 1; i < count && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHandler_restartAllowed(t *testing.T) {
+	h := &Handler{
+		config: Config{
+			RestartBackoffDelay:    time.Second,
+			RestartBackoffMaxDelay: time.Second * 3,
+			CrashLoopMaxRestarts:   3,
+		},
+		restarts: make(map[string]restartRecord),
+	}
+	now := time.Now()
+	if !h.restartAllowed(context.Background(), "test", now) {
+		t.Error("expected restart")
+	}
+	if h.restartAllowed(context.Background(), "test", now.Add(time.Millisecond*500)) {
+		t.Error("expected backoff")
+	}
+	if !h.restartAllowed(context.Background(), "test", now.Add(time.Second)) {
+		t.Error("expected restart")
+	}
+	if !h.restartAllowed(context.Background(), "test", now.Add(time.Second*3)) {
+		t.Error("expected restart")
+	}
+	if h.restartAllowed(context.Background(), "test", now.Add(time.Second*6)) {
+		t.Error("expected no restart")
+	}
+	if !h.isCrashLooping("test") {
+		t.Error("expected crash loop")
+	}
+	h.restartsRemove("test")
+	if h.isCrashLooping("test") {
+		t.Error("expected no crash loop")
+	}
+}
+
+func TestHandler_startFailed(t *testing.T) {
+	h := &Handler{
+		config: Config{
+			RestartBackoffDelay:    time.Second,
+			RestartBackoffMaxDelay: time.Minute,
+			CrashLoopMaxRestarts:   1,
+		},
+		restarts: make(map[string]restartRecord),
+	}
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if !h.restartAllowed(context.Background(), "test", now) {
+			t.Fatal("expected restart")
+		}
+		h.startFailed("test", errors.New("test error"), now, false)
+		now = h.restarts["test"].next
+	}
+	if h.isCrashLooping("test") {
+		t.Error("expected no crash loop")
+	}
+	if delay := h.restarts["test"].next.Sub(h.restarts["test"].last); delay != time.Second*4 {
+		t.Errorf("expected 4s backoff, got %s", delay)
+	}
+	startErr := h.getStartError("test")
+	if startErr == nil || startErr.Err.Error() != "test error" {
+		t.Errorf("expected start error, got %v", startErr)
+	}
+	h.startSucceeded("test")
+	if h.getStartError("test") != nil {
+		t.Error("expected no start error")
+	}
+}
+
+func Test_getRestartDelay(t *testing.T) {
+	for count, expected := range map[int]time.Duration{1: time.Second, 2: time.Second * 2, 3: time.Second * 4, 5: time.Second * 10} {
+		if delay := getRestartDelay(time.Second, time.Second*10, count); delay != expected {
+			t.Errorf("count %d: expected %s, got %s", count, expected, delay)
+		}
+	}
+}
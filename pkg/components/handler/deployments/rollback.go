@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// rollback collects compensating actions for the steps of a deployment change. Each completed step that alters the
+// gateway registers an action that reverts it.
+type rollback struct {
+	deploymentId string
+	steps        []rollbackStep
+}
+
+type rollbackStep struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+func newRollback(deploymentId string) *rollback {
+	return &rollback{deploymentId: deploymentId}
+}
+
+func (r *rollback) add(name string, fn func(ctx context.Context) error) {
+	r.steps = append(r.steps, rollbackStep{name: name, fn: fn})
+}
+
+// run executes the compensating actions in reverse order. All actions are executed regardless of previous failures
+// and without the cancellation of the provided context, as a canceled job must be rolled back as well.
+func (r *rollback) run(ctx context.Context) []lib_models.DeploymentRollbackStep {
+	ctx = context.WithoutCancel(ctx)
+	var results []lib_models.DeploymentRollbackStep
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		step := r.steps[i]
+		result := lib_models.DeploymentRollbackStep{Name: step.name}
+		if err := step.fn(ctx); err != nil {
+			logger.ErrorContext(ctx, "rollback, "+step.name, slog_keys.DeploymentId, r.deploymentId, slog_keys.Error, err)
+			result.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		results = append(results, result)
+	}
+	r.steps = nil
+	return results
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func Test_rollback_run(t *testing.T) {
+	var order []string
+	rb := newRollback("test")
+	rb.add("a", func(ctx context.Context) error {
+		order = append(order, "a")
+		return nil
+	})
+	rb.add("b", func(ctx context.Context) error {
+		order = append(order, "b")
+		return errors.New("test")
+	})
+	ctx, cf := context.WithCancel(context.Background())
+	cf()
+	rb.add("c", func(ctx context.Context) error {
+		order = append(order, "c")
+		return ctx.Err()
+	})
+	results := rb.run(ctx)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, name := range []string{"c", "b", "a"} {
+		if order[i] != name || results[i].Name != name {
+			t.Errorf("expected %s at %d, got %s and %s", name, i, order[i], results[i].Name)
+		}
+	}
+	if results[0].HasError {
+		t.Error("expected canceled context to be ignored")
+	}
+	if !results[1].HasError {
+		t.Error("expected error")
+	}
+	if len(rb.run(ctx)) != 0 {
+		t.Error("expected steps to be cleared")
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+func (h *Handler) GetRuntimeEvents(
+	ctx context.Context,
+	id string,
+	filter lib_models.DeploymentRuntimeEventsFilter,
+) ([]lib_models.DeploymentRuntimeEvent, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if _, err := h.databaseHandler.ReadDeployment(ctx, id); err != nil {
+		return nil, err
+	}
+	events, err := h.databaseHandler.ReadDeploymentRuntimeEvents(ctx, id, filter)
+	if err != nil {
+		logger.ErrorContext(ctx, "get runtime events, read from database", slog_keys.DeploymentId, id, slog_keys.Error, err)
+		return nil, err
+	}
+	return events, nil
+}
+
+// storeRuntimeEvent stores an action of the runtime monitor, the container states after the action are retrieved
+// from the container engine wrapper.
+func (h *Handler) storeRuntimeEvent(
+	ctx context.Context,
+	deploymentId string,
+	action string,
+	reason string,
+	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	containersBefore map[string]lib_models.ContainerHealthInfo,
+	actionErr error,
+) {
+	event := lib_models.DeploymentRuntimeEvent{
+		DeploymentId:     deploymentId,
+		Timestamp:        helper_time.Now(),
+		Action:           action,
+		Reason:           reason,
+		ContainersBefore: containersBefore,
+	}
+	if actionErr != nil {
+		event.Error = actionErr.Error()
+	}
+	cewContainersMap, err := h.getCewContainers(ctx, map[string]map[string]pkg_models.DeploymentContainerBase{deploymentId: deploymentContainers})
+	if err != nil {
+		rmLogger.WarnContext(ctx, "store runtime event, get containers", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+	}
+	event.ContainersAfter = getContainersHealthInfo(deploymentContainers, cewContainersMap)
+	if err = h.databaseHandler.CreateDeploymentRuntimeEvent(ctx, event, h.config.RuntimeEventsMaxEntries); err != nil {
+		rmLogger.ErrorContext(ctx, "store runtime event", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+	}
+}
+
+func getContainersHealthInfo(
+	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	cewContainersMap map[string]external_models.CewContainer,
+) map[string]lib_models.ContainerHealthInfo {
+	containersHealthInfo := make(map[string]lib_models.ContainerHealthInfo)
+	for reference, deploymentContainer := range deploymentContainers {
+		var containerHealthInfo lib_models.ContainerHealthInfo
+		if cewContainer, ok := cewContainersMap[deploymentContainer.Name]; ok {
+			containerHealthInfo.State = cewContainer.State
+			if cewContainer.Health != nil {
+				containerHealthInfo.Health = *cewContainer.Health
+			}
+		}
+		containersHealthInfo[reference] = containerHealthInfo
+	}
+	return containersHealthInfo
+}
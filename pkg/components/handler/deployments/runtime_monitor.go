@@ -21,8 +21,14 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"strings"
 	"time"
 
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_containers "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/containers"
+	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
@@ -48,25 +54,69 @@ func (h *Handler) checkDeployments(ctx context.Context) {
 		rmLogger.ErrorContext(ctx, "get deployments", slog_keys.Error, err)
 		return
 	}
+	h.restartsRetain(deployments)
+	h.deploymentModulesRetain(deployments)
+	h.publishStateChanges(deployments, deploymentsContainers, cewContainersMap)
+	// deployments are handled without regard to their dependencies if the dependencies form a cycle
+	deploymentsGraph, err := h.getDeploymentsGraph(ctx, deployments)
+	if err != nil {
+		rmLogger.ErrorContext(ctx, "get deployments order", slog_keys.Error, err)
+	}
+	now := helper_time.Now()
 	filteredDeployments := h.runtimeMonitorJobsFilter(deployments)
 	for id, deployment := range filteredDeployments {
 		deploymentContainers := deploymentsContainers[id]
 		state := getContainersCombinedState(deploymentContainers, cewContainersMap)
-		if state == containersStateBroken || state == containersStateUnhealthy {
+		if state == containersStateBroken {
 			continue
 		}
 		if deployment.Enabled {
-			if state == containersStateRunning {
+			switch state {
+			case containersStateRunning:
+				h.restartsReset(id, now)
+				continue
+			case containersStateUnhealthy:
+				if !h.config.RestartUnhealthy || !h.restartAllowed(ctx, id, now) {
+					continue
+				}
+				h.runtimeMonitorJobsAdd(id)
+				go h.restartUnhealthyContainers(ctx, id, deploymentContainers, cewContainersMap)
+				continue
+			}
+			if !h.dependenciesReady(id, deploymentsGraph, deployments, deploymentsContainers, cewContainersMap) {
+				rmLogger.DebugContext(ctx, "start deployment, waiting for dependencies", slog_keys.DeploymentId, id)
+				continue
+			}
+			if !h.restartAllowed(ctx, id, now) {
 				continue
 			}
 			h.runtimeMonitorJobsAdd(id)
-			go h.startDeployment(ctx, id, deploymentContainers, deploymentsMountSecrets[id])
+			go h.startDeployment(
+				ctx,
+				id,
+				deploymentContainers,
+				h.getDeploymentServicesGraph(ctx, deployment),
+				deploymentsMountSecrets[id],
+				getContainersHealthInfo(deploymentContainers, cewContainersMap),
+			)
 		} else {
-			if state == containersStateStopped {
+			h.restartsRemove(id)
+			if state == containersStateStopped || state == containersStateUnhealthy {
+				continue
+			}
+			if !dependentsStopped(id, deploymentsGraph, deployments, deploymentsContainers, cewContainersMap) {
+				rmLogger.DebugContext(ctx, "stop deployment, waiting for dependents", slog_keys.DeploymentId, id)
 				continue
 			}
 			h.runtimeMonitorJobsAdd(id)
-			go h.stopDeployment(ctx, id, deploymentContainers, len(deploymentsMountSecrets[id]) > 0)
+			go h.stopDeployment(
+				ctx,
+				id,
+				deploymentContainers,
+				h.getDeploymentServicesGraph(ctx, deployment),
+				len(deploymentsMountSecrets[id]) > 0,
+				getContainersHealthInfo(deploymentContainers, cewContainersMap),
+			)
 		}
 	}
 }
@@ -112,15 +162,24 @@ func (h *Handler) startDeployment(
 	ctx context.Context,
 	deploymentId string,
 	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 	deploymentMountSecrets map[string]pkg_models.DeploymentSecret,
+	containersBefore map[string]lib_models.ContainerHealthInfo,
 ) {
 	var err error
+	var containersStarted bool
 	defer func() {
-		if err != nil && len(deploymentMountSecrets) > 0 {
-			e, _ := h.secretManagerClient.CleanPathVariants(context.Background(), deploymentId)
-			if e != nil {
-				rmLogger.ErrorContext(ctx, "start deployment, unload mounted secrets", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		h.storeRuntimeEvent(ctx, deploymentId, lib_constants.RuntimeEventActionStart, lib_constants.RuntimeEventReasonNotRunning, deploymentContainers, containersBefore, err)
+		if err != nil {
+			h.startFailed(deploymentId, err, helper_time.Now(), containersStarted)
+			if len(deploymentMountSecrets) > 0 {
+				e, _ := h.secretManagerClient.CleanPathVariants(context.Background(), deploymentId)
+				if e != nil {
+					rmLogger.ErrorContext(ctx, "start deployment, unload mounted secrets", slog_keys.DeploymentId, deploymentId, slog_keys.Error, e)
+				}
 			}
+		} else {
+			h.startSucceeded(deploymentId)
 		}
 		h.runtimeMonitorJobsRemove(deploymentId)
 	}()
@@ -138,9 +197,15 @@ func (h *Handler) startDeployment(
 			slog_keys.Secrets, deploymentMountSecrets,
 			slog_keys.Error, err,
 		)
+		err = fmt.Errorf("load mount secrets: %w", err)
+		// keep partially running deployments stopped, containers must not run without their secret files
+		if e := h.stopContainers(ctx, deploymentContainers, servicesGraph); e != nil {
+			rmLogger.ErrorContext(ctx, "start deployment, stop containers", slog_keys.DeploymentId, deploymentId, slog_keys.Error, e)
+		}
 		return
 	}
-	err = h.startContainers(ctx, deploymentContainers)
+	containersStarted = true
+	err = h.startContainers(ctx, deploymentContainers, servicesGraph)
 	if err != nil {
 		rmLogger.ErrorContext(ctx,
 			"start deployment, start containers",
@@ -148,6 +213,7 @@ func (h *Handler) startDeployment(
 			slog_keys.Containers, deploymentContainers,
 			slog_keys.Error, err,
 		)
+		err = fmt.Errorf("start containers: %w", err)
 		return
 	}
 }
@@ -177,16 +243,68 @@ func (h *Handler) loadDeploymentMountSecrets(
 			}
 		}
 	}
-	return nil
+	return helper_errors.Join(errs...)
+}
+
+func (h *Handler) restartUnhealthyContainers(
+	ctx context.Context,
+	deploymentId string,
+	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	cewContainersMap map[string]external_models.CewContainer,
+) {
+	var errs []error
+	defer func() {
+		h.storeRuntimeEvent(
+			ctx,
+			deploymentId,
+			lib_constants.RuntimeEventActionRestart,
+			lib_constants.RuntimeEventReasonUnhealthy,
+			deploymentContainers,
+			getContainersHealthInfo(deploymentContainers, cewContainersMap),
+			helper_errors.Join(errs...),
+		)
+		h.runtimeMonitorJobsRemove(deploymentId)
+	}()
+	for _, deploymentContainer := range deploymentContainers {
+		cewContainer, ok := cewContainersMap[deploymentContainer.Name]
+		if !ok || cewContainer.Health == nil || *cewContainer.Health != lib_constants.ContainerUnhealthy {
+			continue
+		}
+		rmLogger.DebugContext(ctx, "restart unhealthy container", slog_keys.DeploymentId, deploymentId, slog_keys.ContainerName, deploymentContainer.Name)
+		err := helper_containers.Restart(ctx, h.containerEngineWrapperClient, deploymentContainer.Name, h.config.JobPollInterval)
+		if err != nil {
+			rmLogger.ErrorContext(ctx,
+				"restart unhealthy container",
+				slog_keys.DeploymentId, deploymentId,
+				slog_keys.ContainerName, deploymentContainer.Name,
+				slog_keys.Error, err,
+			)
+			errs = append(errs, fmt.Errorf("'%s' %w", deploymentContainer.Reference, err))
+		}
+	}
 }
 
 func (h *Handler) stopDeployment(
 	ctx context.Context,
 	deploymentId string,
 	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+	servicesGraph dependencyGraph,
 	hasMountSecrets bool,
+	containersBefore map[string]lib_models.ContainerHealthInfo,
 ) {
-	defer h.runtimeMonitorJobsRemove(deploymentId)
+	var errs []error
+	defer func() {
+		h.storeRuntimeEvent(
+			ctx,
+			deploymentId,
+			lib_constants.RuntimeEventActionStop,
+			lib_constants.RuntimeEventReasonDisabled,
+			deploymentContainers,
+			containersBefore,
+			helper_errors.Join(errs...),
+		)
+		h.runtimeMonitorJobsRemove(deploymentId)
+	}()
 	rmLogger.DebugContext(ctx,
 		"stop deployment",
 		slog_keys.DeploymentId, deploymentId,
@@ -196,9 +314,10 @@ func (h *Handler) stopDeployment(
 		err, _ := h.secretManagerClient.CleanPathVariants(ctx, deploymentId)
 		if err != nil {
 			rmLogger.ErrorContext(ctx, "stop deployment, unload mounted secrets", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+			errs = append(errs, fmt.Errorf("unload mounted secrets: %w", err))
 		}
 	}
-	err := h.stopContainers(ctx, deploymentContainers)
+	err := h.stopContainers(ctx, deploymentContainers, servicesGraph)
 	if err != nil {
 		rmLogger.ErrorContext(ctx,
 			"stop deployment, stop containers",
@@ -206,6 +325,52 @@ func (h *Handler) stopDeployment(
 			slog_keys.Containers, deploymentContainers,
 			slog_keys.Error, err,
 		)
+		errs = append(errs, fmt.Errorf("stop containers: %w", err))
+	}
+}
+
+// publishStateChanges publishes the state of deployments that changed since the last check. Must only be called by
+// the runtime monitor.
+func (h *Handler) publishStateChanges(
+	deployments map[string]pkg_models.DeploymentBase,
+	deploymentsContainers map[string]map[string]pkg_models.DeploymentContainerBase,
+	cewContainersMap map[string]external_models.CewContainer,
+) {
+	for id, deployment := range deployments {
+		deploymentContainers := deploymentsContainers[id]
+		state := lib_models.DeploymentStateEvent{
+			Id:       id,
+			ModuleId: deployment.ModuleId,
+			Enabled:  deployment.Enabled,
+			State:    h.getDeploymentState(id, getContainersCombinedState(deploymentContainers, cewContainersMap)),
+		}
+		for reference, deploymentContainer := range deploymentContainers {
+			containerHealthInfo := lib_models.DeploymentContainerHealthInfo{Reference: reference}
+			if cewContainer, ok := cewContainersMap[deploymentContainer.Name]; ok {
+				containerHealthInfo.State = cewContainer.State
+				if cewContainer.Health != nil {
+					containerHealthInfo.Health = *cewContainer.Health
+				}
+			}
+			state.Containers = append(state.Containers, containerHealthInfo)
+		}
+		slices.SortFunc(state.Containers, func(a, b lib_models.DeploymentContainerHealthInfo) int {
+			return strings.Compare(a.Reference, b.Reference)
+		})
+		lastState, ok := h.runtimeStates[id]
+		if ok && lastState.Enabled == state.Enabled && lastState.State == state.State && slices.Equal(lastState.Containers, state.Containers) {
+			continue
+		}
+		h.runtimeStates[id] = state
+		h.eventsHandler.Publish(lib_models.Event{
+			Type:       lib_constants.EventDeploymentState,
+			Deployment: &state,
+		})
+	}
+	for id := range h.runtimeStates {
+		if _, ok := deployments[id]; !ok {
+			delete(h.runtimeStates, id)
+		}
 	}
 }
 
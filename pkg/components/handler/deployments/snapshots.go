@@ -0,0 +1,136 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"context"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"slices"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_file_sys "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/file_sys"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
+	helper_uuid "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/uuid"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+const (
+	snapshotsDirName      = "snapshots"
+	snapshotModuleDirName = "module"
+	snapshotFilesDirName  = "files"
+)
+
+func (h *Handler) GetSnapshots(ctx context.Context, deploymentId string) ([]pkg_models.DeploymentSnapshot, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if _, err := h.databaseHandler.ReadDeployment(ctx, deploymentId); err != nil {
+		return nil, err
+	}
+	snapshots, err := h.databaseHandler.ReadDeploymentSnapshots(ctx, deploymentId)
+	if err != nil {
+		logger.ErrorContext(ctx, "get snapshots, read from database", slog_keys.DeploymentId, deploymentId, slog_keys.Error, err)
+		return nil, err
+	}
+	for i := range snapshots {
+		snapshots[i].ModuleFileSystem = h.getSnapshotModuleFS(snapshots[i])
+	}
+	return snapshots, nil
+}
+
+func (h *Handler) GetSnapshot(ctx context.Context, deploymentId, snapshotId string) (pkg_models.DeploymentSnapshot, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	snapshot, err := h.databaseHandler.ReadDeploymentSnapshot(ctx, snapshotId)
+	if err != nil {
+		logger.ErrorContext(ctx, "get snapshot, read from database", slog_keys.DeploymentId, deploymentId, slog_keys.SnapshotId, snapshotId, slog_keys.Error, err)
+		return pkg_models.DeploymentSnapshot{}, err
+	}
+	if snapshot.DeploymentId != deploymentId {
+		return pkg_models.DeploymentSnapshot{}, lib_errors.New[lib_errors.ErrNotFound]("deployment snapshot not found")
+	}
+	snapshot.ModuleFileSystem = h.getSnapshotModuleFS(snapshot)
+	return snapshot, nil
+}
+
+// createSnapshot stores the module files, the generated files and the user input of a deployment. Snapshots are
+// supplementary, failures are logged only and do not affect the deployment.
+func (h *Handler) createSnapshot(
+	ctx context.Context,
+	module pkg_models.Module,
+	deployment pkg_models.DeploymentBase,
+	userInput pkg_models.DeploymentUserInput,
+	containers map[string]pkg_models.DeploymentContainerBase,
+) {
+	id, err := helper_uuid.New()
+	if err != nil {
+		logger.ErrorContext(ctx, "create snapshot, generate id", slog_keys.DeploymentId, deployment.Id, slog_keys.Error, err)
+		return
+	}
+	userInput.ModuleId = module.ID
+	snapshot := pkg_models.DeploymentSnapshot{
+		Id:            id,
+		DeploymentId:  deployment.Id,
+		ModuleId:      module.ID,
+		ModuleSource:  module.Source,
+		ModuleChannel: module.Channel,
+		ModuleVersion: module.Version,
+		UserInput:     userInput,
+		Containers:    slices.Collect(maps.Values(containers)),
+		Created:       helper_time.Now(),
+	}
+	dirPath := path.Join(h.config.WorkdirPath, snapshotsDirName, deployment.Id, id)
+	err = h.copySnapshotFiles(module, deployment.FilesDirName, dirPath)
+	if err == nil {
+		var removed []string
+		removed, err = h.databaseHandler.CreateDeploymentSnapshot(ctx, snapshot, h.config.SnapshotsMaxEntries)
+		for _, removedId := range removed {
+			if e := os.RemoveAll(path.Join(h.config.WorkdirPath, snapshotsDirName, deployment.Id, removedId)); e != nil {
+				logger.ErrorContext(ctx, "create snapshot, remove old snapshot files", slog_keys.DeploymentId, deployment.Id, slog_keys.SnapshotId, removedId, slog_keys.Error, e)
+			}
+		}
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "create snapshot", slog_keys.DeploymentId, deployment.Id, slog_keys.Error, err)
+		if e := os.RemoveAll(dirPath); e != nil {
+			logger.ErrorContext(ctx, "create snapshot, remove files", slog_keys.DeploymentId, deployment.Id, slog_keys.Error, e)
+		}
+	}
+}
+
+func (h *Handler) copySnapshotFiles(module pkg_models.Module, deploymentFilesDirName, dirPath string) error {
+	err := os.MkdirAll(dirPath, dirPerm)
+	if err != nil {
+		return err
+	}
+	err = helper_file_sys.CopyAll(module.FileSystem, path.Join(dirPath, snapshotModuleDirName))
+	if err != nil {
+		return err
+	}
+	return helper_file_sys.CopyAll(os.DirFS(path.Join(h.config.WorkdirPath, deploymentFilesDirName)), path.Join(dirPath, snapshotFilesDirName))
+}
+
+func (h *Handler) getSnapshotModuleFS(snapshot pkg_models.DeploymentSnapshot) fs.FS {
+	return os.DirFS(path.Join(h.config.WorkdirPath, snapshotsDirName, snapshot.DeploymentId, snapshot.Id, snapshotModuleDirName))
+}
+
+func (h *Handler) removeSnapshots(deploymentId string) error {
+	return os.RemoveAll(path.Join(h.config.WorkdirPath, snapshotsDirName, deploymentId))
+}
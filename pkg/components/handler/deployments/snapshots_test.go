@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployments
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"testing"
+	"testing/fstest"
+
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func TestHandler_copySnapshotFiles(t *testing.T) {
+	h := &Handler{config: Config{WorkdirPath: t.TempDir()}}
+	if err := os.MkdirAll(path.Join(h.config.WorkdirPath, "files", "group"), dirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(h.config.WorkdirPath, "files", "group", "test.conf"), []byte("test"), 0660); err != nil {
+		t.Fatal(err)
+	}
+	module := pkg_models.Module{FileSystem: fstest.MapFS{"Modfile.yml": {Data: []byte("test")}}}
+	snapshot := pkg_models.DeploymentSnapshot{Id: "snapshot", DeploymentId: "deployment"}
+	dirPath := path.Join(h.config.WorkdirPath, snapshotsDirName, snapshot.DeploymentId, snapshot.Id)
+	if err := h.copySnapshotFiles(module, "files", dirPath); err != nil {
+		t.Fatal(err)
+	}
+	b, err := fs.ReadFile(h.getSnapshotModuleFS(snapshot), "Modfile.yml")
+	if err != nil {
+		t.Error(err)
+	} else if string(b) != "test" {
+		t.Errorf("expected test, got %s", string(b))
+	}
+	if _, err = os.Stat(path.Join(dirPath, snapshotFilesDirName, "group", "test.conf")); err != nil {
+		t.Error(err)
+	}
+	if err = h.removeSnapshots(snapshot.DeploymentId); err != nil {
+		t.Error(err)
+	}
+	if _, err = os.Stat(dirPath); !os.IsNotExist(err) {
+		t.Error("expected snapshot files to be removed")
+	}
+}
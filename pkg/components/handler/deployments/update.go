@@ -22,9 +22,9 @@ import (
 	"os"
 	"path"
 	"slices"
+	"strings"
 
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
-	helper_maps "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/maps"
 	helper_naming "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/naming"
 	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
@@ -43,12 +43,23 @@ func (h *Handler) UpdateDeployments(
 	moduleIds := slices.Collect(maps.Keys(selectedModules))
 	deployments, err := h.databaseHandler.ReadDeployments(ctx, pkg_models.DeploymentsFilter{
 		ModuleIds: moduleIds,
+		Names:     []string{""},
 	})
 	if err != nil {
 		logger.ErrorContext(ctx, "update deployments, read from database", slog_keys.ModuleIds, moduleIds, slog_keys.Error, err)
 		return nil, err
 	}
 	deploymentIds := slices.Collect(maps.Keys(deployments))
+	deploymentsUserData, err := h.getDeploymentsUserDataFromDB(ctx, deploymentIds)
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"update deployments, read user data from database",
+			slog_keys.DeploymentIds, deploymentIds,
+			slog_keys.Error, err,
+		)
+		return nil, err
+	}
 	deploymentsVolumes, deploymentsContainers, err := h.getDeploymentsVolumesAndContainersFromDB(ctx, deploymentIds)
 	if err != nil {
 		logger.ErrorContext(
@@ -69,8 +80,14 @@ func (h *Handler) UpdateDeployments(
 		logger.ErrorContext(ctx, "update deployments, initialize cache", slog_keys.Error, err)
 		return nil, err
 	}
+	modulesGraph, err := getModulesGraph(selectedModules)
+	if err != nil {
+		logger.ErrorContext(ctx, "update deployments, get modules order", slog_keys.Error, err)
+		return nil, err
+	}
 	var results []lib_models.DeploymentResult
-	for moduleId, module := range selectedModules {
+	for _, moduleId := range modulesGraph.order {
+		module := selectedModules[moduleId]
 		result := lib_models.DeploymentResult{ModuleId: moduleId}
 		cacheItem, ok := cache.Deployments[moduleId]
 		if !ok {
@@ -79,19 +96,25 @@ func (h *Handler) UpdateDeployments(
 			continue
 		}
 		result.Id = cacheItem.DeploymentId
+		rb := newRollback(cacheItem.DeploymentId)
 		err = h.updateDeployment(
 			ctx,
 			module,
 			userInputs[moduleId],
 			cacheItem.DeploymentId,
 			cacheItem.Containers,
-			deployments[cacheItem.DeploymentId],
-			deploymentsContainers[cacheItem.DeploymentId],
-			deploymentsVolumes[cacheItem.DeploymentId],
+			currentDeploymentData{
+				Deployment: deployments[cacheItem.DeploymentId],
+				UserData:   deploymentsUserData[cacheItem.DeploymentId],
+				Containers: deploymentsContainers[cacheItem.DeploymentId],
+				Volumes:    deploymentsVolumes[cacheItem.DeploymentId],
+			},
 			cache,
+			rb,
 		)
 		if err != nil {
 			result.ErrorResult = lib_models.NewErrorResult(err.Error())
+			result.Rollback = rb.run(ctx)
 		}
 		results = append(results, result)
 	}
@@ -104,12 +127,11 @@ func (h *Handler) updateDeployment(
 	userInput pkg_models.DeploymentUserInput,
 	deploymentId string,
 	cacheContainers map[string]containerCacheItem,
-	currentDeployment pkg_models.DeploymentBase,
-	currentContainers map[string]pkg_models.DeploymentContainerBase,
-	currentVolumes map[string]pkg_models.DeploymentVolume,
+	current currentDeploymentData,
 	cache cacheCollection,
+	rb *rollback,
 ) error {
-	newDeployment, err := getDeployment(module, deploymentId)
+	newDeployment, err := getDeployment(module, current.Deployment.Name, deploymentId)
 	if err != nil {
 		logger.ErrorContext(
 			ctx,
@@ -120,8 +142,8 @@ func (h *Handler) updateDeployment(
 		)
 		return err
 	}
-	newDeployment.Enabled = currentDeployment.Enabled
-	newDeployment.Created = currentDeployment.Created
+	newDeployment.Enabled = current.Deployment.Enabled
+	newDeployment.Created = current.Deployment.Created
 	newDeployment.Updated = helper_time.Now()
 	defaultData, err := getDefaultData(module)
 	if err != nil {
@@ -145,9 +167,10 @@ func (h *Handler) updateDeployment(
 		)
 		return err
 	}
-	err = h.updateCaches(
+	cache, err = h.updateCaches(
 		ctx,
 		module.Dependencies,
+		userData.DependencyTargets,
 		userData.HostResources,
 		userData.Secrets,
 		userData.GlobalConfigs,
@@ -202,174 +225,107 @@ func (h *Handler) updateDeployment(
 		)
 		return err
 	}
-	err = h.stopContainers(ctx, currentContainers)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, stop containers",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	updatedVolumes := updateVolumes(module.Volumes, currentVolumes, deploymentId)
-	err = h.removeDeploymentEnvironment(
-		ctx,
-		deploymentId,
-		currentDeployment.DirName,
-		currentDeployment.FilesDirName,
-		currentContainers,
-	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, remove environment",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.databaseHandler.UpdateDeployment(
+	err = h.replaceDeployment(
 		ctx,
+		"update deployment",
+		module,
 		newDeployment,
-		slices.Collect(maps.Values(userData.HostResources)),
-		slices.Collect(maps.Values(userData.Secrets)),
-		slices.Collect(maps.Values(userData.Configs)),
-		slices.Collect(maps.Values(userData.GlobalConfigs)),
-		slices.Collect(maps.Values(userData.Files)),
-		slices.Collect(maps.Values(userData.FileGroups)),
-		slices.Collect(maps.Values(updatedVolumes)),
-		slices.Collect(maps.Values(newContainers)),
-	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, write to database",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.ensureDeploymentEnvironment(
-		ctx,
-		module.Services,
-		module.FileSystem,
-		deploymentId,
-		newDeployment.DirName,
-		newDeployment.FilesDirName,
-		updatedVolumes,
-	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, ensure environment",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	bindMounts, err := h.getBindMounts(
-		ctx,
-		deploymentId,
-		newDeployment.FilesDirName,
-		userData.FileGroups,
-		userData.Secrets,
-		mergedFiles,
-	)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, get bind mounts",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	// TODO "mount secrets" must be "unloaded" if one of the following steps fail
-	err = h.createContainers(
-		ctx,
-		module.Configs,
-		module.Services,
-		deploymentId,
-		newDeployment.DirName,
-		newDeployment.FilesDirName,
-		userData.Secrets,
-		userData.HostResources,
+		userData,
 		newContainers,
-		updatedVolumes,
+		updateVolumes(module.Volumes, current.Volumes, deploymentId),
 		mergedConfigs,
-		bindMounts,
-		cache.SecretValues,
-		cache.Deployments,
-		cache.HostResources,
+		mergedFiles,
+		current,
+		cache,
+		rb,
 	)
 	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, create containers",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
-		return err
-	}
-	err = h.createHttpEndpoints(ctx, module.Services, module.ID, deploymentId, newContainers)
-	if err != nil {
-		logger.ErrorContext(
-			ctx,
-			"update deployment, create http endpoints",
-			slog_keys.ModuleId, module.ID,
-			slog_keys.DeploymentId, deploymentId,
-			slog_keys.Error, err,
-		)
 		return err
 	}
+	h.createSnapshot(ctx, module, newDeployment, userInput, newContainers)
 	return nil
 }
 
+// initDeploymentsCacheFromModulesAndDeployments returns cache items for the primary deployments of the provided
+// modules.
 func initDeploymentsCacheFromModulesAndDeployments(
 	modules map[string]pkg_models.Module,
 	deployments map[string]pkg_models.DeploymentBase,
 	deploymentsContainers map[string]map[string]pkg_models.DeploymentContainerBase,
 ) (map[string]deploymentsCacheItem, error) {
 	cache := make(map[string]deploymentsCacheItem)
-	deployments = helper_maps.CollectFunc(maps.Values(deployments), func(value pkg_models.DeploymentBase) string {
-		return value.ModuleId
-	})
-	for moduleId, module := range modules {
-		deployment, ok := deployments[moduleId]
+	for _, deployment := range deployments {
+		if deployment.Name != "" {
+			continue
+		}
+		module, ok := modules[deployment.ModuleId]
 		if !ok {
 			continue
 		}
-		containers := make(map[string]containerCacheItem)
-		for reference := range module.Services {
-			existingContainer := deploymentsContainers[deployment.Id][reference]
-			name, err := helper_naming.NewContainerName(constants.DeploymentAbbreviation)
-			if err != nil {
-				return nil, err
-			}
-			alias := existingContainer.Alias
-			if alias == "" {
-				alias = helper_naming.NewContainerAlias(deployment.Id, reference)
-			}
-			containers[reference] = containerCacheItem{
-				Name:  name,
-				Alias: alias,
-			}
+		cacheItem, err := newDeploymentsCacheItemFromDeployment(module, deployment.Id, deploymentsContainers[deployment.Id])
+		if err != nil {
+			return nil, err
+		}
+		cache[deployment.ModuleId] = cacheItem
+	}
+	return cache, nil
+}
+
+// initInstancesCacheFromModulesAndDeployments returns cache items for the deployment instances of the provided
+// modules ordered by instance name.
+func initInstancesCacheFromModulesAndDeployments(
+	modules map[string]pkg_models.Module,
+	deployments map[string]pkg_models.DeploymentBase,
+	deploymentsContainers map[string]map[string]pkg_models.DeploymentContainerBase,
+) (map[string][]deploymentsCacheItem, error) {
+	instances := slices.SortedFunc(maps.Values(deployments), func(a, b pkg_models.DeploymentBase) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	cache := make(map[string][]deploymentsCacheItem)
+	for _, deployment := range instances {
+		if deployment.Name == "" {
+			continue
+		}
+		module, ok := modules[deployment.ModuleId]
+		if !ok {
+			continue
 		}
-		cache[moduleId] = deploymentsCacheItem{
-			DeploymentId: deployment.Id,
-			Containers:   containers,
+		cacheItem, err := newDeploymentsCacheItemFromDeployment(module, deployment.Id, deploymentsContainers[deployment.Id])
+		if err != nil {
+			return nil, err
 		}
+		cache[deployment.ModuleId] = append(cache[deployment.ModuleId], cacheItem)
 	}
 	return cache, nil
 }
 
+func newDeploymentsCacheItemFromDeployment(
+	module pkg_models.Module,
+	deploymentId string,
+	deploymentContainers map[string]pkg_models.DeploymentContainerBase,
+) (deploymentsCacheItem, error) {
+	containers := make(map[string]containerCacheItem)
+	for reference := range module.Services {
+		existingContainer := deploymentContainers[reference]
+		name, err := helper_naming.NewContainerName(constants.DeploymentAbbreviation)
+		if err != nil {
+			return deploymentsCacheItem{}, err
+		}
+		alias := existingContainer.Alias
+		if alias == "" {
+			alias = helper_naming.NewContainerAlias(deploymentId, reference)
+		}
+		containers[reference] = containerCacheItem{
+			Name:  name,
+			Alias: alias,
+		}
+	}
+	return deploymentsCacheItem{
+		DeploymentId: deploymentId,
+		Containers:   containers,
+	}, nil
+}
+
 func (h *Handler) removeDeploymentDirs(deploymentDirName, deploymentFilesDirName string) error {
 	err := removeDeploymentDir(h.config.WorkdirPath, deploymentDirName)
 	if err != nil {
@@ -36,29 +36,65 @@ func (h *Handler) ensureContainerVolumes(ctx context.Context,
 	volumes map[string]pkg_models.DeploymentVolume,
 	deploymentId string,
 ) error {
+	err := h.removeUnusedContainerVolumes(ctx, volumes, deploymentId)
+	if err != nil {
+		return err
+	}
+	_, err = h.createMissingContainerVolumes(ctx, volumes, deploymentId)
+	return err
+}
+
+// createMissingContainerVolumes creates volumes that do not exist and returns the created volumes, also if an error
+// occurred.
+func (h *Handler) createMissingContainerVolumes(ctx context.Context,
+	volumes map[string]pkg_models.DeploymentVolume,
+	deploymentId string,
+) (map[string]pkg_models.DeploymentVolume, error) {
 	volumes = helper_maps.CollectFunc(maps.Values(volumes), func(value pkg_models.DeploymentVolume) string {
 		return value.Name
 	})
 	existingVolumes, err := h.getContainerVolumes(ctx, deploymentId, slices.Collect(maps.Keys(volumes)))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	createdVolumes := make(map[string]pkg_models.DeploymentVolume)
 	var errs []error
-	for name := range existingVolumes {
-		_, ok := volumes[name]
+	for name, volume := range volumes {
+		_, ok := existingVolumes[name]
 		if !ok {
-			err = helper_containers.RemoveVolume(ctx, h.containerEngineWrapperClient, name)
+			err = h.createContainerVolume(ctx, volume)
 			if err != nil {
-				errs = append(errs, fmt.Errorf("'%s' %w", name, err))
+				errs = append(errs, fmt.Errorf("'%s' %w", volume.Reference, err))
+				continue
 			}
+			createdVolumes[volume.Reference] = volume
 		}
 	}
-	for name, volume := range volumes {
-		_, ok := existingVolumes[name]
+	if len(errs) > 0 {
+		return createdVolumes, helper_errors.Join(errs...)
+	}
+	return createdVolumes, nil
+}
+
+// removeUnusedContainerVolumes removes volumes of the deployment that are not contained in volumes.
+func (h *Handler) removeUnusedContainerVolumes(ctx context.Context,
+	volumes map[string]pkg_models.DeploymentVolume,
+	deploymentId string,
+) error {
+	volumes = helper_maps.CollectFunc(maps.Values(volumes), func(value pkg_models.DeploymentVolume) string {
+		return value.Name
+	})
+	existingVolumes, err := h.getContainerVolumes(ctx, deploymentId, slices.Collect(maps.Keys(volumes)))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for name := range existingVolumes {
+		_, ok := volumes[name]
 		if !ok {
-			err = h.createContainerVolume(ctx, volume)
+			err = helper_containers.RemoveVolume(ctx, h.containerEngineWrapperClient, name)
 			if err != nil {
-				errs = append(errs, fmt.Errorf("'%s' %w", volume.Reference, err))
+				errs = append(errs, fmt.Errorf("'%s' %w", name, err))
 			}
 		}
 	}
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"slices"
+	"sync"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+type Config struct {
+	BufferSize int
+}
+
+// Handler distributes events to subscribers. Events are dropped for subscribers that do not keep up.
+type Handler struct {
+	subscriptions map[*subscription]struct{}
+	config        Config
+	closed        bool
+	mu            sync.RWMutex
+}
+
+type subscription struct {
+	filter  lib_models.EventsFilter
+	channel chan lib_models.Event
+}
+
+func New(config Config) *Handler {
+	return &Handler{
+		subscriptions: make(map[*subscription]struct{}),
+		config:        config,
+	}
+}
+
+func (h *Handler) Publish(event lib_models.Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = helper_time.Now()
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subscriptions {
+		if !match(sub.filter, event) {
+			continue
+		}
+		select {
+		case sub.channel <- event:
+		default:
+			logger.Warn("subscriber buffer full, event dropped", slog_keys.EventType, event.Type)
+		}
+	}
+}
+
+// Subscribe returns a channel providing events matching the filter and a function to end the subscription. The
+// channel is closed if the subscription is ended or the handler is closed.
+func (h *Handler) Subscribe(filter lib_models.EventsFilter) (<-chan lib_models.Event, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub := &subscription{
+		filter:  filter,
+		channel: make(chan lib_models.Event, h.config.BufferSize),
+	}
+	if h.closed {
+		close(sub.channel)
+		return sub.channel, func() {}
+	}
+	h.subscriptions[sub] = struct{}{}
+	return sub.channel, func() {
+		h.unsubscribe(sub)
+	}
+}
+
+// Close ends all subscriptions and prevents new subscriptions.
+func (h *Handler) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subscriptions {
+		close(sub.channel)
+		delete(h.subscriptions, sub)
+	}
+}
+
+func (h *Handler) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscriptions[sub]; !ok {
+		return
+	}
+	close(sub.channel)
+	delete(h.subscriptions, sub)
+}
+
+func match(filter lib_models.EventsFilter, event lib_models.Event) bool {
+	if len(filter.Types) > 0 && !slices.Contains(filter.Types, event.Type) {
+		return false
+	}
+	if len(filter.JobIds) == 0 && len(filter.DeploymentIds) == 0 && len(filter.ModuleIds) == 0 {
+		return true
+	}
+	var jobId, deploymentId, moduleId string
+	switch event.Type {
+	case lib_constants.EventDeploymentState:
+		if event.Deployment != nil {
+			deploymentId = event.Deployment.Id
+			moduleId = event.Deployment.ModuleId
+		}
+	case lib_constants.EventAuxiliaryDeploymentState:
+		if event.AuxiliaryDeployment != nil {
+			deploymentId = event.AuxiliaryDeployment.DeploymentId
+			moduleId = event.AuxiliaryDeployment.ModuleId
+		}
+	case lib_constants.EventModuleUpdatesAvailable:
+		return slices.ContainsFunc(event.ModuleUpdates, func(update lib_models.ModuleUpdateEvent) bool {
+			return slices.Contains(filter.ModuleIds, update.Installed.Id)
+		})
+	default:
+		if event.Job != nil {
+			jobId = event.Job.Id
+			deploymentId = event.Job.DeploymentId
+		}
+	}
+	return jobId != "" && slices.Contains(filter.JobIds, jobId) ||
+		deploymentId != "" && slices.Contains(filter.DeploymentIds, deploymentId) ||
+		moduleId != "" && slices.Contains(filter.ModuleIds, moduleId)
+}
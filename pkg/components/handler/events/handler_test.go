@@ -0,0 +1,96 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"testing"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+)
+
+func TestHandler(t *testing.T) {
+	h := New(Config{BufferSize: 1})
+	events, unsubscribe := h.Subscribe(lib_models.EventsFilter{ModuleIds: []string{"mod"}})
+	defer unsubscribe()
+	h.Publish(lib_models.Event{Type: lib_constants.EventJobCreated, Job: &lib_models.Job{Id: "job"}})
+	h.Publish(lib_models.Event{
+		Type:                lib_constants.EventAuxiliaryDeploymentState,
+		AuxiliaryDeployment: &lib_models.AuxiliaryDeploymentStateEvent{Id: "aux", DeploymentId: "dep", ModuleId: "mod"},
+	})
+	// buffer full, event dropped
+	h.Publish(lib_models.Event{
+		Type:       lib_constants.EventDeploymentState,
+		Deployment: &lib_models.DeploymentStateEvent{Id: "dep", ModuleId: "mod"},
+	})
+	event := <-events
+	if event.Type != lib_constants.EventAuxiliaryDeploymentState || event.Timestamp.IsZero() {
+		t.Errorf("unexpected event %v", event)
+	}
+	select {
+	case event = <-events:
+		t.Errorf("unexpected event %v", event)
+	default:
+	}
+	h.Close()
+	if _, ok := <-events; ok {
+		t.Error("expected closed channel")
+	}
+	events, _ = h.Subscribe(lib_models.EventsFilter{})
+	if _, ok := <-events; ok {
+		t.Error("expected closed channel")
+	}
+}
+
+func Test_match(t *testing.T) {
+	jobEvent := lib_models.Event{Type: lib_constants.EventJobCompleted, Job: &lib_models.Job{Id: "job"}}
+	depJobEvent := lib_models.Event{Type: lib_constants.EventJobCompleted, Job: &lib_models.Job{Id: "job", DeploymentId: "dep"}}
+	depEvent := lib_models.Event{
+		Type:       lib_constants.EventDeploymentState,
+		Deployment: &lib_models.DeploymentStateEvent{Id: "dep", ModuleId: "mod"},
+	}
+	updatesEvent := lib_models.Event{
+		Type:          lib_constants.EventModuleUpdatesAvailable,
+		ModuleUpdates: []lib_models.ModuleUpdateEvent{{Installed: lib_models.ModuleAbbreviated{Id: "mod"}}},
+	}
+	tests := []struct {
+		name   string
+		filter lib_models.EventsFilter
+		event  lib_models.Event
+		want   bool
+	}{
+		{"no filter", lib_models.EventsFilter{}, jobEvent, true},
+		{"type", lib_models.EventsFilter{Types: []string{lib_constants.EventJobCreated}}, jobEvent, false},
+		{"job id", lib_models.EventsFilter{JobIds: []string{"job"}}, jobEvent, true},
+		{"other job id", lib_models.EventsFilter{JobIds: []string{"other"}}, jobEvent, false},
+		{"deployment id", lib_models.EventsFilter{DeploymentIds: []string{"dep"}}, depEvent, true},
+		{"deployment id job event", lib_models.EventsFilter{DeploymentIds: []string{"dep"}}, depJobEvent, true},
+		{"other deployment id job event", lib_models.EventsFilter{DeploymentIds: []string{"other"}}, depJobEvent, false},
+		{"deployment id unowned job event", lib_models.EventsFilter{DeploymentIds: []string{"dep"}}, jobEvent, false},
+		{"job or module id", lib_models.EventsFilter{JobIds: []string{"job"}, ModuleIds: []string{"mod"}}, depEvent, true},
+		{"module id job event", lib_models.EventsFilter{ModuleIds: []string{"mod"}}, jobEvent, false},
+		{"module id updates event", lib_models.EventsFilter{ModuleIds: []string{"mod"}}, updatesEvent, true},
+		{"deployment id updates event", lib_models.EventsFilter{DeploymentIds: []string{"dep"}}, updatesEvent, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := match(tt.filter, tt.event); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
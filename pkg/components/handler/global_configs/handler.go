@@ -17,8 +17,14 @@
 package global_configs
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	helper_uuid "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/uuid"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
@@ -77,8 +83,37 @@ func (h *Handler) UpdateGlobalConfig(ctx context.Context, config pkg_models.Conf
 	return nil
 }
 
-func (h *Handler) DeleteGlobalConfig(ctx context.Context, id string) error {
-	err := h.databaseHandler.DeleteGlobalConfig(ctx, id)
+// GetReferences returns the deployments referencing the global configs. If no IDs are provided the references of all
+// global configs are returned.
+func (h *Handler) GetReferences(ctx context.Context, ids []string) (map[string][]pkg_models.DeploymentGlobalConfig, error) {
+	deploymentsGlobalConfigs, err := h.databaseHandler.ReadDeploymentsGlobalConfigs(ctx, pkg_models.DeploymentGlobalConfigsFilter{Ids: ids})
+	if err != nil {
+		logger.ErrorContext(ctx, "get global config references", slog_keys.Filter, ids, slog_keys.Error, err)
+		return nil, err
+	}
+	references := make(map[string][]pkg_models.DeploymentGlobalConfig)
+	for _, globalConfigs := range deploymentsGlobalConfigs {
+		for _, globalConfig := range globalConfigs {
+			references[globalConfig.Id] = append(references[globalConfig.Id], globalConfig)
+		}
+	}
+	for _, items := range references {
+		slices.SortFunc(items, func(a, b pkg_models.DeploymentGlobalConfig) int {
+			return cmp.Or(strings.Compare(a.DeploymentId, b.DeploymentId), strings.Compare(a.Reference, b.Reference))
+		})
+	}
+	return references, nil
+}
+
+// DeleteGlobalConfig deletes a global config. Global configs referenced by deployments are only deleted if force is
+// true, the references are removed and deployments use the default values of the module on the next update.
+func (h *Handler) DeleteGlobalConfig(ctx context.Context, id string, force bool) error {
+	if !force {
+		if err := h.checkReferences(ctx, []string{id}); err != nil {
+			return err
+		}
+	}
+	err := h.databaseHandler.DeleteGlobalConfig(ctx, id, force)
 	if err != nil {
 		logger.ErrorContext(ctx, "delete global config", slog_keys.GlobalConfigId, id, slog_keys.Error, err)
 		return err
@@ -86,17 +121,43 @@ func (h *Handler) DeleteGlobalConfig(ctx context.Context, id string) error {
 	return nil
 }
 
-func (h *Handler) DeleteGlobalConfigs(ctx context.Context, ids []string, allowAll bool) error {
+func (h *Handler) DeleteGlobalConfigs(ctx context.Context, ids []string, allowAll, force bool) error {
 	if !allowAll && len(ids) == 0 {
 		return nil
 	}
 	if allowAll {
 		logger.WarnContext(ctx, "delete global configs", slog_keys.Filter, ids, slog_keys.AllowAll, allowAll)
 	}
-	err := h.databaseHandler.DeleteGlobalConfigs(ctx, ids)
+	if !force {
+		if err := h.checkReferences(ctx, ids); err != nil {
+			return err
+		}
+	}
+	err := h.databaseHandler.DeleteGlobalConfigs(ctx, ids, force)
 	if err != nil {
 		logger.ErrorContext(ctx, "delete global configs", slog_keys.Filter, ids, slog_keys.AllowAll, allowAll, slog_keys.Error, err)
 		return err
 	}
 	return nil
 }
+
+func (h *Handler) checkReferences(ctx context.Context, ids []string) error {
+	references, err := h.GetReferences(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(references) == 0 {
+		return nil
+	}
+	var items []string
+	for _, id := range slices.Sorted(maps.Keys(references)) {
+		var deploymentIds []string
+		for _, reference := range references[id] {
+			if !slices.Contains(deploymentIds, reference.DeploymentId) {
+				deploymentIds = append(deploymentIds, reference.DeploymentId)
+			}
+		}
+		items = append(items, fmt.Sprintf("'%s' used by deployments %s", id, strings.Join(deploymentIds, ", ")))
+	}
+	return lib_errors.New[lib_errors.ErrInUse]("global configs in use: " + strings.Join(items, "; "))
+}
@@ -27,6 +27,10 @@ type databaseHandler interface {
 	ReadGlobalConfig(ctx context.Context, id string) (pkg_models.Config, error)
 	ReadGlobalConfigs(ctx context.Context, ids []string) (map[string]pkg_models.Config, error)
 	UpdateGlobalConfig(ctx context.Context, config pkg_models.Config) error
-	DeleteGlobalConfig(ctx context.Context, id string) error
-	DeleteGlobalConfigs(ctx context.Context, ids []string) error
+	DeleteGlobalConfig(ctx context.Context, id string, removeReferences bool) error
+	DeleteGlobalConfigs(ctx context.Context, ids []string, removeReferences bool) error
+	ReadDeploymentsGlobalConfigs(
+		ctx context.Context,
+		filter pkg_models.DeploymentGlobalConfigsFilter,
+	) (map[string]map[string]pkg_models.DeploymentGlobalConfig, error)
 }
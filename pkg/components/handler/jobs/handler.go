@@ -23,9 +23,14 @@ import (
 	"sync"
 	"time"
 
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_progress "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/progress"
 	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	helper_uuid "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/uuid"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 )
 
 const ContextKeyJobId = "job_id"
@@ -36,24 +41,69 @@ type Config struct {
 }
 
 type Handler struct {
-	jobSlots       map[int]*Job
-	jobMap         map[string]*Job
-	config         Config
-	cleanupHandler func([]string)
-	ctx            context.Context
-	mu             sync.RWMutex
+	jobSlots map[int]*Job
+	jobMap   map[string]*Job
+	config   Config
+	dbHdl    databaseHandler
+	evHdl    eventsHandler
+	ctx      context.Context
+	mu       sync.RWMutex
 }
 
-func New(ctx context.Context, config Config) *Handler {
+func New(ctx context.Context, config Config, dbHdl databaseHandler, evHdl eventsHandler) *Handler {
 	return &Handler{
 		jobSlots: make(map[int]*Job),
 		jobMap:   make(map[string]*Job),
 		config:   config,
+		dbHdl:    dbHdl,
+		evHdl:    evHdl,
 		ctx:      ctx,
 	}
 }
 
+// Init marks stored jobs that did not finish before the last shutdown as interrupted and loads all stored jobs.
+func (h *Handler) Init(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	err := h.dbHdl.InterruptJobs(ctx, helper_time.Now())
+	if err != nil {
+		return err
+	}
+	dbJobs, err := h.dbHdl.ReadJobs(ctx)
+	if err != nil {
+		return err
+	}
+	for id, dbJob := range dbJobs {
+		if _, ok := h.jobMap[id]; ok {
+			continue
+		}
+		jCtx, cf := context.WithCancel(h.ctx)
+		cf()
+		h.jobMap[id] = &Job{
+			Id:           dbJob.Id,
+			Description:  dbJob.Description,
+			DeploymentId: dbJob.DeploymentId,
+			Start:        dbJob.Start,
+			end:          dbJob.End,
+			interrupted:  dbJob.Interrupted,
+			context:      context.WithValue(jCtx, ContextKeyJobId, id),
+			cancelFunc:   cf,
+		}
+	}
+	return nil
+}
+
 func (h *Handler) CreateJob(description string) (*Job, error) {
+	return h.createJob("", description)
+}
+
+// CreateDeploymentJob creates a job that belongs to a deployment. Deployments can only access their own jobs via the
+// restricted API.
+func (h *Handler) CreateDeploymentJob(deploymentId, description string) (*Job, error) {
+	return h.createJob(deploymentId, description)
+}
+
+func (h *Handler) createJob(deploymentId, description string) (*Job, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	id, err := helper_uuid.New()
@@ -63,13 +113,23 @@ func (h *Handler) CreateJob(description string) (*Job, error) {
 	ctx, cf := context.WithCancel(h.ctx)
 	ctx = context.WithValue(ctx, ContextKeyJobId, id)
 	job := &Job{
-		Id:          id,
-		Description: description,
-		Start:       helper_time.Now(),
-		context:     ctx,
-		cancelFunc:  cf,
+		Id:           id,
+		Description:  description,
+		DeploymentId: deploymentId,
+		Start:        helper_time.Now(),
+		doneHandler: jobDoneHandler{
+			doneFunc: h.jobDone,
+		},
+		eventFunc:  h.publishJobEvent,
+		cancelFunc: cf,
+	}
+	job.context = helper_progress.NewContext(ctx, job)
+	if err = h.storeJob(job); err != nil {
+		cf()
+		return nil, err
 	}
 	h.jobMap[id] = job
+	h.publishJobEvent(lib_constants.EventJobCreated, job)
 	return job, nil
 }
 
@@ -94,11 +154,17 @@ func (h *Handler) CreateSlotJob(slotNum int, description string) (*Job, error) {
 			slotNum:  slotNum,
 			doneFunc: h.slotJobDone,
 		},
-		context:    ctx,
+		eventFunc:  h.publishJobEvent,
 		cancelFunc: cf,
 	}
+	job.context = helper_progress.NewContext(ctx, job)
+	if err = h.storeJob(job); err != nil {
+		cf()
+		return nil, err
+	}
 	h.jobSlots[slotNum] = job
 	h.jobMap[id] = job
+	h.publishJobEvent(lib_constants.EventJobCreated, job)
 	return job, nil
 }
 
@@ -148,19 +214,14 @@ func (h *Handler) Jobs(filterIds []string) map[string]*Job {
 	return tmp
 }
 
-func (h *Handler) SetCleanupHandler(f func([]string)) {
-	h.cleanupHandler = f
-}
-
 func (h *Handler) Cleanup(ctx context.Context) {
 	timer := time.NewTimer(h.config.CleanupLoopDelay)
 	defer timer.Stop()
 	for {
 		select {
 		case <-timer.C:
-			oldJobs := h.cleanup()
-			if h.cleanupHandler != nil && len(oldJobs) > 0 {
-				h.cleanupHandler(oldJobs)
+			if err := h.cleanup(ctx); err != nil {
+				logger.ErrorContext(ctx, "cleanup jobs", slog_keys.Error, err)
 			}
 			timer.Reset(h.config.CleanupLoopDelay)
 		case <-ctx.Done():
@@ -169,35 +230,82 @@ func (h *Handler) Cleanup(ctx context.Context) {
 	}
 }
 
-func (h *Handler) cleanup() []string {
+// cleanup removes finished jobs older than the max job age. Stored job results are removed together with the jobs.
+func (h *Handler) cleanup(ctx context.Context) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	var oldJobs []string
-	tmp := make(map[string]*Job)
 	now := helper_time.Now()
 	for id, job := range h.jobMap {
 		end := job.End()
-		if now.Sub(end) < h.config.MaxJobAge {
-			tmp[id] = job
-		} else {
+		if !end.IsZero() && now.Sub(end) >= h.config.MaxJobAge {
 			oldJobs = append(oldJobs, id)
 		}
 	}
-	h.jobMap = tmp
-	return oldJobs
+	if len(oldJobs) == 0 {
+		return nil
+	}
+	if err := h.dbHdl.DeleteJobs(ctx, oldJobs); err != nil {
+		return err
+	}
+	for _, id := range oldJobs {
+		delete(h.jobMap, id)
+	}
+	logger.DebugContext(ctx, "removed old jobs", slog_keys.JobIds, oldJobs)
+	return nil
+}
+
+func (h *Handler) storeJob(job *Job) error {
+	return h.dbHdl.CreateJob(h.ctx, pkg_models.Job{
+		Id:           job.Id,
+		Description:  job.Description,
+		Start:        job.Start,
+		DeploymentId: job.DeploymentId,
+	})
 }
 
-func (h *Handler) slotJobDone(slotNum int) {
+func (h *Handler) publishJobEvent(eventType string, job *Job) {
+	h.evHdl.Publish(lib_models.Event{
+		Type: eventType,
+		Job: &lib_models.Job{
+			Id:           job.Id,
+			Description:  job.Description,
+			DeploymentId: job.DeploymentId,
+			Start:        job.Start,
+			End:          job.End(),
+			Interrupted:  job.Interrupted(),
+			Progress:     job.Progress(),
+		},
+	})
+}
+
+func (h *Handler) jobDone(id string, end time.Time) {
+	// the handler context may already be canceled during shutdown, the end of the job should be stored regardless
+	if err := h.dbHdl.UpdateJobEnd(context.Background(), id, end); err != nil {
+		logger.Error("update job end", slog_keys.JobId, id, slog_keys.Error, err)
+	}
+}
+
+func (h *Handler) slotJobDone(slotNum int, id string, end time.Time) {
+	h.jobDone(id, end)
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	delete(h.jobSlots, slotNum)
 }
 
+type jobDoneHandler struct {
+	doneFunc func(string, time.Time)
+}
+
+func (h jobDoneHandler) JobDone(id string, end time.Time) {
+	h.doneFunc(id, end)
+}
+
 type slotJobDoneHandler struct {
 	slotNum  int
-	doneFunc func(int)
+	doneFunc func(int, string, time.Time)
 }
 
-func (h slotJobDoneHandler) JobDone() {
-	h.doneFunc(h.slotNum)
+func (h slotJobDoneHandler) JobDone(id string, end time.Time) {
+	h.doneFunc(h.slotNum, id, end)
 }
@@ -0,0 +1,209 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_progress "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/progress"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func TestHandler(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	mockDB := &databaseMock{
+		Jobs: map[string]pkg_models.Job{
+			"1": {Id: "1", Description: "finished", Start: start, End: start.Add(time.Minute)},
+			"2": {Id: "2", Description: "running", Start: start},
+			"3": {Id: "3", Description: "deployment", Start: start, End: start.Add(time.Minute), DeploymentId: "dep"},
+		},
+	}
+	mockEvents := &eventsMock{}
+	h := New(context.Background(), Config{MaxJobAge: time.Hour}, mockDB, mockEvents)
+	if err := h.Init(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	job, ok := h.Job("2")
+	if !ok {
+		t.Fatal("expected job")
+	}
+	if !job.Interrupted() || job.End().IsZero() {
+		t.Error("expected interrupted job")
+	}
+	job, ok = h.Job("1")
+	if !ok {
+		t.Fatal("expected job")
+	}
+	if job.Interrupted() {
+		t.Error("expected job not interrupted")
+	}
+	if job, ok = h.Job("3"); !ok || job.DeploymentId != "dep" {
+		t.Error("expected job of deployment")
+	}
+	t.Run("create job", func(t *testing.T) {
+		job, err := h.CreateSlotJob(0, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := mockDB.Jobs[job.Id]; !ok {
+			t.Error("expected stored job")
+		}
+		job.Done()
+		if mockDB.Jobs[job.Id].End.IsZero() {
+			t.Error("expected stored end")
+		}
+		if _, ok := h.CurrentSlotJob(0); ok {
+			t.Error("expected free slot")
+		}
+		if len(mockEvents.Events) != 2 || mockEvents.Events[0].Type != lib_constants.EventJobCreated || mockEvents.Events[1].Type != lib_constants.EventJobCompleted {
+			t.Errorf("expected created and completed events, got %v", mockEvents.Events)
+		}
+	})
+	t.Run("create deployment job", func(t *testing.T) {
+		job, err := h.CreateDeploymentJob("dep", "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer job.Done()
+		if job.DeploymentId != "dep" || mockDB.Jobs[job.Id].DeploymentId != "dep" {
+			t.Error("expected stored deployment id")
+		}
+		if event := mockEvents.Events[len(mockEvents.Events)-1]; event.Job == nil || event.Job.DeploymentId != "dep" {
+			t.Errorf("expected deployment id in event, got %v", event)
+		}
+	})
+	t.Run("progress", func(t *testing.T) {
+		job, err := h.CreateJob("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer job.Done()
+		if job.Progress() != nil {
+			t.Error("expected no progress")
+		}
+		helper_progress.SetSteps(job.Context(), 2)
+		helper_progress.NextStep(job.Context(), "step 1")
+		helper_progress.SetItem(job.Context(), "item", lib_constants.JobItemRunning)
+		progress := job.Progress()
+		if progress == nil || progress.Step != 1 || progress.StepsTotal != 2 || progress.Stage != "step 1" || progress.Items["item"] != lib_constants.JobItemRunning {
+			t.Errorf("unexpected progress %v", progress)
+		}
+	})
+	t.Run("create job error", func(t *testing.T) {
+		mockDB.Err = errors.New("test error")
+		defer func() {
+			mockDB.Err = nil
+		}()
+		if _, err := h.CreateSlotJob(0, "test"); err == nil {
+			t.Error("expected error")
+		}
+		if _, ok := h.CurrentSlotJob(0); ok {
+			t.Error("expected free slot")
+		}
+	})
+	t.Run("cleanup", func(t *testing.T) {
+		running, err := h.CreateJob("test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer running.Done()
+		h.config.MaxJobAge = time.Minute
+		if err = h.cleanup(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := h.Job("1"); ok {
+			t.Error("expected job to be removed")
+		}
+		if _, ok := mockDB.Jobs["1"]; ok {
+			t.Error("expected stored job to be removed")
+		}
+		if _, ok := h.Job(running.Id); !ok {
+			t.Error("expected running job")
+		}
+	})
+}
+
+type databaseMock struct {
+	Jobs map[string]pkg_models.Job
+	Err  error
+}
+
+func (m *databaseMock) CreateJob(_ context.Context, job pkg_models.Job) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.Jobs[job.Id] = job
+	return nil
+}
+
+func (m *databaseMock) ReadJobs(_ context.Context) (map[string]pkg_models.Job, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	jobs := make(map[string]pkg_models.Job)
+	for id, job := range m.Jobs {
+		jobs[id] = job
+	}
+	return jobs, nil
+}
+
+func (m *databaseMock) UpdateJobEnd(_ context.Context, id string, end time.Time) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	job := m.Jobs[id]
+	job.End = end
+	m.Jobs[id] = job
+	return nil
+}
+
+func (m *databaseMock) InterruptJobs(_ context.Context, end time.Time) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	for id, job := range m.Jobs {
+		if job.End.IsZero() {
+			job.End = end
+			job.Interrupted = true
+			m.Jobs[id] = job
+		}
+	}
+	return nil
+}
+
+func (m *databaseMock) DeleteJobs(_ context.Context, ids []string) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	for _, id := range ids {
+		delete(m.Jobs, id)
+	}
+	return nil
+}
+
+type eventsMock struct {
+	Events []lib_models.Event
+}
+
+func (m *eventsMock) Publish(event lib_models.Event) {
+	m.Events = append(m.Events, event)
+}
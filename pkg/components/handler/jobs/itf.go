@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jobs
+
+import (
+	"context"
+	"time"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+type databaseHandler interface {
+	CreateJob(ctx context.Context, job pkg_models.Job) error
+	ReadJobs(ctx context.Context) (map[string]pkg_models.Job, error)
+	UpdateJobEnd(ctx context.Context, id string, end time.Time) error
+	InterruptJobs(ctx context.Context, end time.Time) error
+	DeleteJobs(ctx context.Context, ids []string) error
+}
+
+type eventsHandler interface {
+	Publish(event lib_models.Event)
+}
@@ -18,25 +18,32 @@ package jobs
 
 import (
 	"context"
+	"maps"
 	"sync"
 	"time"
 
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 )
 
 type doneHandler interface {
-	JobDone()
+	JobDone(id string, end time.Time)
 }
 
 type Job struct {
-	Id          string
-	Description string
-	Start       time.Time
-	end         time.Time
-	doneHandler doneHandler
-	context     context.Context
-	cancelFunc  context.CancelFunc
-	mu          sync.RWMutex
+	Id           string
+	Description  string
+	DeploymentId string
+	Start        time.Time
+	end          time.Time
+	interrupted  bool
+	progress     *lib_models.JobProgress
+	doneHandler  doneHandler
+	eventFunc    func(string, *Job)
+	context      context.Context
+	cancelFunc   context.CancelFunc
+	mu           sync.RWMutex
 }
 
 func (j *Job) Context() context.Context {
@@ -44,14 +51,20 @@ func (j *Job) Context() context.Context {
 }
 
 func (j *Job) Cancel() {
+	if j.eventFunc != nil && j.context.Err() == nil && j.End().IsZero() {
+		defer j.eventFunc(lib_constants.EventJobCanceled, j)
+	}
 	j.cancelFunc()
 }
 
 func (j *Job) Done() {
 	defer j.cancelFunc()
-	j.setEnd()
+	end := j.setEnd()
 	if j.doneHandler != nil {
-		j.doneHandler.JobDone()
+		j.doneHandler.JobDone(j.Id, end)
+	}
+	if j.eventFunc != nil {
+		j.eventFunc(lib_constants.EventJobCompleted, j)
 	}
 }
 
@@ -61,8 +74,66 @@ func (j *Job) End() time.Time {
 	return j.end
 }
 
-func (j *Job) setEnd() {
+// Interrupted returns true if the job did not finish because the service was stopped.
+func (j *Job) Interrupted() bool {
+	return j.interrupted
+}
+
+// Progress returns a copy of the current progress or nil if no progress has been reported.
+func (j *Job) Progress() *lib_models.JobProgress {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if j.progress == nil {
+		return nil
+	}
+	progress := *j.progress
+	progress.Items = maps.Clone(j.progress.Items)
+	return &progress
+}
+
+func (j *Job) SetSteps(total int) {
+	j.updateProgress(func(progress *lib_models.JobProgress) {
+		progress.StepsTotal = total
+	})
+}
+
+func (j *Job) NextStep(stage string) {
+	j.updateProgress(func(progress *lib_models.JobProgress) {
+		progress.Step++
+		progress.Stage = stage
+	})
+}
+
+func (j *Job) SetStage(stage string) {
+	j.updateProgress(func(progress *lib_models.JobProgress) {
+		progress.Stage = stage
+	})
+}
+
+func (j *Job) SetItem(item, status string) {
+	j.updateProgress(func(progress *lib_models.JobProgress) {
+		if progress.Items == nil {
+			progress.Items = make(map[string]string)
+		}
+		progress.Items[item] = status
+	})
+}
+
+func (j *Job) updateProgress(f func(progress *lib_models.JobProgress)) {
+	j.mu.Lock()
+	if j.progress == nil {
+		j.progress = &lib_models.JobProgress{}
+	}
+	f(j.progress)
+	j.mu.Unlock()
+	if j.eventFunc != nil {
+		j.eventFunc(lib_constants.EventJobProgress, j)
+	}
+}
+
+func (j *Job) setEnd() time.Time {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 	j.end = helper_time.Now()
+	return j.end
 }
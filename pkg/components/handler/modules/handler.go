@@ -19,6 +19,7 @@ import (
 	helper_file_sys "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/file_sys"
 	helper_job "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/job"
 	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_progress "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/progress"
 	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	helper_url "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/url"
 	helper_uuid "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/uuid"
@@ -401,6 +402,7 @@ func (h *Handler) pullImages(ctx context.Context, images []string) ([]string, er
 		} else {
 			continue
 		}
+		helper_progress.SetStage(ctx, fmt.Sprintf("pulling image '%s'", image))
 		err = h.pullImage(ctx, image)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("'%s' %w", image, err))
@@ -653,8 +653,9 @@ func populateTestDir(t *testing.T, workDir string) {
 }
 
 type storageHandlerMock struct {
-	Err  error
-	Mods map[string]pkg_models.DatabaseModule
+	Err         error
+	Mods        map[string]pkg_models.DatabaseModule
+	Constraints map[string]pkg_models.ModuleVersionConstraint
 }
 
 func (m *storageHandlerMock) ReadModules(_ context.Context, filter pkg_models.ModulesFilter) (map[string]pkg_models.DatabaseModule, error) {
@@ -721,6 +722,32 @@ func (m *storageHandlerMock) DeleteModule(_ context.Context, id string) error {
 	return nil
 }
 
+func (m *storageHandlerMock) ReadModuleVersionConstraints(_ context.Context) (map[string]pkg_models.ModuleVersionConstraint, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.Constraints, nil
+}
+
+func (m *storageHandlerMock) SetModuleVersionConstraint(_ context.Context, constraint pkg_models.ModuleVersionConstraint) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	if m.Constraints == nil {
+		m.Constraints = make(map[string]pkg_models.ModuleVersionConstraint)
+	}
+	m.Constraints[constraint.ModuleId] = constraint
+	return nil
+}
+
+func (m *storageHandlerMock) DeleteModuleVersionConstraint(_ context.Context, moduleId string) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	delete(m.Constraints, moduleId)
+	return nil
+}
+
 type cewClientMock struct {
 	Images           map[string]external_models.CewImage
 	Jobs             map[string]external_models.JobLibJob
@@ -29,6 +29,9 @@ type databaseHandler interface {
 	CreateModule(ctx context.Context, mod pkg_models.DatabaseModule) error
 	UpdateModule(ctx context.Context, mod pkg_models.DatabaseModule) error
 	DeleteModule(ctx context.Context, id string) error
+	ReadModuleVersionConstraints(ctx context.Context) (map[string]pkg_models.ModuleVersionConstraint, error)
+	SetModuleVersionConstraint(ctx context.Context, constraint pkg_models.ModuleVersionConstraint) error
+	DeleteModuleVersionConstraint(ctx context.Context, moduleId string) error
 }
 
 type containerEngineWrapperClient interface {
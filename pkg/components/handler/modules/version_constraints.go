@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modules
+
+import (
+	"context"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_sem_ver "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/sem_ver"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// GetVersionConstraints returns the version constraints of installed modules mapped to module IDs.
+func (h *Handler) GetVersionConstraints(ctx context.Context) (map[string]string, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	constraints, err := h.databaseHandler.ReadModuleVersionConstraints(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "get version constraints", slog_keys.Error, err)
+		return nil, err
+	}
+	constraintsMap := make(map[string]string)
+	for id, constraint := range constraints {
+		constraintsMap[id] = constraint.Constraint
+	}
+	return constraintsMap, nil
+}
+
+// SetVersionConstraint validates and stores the version constraint of an installed module. The constraint is
+// removed with the module.
+func (h *Handler) SetVersionConstraint(ctx context.Context, id, constraint string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, err := h.databaseHandler.ReadModule(ctx, id); err != nil {
+		return err
+	}
+	if err := helper_sem_ver.ValidateConstraint(constraint); err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	err := h.databaseHandler.SetModuleVersionConstraint(ctx, pkg_models.ModuleVersionConstraint{
+		ModuleId:   id,
+		Constraint: constraint,
+		Updated:    helper_time.Now(),
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "set version constraint", slog_keys.ModuleId, id, slog_keys.Error, err)
+		return err
+	}
+	return nil
+}
+
+func (h *Handler) DeleteVersionConstraint(ctx context.Context, id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, err := h.databaseHandler.ReadModule(ctx, id); err != nil {
+		return err
+	}
+	if err := h.databaseHandler.DeleteModuleVersionConstraint(ctx, id); err != nil {
+		logger.ErrorContext(ctx, "delete version constraint", slog_keys.ModuleId, id, slog_keys.Error, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func TestHandler_SetVersionConstraint(t *testing.T) {
+	stgHdlMock := &storageHandlerMock{Mods: map[string]pkg_models.DatabaseModule{
+		"github.com/org/repo": {Id: "github.com/org/repo"},
+	}}
+	h := New(stgHdlMock, nil, Config{})
+	if err := h.SetVersionConstraint(context.Background(), "github.com/org/repo", ">=v1.4.0;<v1.4.5"); err != nil {
+		t.Fatal(err)
+	}
+	constraints, err := h.GetVersionConstraints(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if constraints["github.com/org/repo"] != ">=v1.4.0;<v1.4.5" {
+		t.Errorf("unexpected constraints %v", constraints)
+	}
+	t.Run("invalid constraint", func(t *testing.T) {
+		err = h.SetVersionConstraint(context.Background(), "github.com/org/repo", "latest")
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("not installed", func(t *testing.T) {
+		err = h.SetVersionConstraint(context.Background(), "github.com/org/other", "~v1.4")
+		if !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		if err = h.DeleteVersionConstraint(context.Background(), "github.com/org/repo"); err != nil {
+			t.Fatal(err)
+		}
+		if len(stgHdlMock.Constraints) != 0 {
+			t.Error("expected constraint to be removed")
+		}
+	})
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const acceptHeaderKey = "Accept"
+
+func newRequest(ctx context.Context, u string, header map[string]string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	return req, nil
+}
+
+func doJson(httpClient HTTPClient, req *http.Request, v any) error {
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.ReadAll(res.Body)
+		res.Body.Close()
+	}()
+	if res.StatusCode >= 400 {
+		return getResponseError(res)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func doStream(httpClient HTTPClient, req *http.Request) (io.ReadCloser, error) {
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= 400 {
+		defer res.Body.Close()
+		return nil, getResponseError(res)
+	}
+	return res.Body, nil
+}
+
+func getResponseError(res *http.Response) error {
+	b, err := io.ReadAll(res.Body)
+	if err != nil || len(b) == 0 {
+		return NewResponseError(res.StatusCode, res.Status)
+	}
+	return NewResponseError(res.StatusCode, string(b))
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGitLab_GetLastCommit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Fsub%2Frepo/repository/commits/main" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"1234","authored_date":"2026-01-01T00:00:00Z","committed_date":"2026-01-02T00:00:00Z"}`))
+	}))
+	defer server.Close()
+	c := NewGitLab(server.Client(), server.URL+"/api/v4/")
+	commit, err := c.GetLastCommit(context.Background(), "group/sub", "repo", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit.Sha != "1234" {
+		t.Errorf("expected 1234, got %s", commit.Sha)
+	}
+	if !commit.Date.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected committed date, got %s", commit.Date)
+	}
+	t.Run("not found", func(t *testing.T) {
+		_, err = c.GetLastCommit(context.Background(), "group", "repo", "main")
+		var resErr *ResponseError
+		if !errors.As(err, &resErr) || resErr.Code != http.StatusNotFound {
+			t.Errorf("expected response error with code 404, got %v", err)
+		}
+	})
+}
+
+func TestGitLab_GetRepoTarGzArchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/projects/owner%2Frepo/repository/archive.tar.gz" || r.URL.Query().Get("sha") != "1234" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("test"))
+	}))
+	defer server.Close()
+	c := NewGitLab(server.Client(), server.URL)
+	rc, err := c.GetRepoTarGzArchive(context.Background(), "owner", "repo", "1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "test" {
+		t.Errorf("expected test, got %s", string(b))
+	}
+}
+
+func TestGitea_GetLastCommit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repos/owner/repo/commits" || r.URL.Query().Get("sha") != "main" || r.URL.Query().Get("limit") != "1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"sha":"1234","commit":{"author":{"date":"2026-01-02T00:00:00Z"},"committer":{"date":"2026-01-01T00:00:00Z"}}}]`))
+	}))
+	defer server.Close()
+	c := NewGitea(server.Client(), server.URL+"/api/v1")
+	commit, err := c.GetLastCommit(context.Background(), "owner", "repo", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit.Sha != "1234" {
+		t.Errorf("expected 1234, got %s", commit.Sha)
+	}
+	if !commit.Date.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected author date, got %s", commit.Date)
+	}
+	t.Run("no commits", func(t *testing.T) {
+		server2 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		}))
+		defer server2.Close()
+		_, err = NewGitea(server2.Client(), server2.URL).GetLastCommit(context.Background(), "owner", "repo", "main")
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestGitea_GetRepoTarGzArchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/archive/1234.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("not found"))
+			return
+		}
+		_, _ = w.Write([]byte("test"))
+	}))
+	defer server.Close()
+	c := NewGitea(server.Client(), server.URL)
+	rc, err := c.GetRepoTarGzArchive(context.Background(), "owner", "repo", "1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "test" {
+		t.Errorf("expected test, got %s", string(b))
+	}
+	t.Run("error", func(t *testing.T) {
+		_, err = c.GetRepoTarGzArchive(context.Background(), "owner", "repo", "5678")
+		var resErr *ResponseError
+		if !errors.As(err, &resErr) || resErr.Message != "not found" {
+			t.Errorf("expected response error 'not found', got %v", err)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const giteaJsonMediaType = "application/json"
+
+// Gitea uses the Gitea REST API (v1), Forgejo is compatible. The base URL must point to the API root, e.g. https://gitea.example.com/api/v1.
+type Gitea struct {
+	httpClient HTTPClient
+	baseURL    string
+}
+
+func NewGitea(httpClient HTTPClient, baseUrl string) *Gitea {
+	return &Gitea{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseUrl, "/"),
+	}
+}
+
+func (c *Gitea) GetLastCommit(ctx context.Context, owner, repo, ref string) (GitCommit, error) {
+	query := url.Values{}
+	query.Set("sha", ref)
+	query.Set("limit", "1")
+	query.Set("stat", "false")
+	query.Set("verification", "false")
+	query.Set("files", "false")
+	req, err := newRequest(
+		ctx,
+		c.baseURL+"/repos/"+url.PathEscape(owner)+"/"+url.PathEscape(repo)+"/commits?"+query.Encode(),
+		map[string]string{acceptHeaderKey: giteaJsonMediaType},
+	)
+	if err != nil {
+		return GitCommit{}, err
+	}
+	var tmp []giteaCommit
+	if err = doJson(c.httpClient, req, &tmp); err != nil {
+		return GitCommit{}, err
+	}
+	if len(tmp) == 0 {
+		return GitCommit{}, errors.New("no commits found")
+	}
+	lastCommit := GitCommit{
+		Sha:  tmp[0].Sha,
+		Date: tmp[0].Commit.Author.Date,
+	}
+	if tmp[0].Commit.Committer.Date.After(tmp[0].Commit.Author.Date) {
+		lastCommit.Date = tmp[0].Commit.Committer.Date
+	}
+	return lastCommit, nil
+}
+
+func (c *Gitea) GetRepoTarGzArchive(ctx context.Context, owner, repo, ref string) (io.ReadCloser, error) {
+	req, err := newRequest(
+		ctx,
+		c.baseURL+"/repos/"+url.PathEscape(owner)+"/"+url.PathEscape(repo)+"/archive/"+url.PathEscape(ref)+".tar.gz",
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return doStream(c.httpClient, req)
+}
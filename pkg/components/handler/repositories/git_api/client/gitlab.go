@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const gitLabJsonMediaType = "application/json"
+
+// GitLab uses the GitLab REST API (v4). The base URL must point to the API root, e.g. https://gitlab.example.com/api/v4.
+type GitLab struct {
+	httpClient HTTPClient
+	baseURL    string
+}
+
+func NewGitLab(httpClient HTTPClient, baseUrl string) *GitLab {
+	return &GitLab{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseUrl, "/"),
+	}
+}
+
+func (c *GitLab) GetLastCommit(ctx context.Context, owner, repo, ref string) (GitCommit, error) {
+	req, err := newRequest(
+		ctx,
+		c.baseURL+"/projects/"+getGitLabProjectId(owner, repo)+"/repository/commits/"+url.PathEscape(ref),
+		map[string]string{acceptHeaderKey: gitLabJsonMediaType},
+	)
+	if err != nil {
+		return GitCommit{}, err
+	}
+	var tmp gitLabCommit
+	if err = doJson(c.httpClient, req, &tmp); err != nil {
+		return GitCommit{}, err
+	}
+	lastCommit := GitCommit{
+		Sha:  tmp.Id,
+		Date: tmp.AuthoredDate,
+	}
+	if tmp.CommittedDate.After(tmp.AuthoredDate) {
+		lastCommit.Date = tmp.CommittedDate
+	}
+	return lastCommit, nil
+}
+
+func (c *GitLab) GetRepoTarGzArchive(ctx context.Context, owner, repo, ref string) (io.ReadCloser, error) {
+	req, err := newRequest(
+		ctx,
+		c.baseURL+"/projects/"+getGitLabProjectId(owner, repo)+"/repository/archive.tar.gz?sha="+url.QueryEscape(ref),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return doStream(c.httpClient, req)
+}
+
+// getGitLabProjectId returns the url encoded project path, owner can be a group path like 'group/subgroup'.
+func getGitLabProjectId(owner, repo string) string {
+	return url.PathEscape(owner + "/" + repo)
+}
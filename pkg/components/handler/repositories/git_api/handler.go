@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git_api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/git_api/client"
+	handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_http "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/http"
+)
+
+const (
+	GitLab = "gitlab"
+	Gitea  = "gitea"
+)
+
+type Config struct {
+	WorkdirPath   string
+	Timeout       time.Duration
+	ArchiveLimits helper_archive.Limits
+}
+
+func NewGitLab(config Config) *handler_repositories_remote.Handler[Source] {
+	httpClient := helper_http.NewClient(config.Timeout)
+	return newHandler(GitLab, func(baseUrl string) gitClient {
+		return client.NewGitLab(httpClient, baseUrl)
+	}, config)
+}
+
+func NewGitea(config Config) *handler_repositories_remote.Handler[Source] {
+	httpClient := helper_http.NewClient(config.Timeout)
+	return newHandler(Gitea, func(baseUrl string) gitClient {
+		return client.NewGitea(httpClient, baseUrl)
+	}, config)
+}
+
+func newHandler(repoType string, newClient func(baseUrl string) gitClient, config Config) *handler_repositories_remote.Handler[Source] {
+	return handler_repositories_remote.New[Source](
+		repoType,
+		&sourceType{newClient: newClient},
+		handler_repositories_remote.Config{
+			WorkdirPath:   config.WorkdirPath,
+			ArchiveLimits: config.ArchiveLimits,
+		},
+	)
+}
+
+type sourceType struct {
+	newClient func(baseUrl string) gitClient
+}
+
+func (t *sourceType) Prepare(src Source) (Source, error) {
+	if src.BaseUrl == "" {
+		return Source{}, errors.New("missing base url")
+	}
+	if src.Owner == "" {
+		return Source{}, errors.New("missing owner")
+	}
+	if src.Repository == "" {
+		return Source{}, errors.New("missing repository")
+	}
+	if src.Reference == "" {
+		return Source{}, errors.New("missing reference")
+	}
+	return src, nil
+}
+
+// SourceString returns the host of the base url joined with owner and repository, e.g. 'gitea.example.com/owner/repo'.
+func (t *sourceType) SourceString(src Source) (string, error) {
+	u, err := url.Parse(src.BaseUrl)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", errors.New("invalid base url")
+	}
+	return path.Join(u.Host, src.Owner, src.Repository), nil
+}
+
+func (t *sourceType) FsName(srcString string, src Source) string {
+	return strings.NewReplacer("/", "_", ".", "_", ":", "_").Replace(srcString + "_" + src.Reference)
+}
+
+func (t *sourceType) Base(src Source) handler_repositories_remote.SourceBase {
+	return src.SourceBase
+}
+
+func (t *sourceType) NewFetcher(src Source) handler_repositories_remote.Fetcher {
+	return &fetcher{
+		gitClt: t.newClient(src.BaseUrl),
+		source: src,
+	}
+}
+
+func (t *sourceType) Blacklist() []string {
+	return []string{".git", ".github", ".gitlab", ".gitea"}
+}
+
+type fetcher struct {
+	gitClt gitClient
+	source Source
+}
+
+func (f *fetcher) Revision(ctx context.Context) (string, error) {
+	gitCommit, err := f.gitClt.GetLastCommit(ctx, f.source.Owner, f.source.Repository, f.source.Reference)
+	if err != nil {
+		return "", err
+	}
+	return gitCommit.Sha, nil
+}
+
+func (f *fetcher) Fetch(ctx context.Context, revision string, w io.Writer) error {
+	repoArchive, err := f.gitClt.GetRepoTarGzArchive(ctx, f.source.Owner, f.source.Repository, revision)
+	if err != nil {
+		return err
+	}
+	defer repoArchive.Close()
+	_, err = io.Copy(w, repoArchive)
+	return err
+}
@@ -6,13 +6,22 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	helper_token "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/token"
 )
 
 const (
-	acceptHeaderKey       = "Accept"
-	gitHubApiVerHeaderKey = "X-GitHub-Api-Version"
-	gitHubApiVer          = "2022-11-28"
-	gitHubJsonMediaType   = "application/vnd.github+json"
+	acceptHeaderKey             = "Accept"
+	authorizationHeaderKey      = "Authorization"
+	gitHubApiVerHeaderKey       = "X-GitHub-Api-Version"
+	rateLimitRemainingHeaderKey = "X-RateLimit-Remaining"
+	rateLimitResetHeaderKey     = "X-RateLimit-Reset"
+	retryAfterHeaderKey         = "Retry-After"
+	gitHubApiVer                = "2022-11-28"
+	gitHubJsonMediaType         = "application/vnd.github+json"
 )
 
 type HTTPClient interface {
@@ -22,27 +31,25 @@ type HTTPClient interface {
 type Client struct {
 	httpClient HTTPClient
 	baseURL    string
+	rateLimits map[string]time.Time // {tokenHash:reset}
+	mu         sync.Mutex
 }
 
 func New(httpClient HTTPClient, baseUrl string) *Client {
 	return &Client{
 		httpClient: httpClient,
 		baseURL:    baseUrl,
+		rateLimits: make(map[string]time.Time),
 	}
 }
 
-func (c *Client) GetLastCommit(ctx context.Context, owner, repo, ref string) (GitCommit, error) {
+// GetLastCommit returns the last commit of a reference. If a token is provided, it is sent as bearer token.
+func (c *Client) GetLastCommit(ctx context.Context, token, owner, repo, ref string) (GitCommit, error) {
 	u, err := url.JoinPath(c.baseURL, "repos", owner, repo, "commits", ref)
 	if err != nil {
 		return GitCommit{}, err
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return GitCommit{}, err
-	}
-	req.Header.Set(acceptHeaderKey, gitHubJsonMediaType)
-	req.Header.Set(gitHubApiVerHeaderKey, gitHubApiVer)
-	res, err := c.httpClient.Do(req)
+	res, err := c.doRequest(ctx, token, u)
 	if err != nil {
 		return GitCommit{}, err
 	}
@@ -52,13 +59,6 @@ func (c *Client) GetLastCommit(ctx context.Context, owner, repo, ref string) (Gi
 		}
 		res.Body.Close()
 	}()
-	if res.StatusCode >= 400 {
-		b, err := io.ReadAll(res.Body)
-		if err != nil || len(b) == 0 {
-			return GitCommit{}, NewResponseError(res.StatusCode, res.Status)
-		}
-		return GitCommit{}, NewResponseError(res.StatusCode, string(b))
-	}
 	var tmp commit
 	if err = json.NewDecoder(res.Body).Decode(&tmp); err != nil {
 		return GitCommit{}, err
@@ -73,28 +73,95 @@ func (c *Client) GetLastCommit(ctx context.Context, owner, repo, ref string) (Gi
 	return lastCommit, nil
 }
 
-func (c *Client) GetRepoTarGzArchive(ctx context.Context, owner, repo, ref string) (io.ReadCloser, error) {
+// GetRepoTarGzArchive returns the archive of a reference. If a token is provided, it is sent as bearer token.
+func (c *Client) GetRepoTarGzArchive(ctx context.Context, token, owner, repo, ref string) (io.ReadCloser, error) {
 	u, err := url.JoinPath(c.baseURL, "repos", owner, repo, "tarball", ref)
 	if err != nil {
 		return nil, err
 	}
+	res, err := c.doRequest(ctx, token, u)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// doRequest executes a GET request and returns a RateLimitError without sending the request if a previous
+// response indicated that the rate limit of the token is exhausted.
+func (c *Client) doRequest(ctx context.Context, token, u string) (*http.Response, error) {
+	if reset, ok := c.getRateLimitReset(token); ok {
+		return nil, NewRateLimitError(http.StatusTooManyRequests, "rate limit exceeded", reset)
+	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set(acceptHeaderKey, gitHubJsonMediaType)
 	req.Header.Set(gitHubApiVerHeaderKey, gitHubApiVer)
+	if token != "" {
+		req.Header.Set(authorizationHeaderKey, "Bearer "+token)
+	}
 	res, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	reset, limited := c.setRateLimit(token, res)
 	if res.StatusCode >= 400 {
 		defer res.Body.Close()
-		b, err := io.ReadAll(res.Body)
-		if err != nil || len(b) == 0 {
-			return nil, NewResponseError(res.StatusCode, res.Status)
+		msg := res.Status
+		if b, err := io.ReadAll(res.Body); err == nil && len(b) > 0 {
+			msg = string(b)
+		}
+		if limited && (res.StatusCode == http.StatusForbidden || res.StatusCode == http.StatusTooManyRequests) {
+			return nil, NewRateLimitError(res.StatusCode, msg, reset)
 		}
-		return nil, NewResponseError(res.StatusCode, string(b))
+		return nil, NewResponseError(res.StatusCode, msg)
 	}
-	return res.Body, nil
+	return res, nil
+}
+
+func (c *Client) getRateLimitReset(token string) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := helper_token.Hash(token)
+	reset, ok := c.rateLimits[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	if time.Now().After(reset) {
+		delete(c.rateLimits, key)
+		return time.Time{}, false
+	}
+	return reset, true
+}
+
+// setRateLimit stores the reset time of the token if the response indicates an exhausted primary or a secondary
+// rate limit.
+func (c *Client) setRateLimit(token string, res *http.Response) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := helper_token.Hash(token)
+	reset, limited := parseRateLimitHeaders(res.Header)
+	if !limited {
+		delete(c.rateLimits, key)
+		return time.Time{}, false
+	}
+	c.rateLimits[key] = reset
+	return reset, true
+}
+
+func parseRateLimitHeaders(header http.Header) (time.Time, bool) {
+	if v := header.Get(retryAfterHeaderKey); v != "" {
+		if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.Now().Add(time.Duration(sec) * time.Second), true
+		}
+	}
+	if header.Get(rateLimitRemainingHeaderKey) != "0" {
+		return time.Time{}, false
+	}
+	sec, err := strconv.ParseInt(header.Get(rateLimitResetHeaderKey), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(sec, 0), true
 }
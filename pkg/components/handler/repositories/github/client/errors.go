@@ -1,5 +1,10 @@
 package client
 
+import (
+	"fmt"
+	"time"
+)
+
 type ResponseError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -15,3 +20,23 @@ func NewResponseError(c int, m string) *ResponseError {
 		Message: m,
 	}
 }
+
+// RateLimitError indicates that the rate limit is exhausted until Reset.
+type RateLimitError struct {
+	ResponseError
+	Reset time.Time `json:"reset"`
+}
+
+func (r *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited until %s: %s", r.Reset.Format(time.RFC3339), r.Message)
+}
+
+func NewRateLimitError(c int, m string, reset time.Time) *RateLimitError {
+	return &RateLimitError{
+		ResponseError: ResponseError{
+			Code:    c,
+			Message: m,
+		},
+		Reset: reset,
+	}
+}
@@ -18,177 +18,132 @@ package github
 
 import (
 	"context"
-	"encoding/json"
-	"os"
+	"errors"
+	"fmt"
+	"io"
 	"path"
 	"strings"
-	"sync"
 	"time"
 
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
-	handler_repositories "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/github/client"
-	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
 	helper_http "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/http"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
 )
 
-const (
-	reposDir   = "repositories"
-	sourcesDir = "sources"
-)
+const gitHubCom = "github.com"
 
 type Config struct {
-	BaseUrl     string
-	WorkdirPath string
-	Timeout     time.Duration
+	BaseUrl       string
+	WorkdirPath   string
+	Timeout       time.Duration
+	ArchiveLimits helper_archive.Limits
+}
+
+func New(config Config, smClient secretManagerClient) *handler_repositories_remote.Handler[Source] {
+	return handler_repositories_remote.New[Source](
+		gitHubCom,
+		&sourceType{
+			gitHubClt: client.New(helper_http.NewClient(config.Timeout), config.BaseUrl),
+			smClient:  smClient,
+		},
+		handler_repositories_remote.Config{
+			WorkdirPath:   config.WorkdirPath,
+			ArchiveLimits: config.ArchiveLimits,
+		},
+	)
 }
 
-type Handler struct {
-	repositories map[string]*Repository
-	gitHubClient gitHubClient
-	workdirPath  string
-	mu           sync.RWMutex
+type sourceType struct {
+	gitHubClt gitHubClient
+	smClient  secretManagerClient
 }
 
-func New(config Config) *Handler {
-	return &Handler{
-		gitHubClient: client.New(
-			helper_http.NewClient(config.Timeout),
-			config.BaseUrl,
-		),
-		workdirPath: config.WorkdirPath,
+func (t *sourceType) Prepare(src Source) (Source, error) {
+	if src.Token != nil && src.Token.Id == "" {
+		return Source{}, errors.New("missing token secret id")
 	}
+	return src, nil
 }
 
-func (h *Handler) RepositoryType() string {
-	return gitHubCom
+func (t *sourceType) SourceString(src Source) (string, error) {
+	return path.Join(gitHubCom, src.Owner, src.Repository), nil
 }
 
-func (h *Handler) Init() error {
-	err := os.MkdirAll(path.Join(h.workdirPath, sourcesDir), 0775)
-	if err != nil {
-		return err
-	}
-	dirEntries, err := os.ReadDir(path.Join(h.workdirPath, sourcesDir))
-	if err != nil {
-		return err
-	}
-	var errs []error
-	h.repositories = make(map[string]*Repository)
-	for _, dirEntry := range dirEntries {
-		if dirEntry.IsDir() {
-			continue
-		}
-		source, err := readSourceFile(path.Join(h.workdirPath, sourcesDir, dirEntry.Name()))
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-		repo := newRepository(
-			h.gitHubClient,
-			source,
-			path.Join(h.workdirPath, reposDir, getFsName(source)),
-		)
-		h.repositories[getSourceString(source)] = repo
-	}
-	if len(errs) > 0 {
-		return helper_errors.Join(errs...)
-	}
-	return nil
+func (t *sourceType) FsName(_ string, src Source) string {
+	return strings.Replace(strings.Replace(src.Owner+"_"+src.Repository+"_"+src.Reference, "/", "_", -1), ".", "_", -1)
 }
 
-func (h *Handler) GetRepositories(_ context.Context) (map[string]handler_repositories.Repository, error) {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-	tmp := make(map[string]handler_repositories.Repository)
-	for src, repo := range h.repositories {
-		tmp[src] = repo
-	}
-	return tmp, nil
+func (t *sourceType) Base(src Source) handler_repositories_remote.SourceBase {
+	return src.SourceBase
 }
 
-func (h *Handler) GetRepository(_ context.Context, source string) (handler_repositories.Repository, error) {
-	h.mu.RLock()
-	defer h.mu.RUnlock()
-	repo, ok := h.repositories[source]
-	if !ok {
-		return nil, lib_errors.New[lib_errors.ErrNotFound]("source not found")
+func (t *sourceType) NewFetcher(src Source) handler_repositories_remote.Fetcher {
+	return &fetcher{
+		gitHubClt: t.gitHubClt,
+		smClient:  t.smClient,
+		source:    src,
 	}
-	return repo, nil
 }
 
-func (h *Handler) CreateRepository(_ context.Context, data []byte) error {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-	var src Source
-	err := json.Unmarshal(data, &src)
+func (t *sourceType) Blacklist() []string {
+	return []string{".git", ".github"}
+}
+
+type fetcher struct {
+	gitHubClt gitHubClient
+	smClient  secretManagerClient
+	source    Source
+}
+
+func (f *fetcher) Revision(ctx context.Context) (string, error) {
+	token, err := f.getToken(ctx)
 	if err != nil {
-		return err
-	}
-	srcString := getSourceString(src)
-	_, ok := h.repositories[srcString]
-	if ok {
-		return lib_errors.New[lib_errors.ErrExists]("source already exists")
+		return "", err
 	}
-	fsName := getFsName(src)
-	err = writeSourceFile(path.Join(h.workdirPath, sourcesDir, fsName), src)
+	gitCommit, err := f.gitHubClt.GetLastCommit(ctx, token, f.source.Owner, f.source.Repository, f.source.Reference)
 	if err != nil {
-		return err
+		return "", wrapClientErr(err)
 	}
-	h.repositories[srcString] = newRepository(h.gitHubClient, src, path.Join(h.workdirPath, reposDir, fsName))
-	return nil
+	return gitCommit.Sha, nil
 }
 
-func (h *Handler) DeleteRepository(_ context.Context, source string) error {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-	repo, ok := h.repositories[source]
-	if !ok {
-		return nil
-	}
-	fsName := getFsName(repo.source)
-	err := os.RemoveAll(path.Join(h.workdirPath, reposDir, fsName))
+func (f *fetcher) Fetch(ctx context.Context, revision string, w io.Writer) error {
+	token, err := f.getToken(ctx)
 	if err != nil {
 		return err
 	}
-	err = os.RemoveAll(path.Join(h.workdirPath, sourcesDir, fsName))
+	repoArchive, err := f.gitHubClt.GetRepoTarGzArchive(ctx, token, f.source.Owner, f.source.Repository, revision)
 	if err != nil {
-		return err
+		return wrapClientErr(err)
 	}
-	delete(h.repositories, source)
-	return nil
+	defer repoArchive.Close()
+	_, err = io.Copy(w, repoArchive)
+	return err
 }
 
-func readSourceFile(p string) (Source, error) {
-	file, err := os.Open(p)
-	if err != nil {
-		return Source{}, err
+func (f *fetcher) getToken(ctx context.Context) (string, error) {
+	if f.source.Token == nil {
+		return "", nil
 	}
-	defer file.Close()
-	jd := json.NewDecoder(file)
-	var src Source
-	err = jd.Decode(&src)
+	variant, err, _ := f.smClient.GetValueVariant(ctx, external_models.SmSecretVariantRequest{
+		ID:   f.source.Token.Id,
+		Item: f.source.Token.Item,
+	})
 	if err != nil {
-		return Source{}, err
+		return "", fmt.Errorf("get token secret '%s': %w", f.source.Token.Id, err)
 	}
-	return src, nil
+	return variant.Value, nil
 }
 
-func writeSourceFile(p string, src Source) error {
-	file, err := os.Create(p)
-	if err != nil {
-		return err
+func wrapClientErr(err error) error {
+	var rlErr *client.RateLimitError
+	if errors.As(err, &rlErr) {
+		e := lib_errors.Wrap[lib_errors.ErrRateLimited](err)
+		e.Until = rlErr.Reset
+		return e
 	}
-	defer file.Close()
-	je := json.NewEncoder(file)
-	je.SetIndent("", "\t")
-	return je.Encode(src)
-}
-
-func getSourceString(src Source) string {
-	return path.Join(gitHubCom, src.Owner, src.Repository)
-}
-
-func getFsName(src Source) string {
-	return strings.Replace(strings.Replace(src.Owner+"_"+src.Repository+"_"+src.Reference, "/", "_", -1), ".", "_", -1)
+	return err
 }
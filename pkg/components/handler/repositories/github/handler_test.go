@@ -0,0 +1,181 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/github/client"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+func TestSourceType_SourceString(t *testing.T) {
+	srcString, err := (&sourceType{}).SourceString(Source{
+		Owner:      "test_owner",
+		Repository: "test_repo",
+		Reference:  "test_ref",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if srcString != "github.com/test_owner/test_repo" {
+		t.Errorf("expect github.com/test_owner/test_repo, got %s", srcString)
+	}
+}
+
+func TestFetcher(t *testing.T) {
+	mockClient := &gitHubClientMock{
+		Commits: map[string]map[string]map[string]client.GitCommit{
+			"test_owner": {
+				"test_repo": {
+					"test_ref": {
+						Sha:  "1234",
+						Date: time.Now(),
+					},
+				},
+			},
+		},
+		Archives: map[string]map[string]map[string]string{
+			"test_owner": {
+				"test_repo": {
+					"1234": "test_archive",
+				},
+			},
+		},
+	}
+	mockSmClient := &secretManagerClientMock{
+		Values: map[string]string{"test_secret": "test_token"},
+	}
+	f := &fetcher{
+		gitHubClt: mockClient,
+		smClient:  mockSmClient,
+		source: Source{
+			Owner:      "test_owner",
+			Repository: "test_repo",
+			Reference:  "test_ref",
+			Token:      &SecretRef{Id: "test_secret"},
+		},
+	}
+	revision, err := f.Revision(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision != "1234" {
+		t.Errorf("expected 1234, got %s", revision)
+	}
+	if mockClient.Token != "test_token" {
+		t.Errorf("expected test_token, got %s", mockClient.Token)
+	}
+	mockClient.Token = ""
+	var buf bytes.Buffer
+	if err = f.Fetch(context.Background(), revision, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if mockClient.Token != "test_token" {
+		t.Errorf("expected test_token, got %s", mockClient.Token)
+	}
+	if buf.String() != "test_archive" {
+		t.Errorf("expected test_archive, got %s", buf.String())
+	}
+	t.Run("secret not found", func(t *testing.T) {
+		f.source.Token = &SecretRef{Id: "unknown"}
+		_, err = f.Revision(context.Background())
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("rate limited", func(t *testing.T) {
+		f.source.Token = nil
+		reset := time.Now().Add(time.Hour)
+		mockClient.Err = client.NewRateLimitError(http.StatusForbidden, "test", reset)
+		_, err = f.Revision(context.Background())
+		var rlErr *lib_errors.ErrRateLimited
+		if !errors.As(err, &rlErr) {
+			t.Fatalf("expected rate limited error, got %v", err)
+		}
+		if !rlErr.Until.Equal(reset) {
+			t.Errorf("expected %s, got %s", reset, rlErr.Until)
+		}
+	})
+}
+
+type gitHubClientMock struct {
+	Err      error
+	Token    string
+	Commits  map[string]map[string]map[string]client.GitCommit
+	Archives map[string]map[string]map[string]string
+}
+
+func (m *gitHubClientMock) GetLastCommit(ctx context.Context, token, owner, repo, ref string) (client.GitCommit, error) {
+	m.Token = token
+	if m.Err != nil {
+		return client.GitCommit{}, m.Err
+	}
+	repos, ok := m.Commits[owner]
+	if !ok {
+		return client.GitCommit{}, client.NewResponseError(http.StatusNotFound, "commit: owner not found")
+	}
+	refs, ok := repos[repo]
+	if !ok {
+		return client.GitCommit{}, client.NewResponseError(http.StatusNotFound, "commit: repo not found")
+	}
+	commit, ok := refs[ref]
+	if !ok {
+		return client.GitCommit{}, client.NewResponseError(http.StatusNotFound, "commit: ref not found")
+	}
+	return commit, nil
+}
+
+func (m *gitHubClientMock) GetRepoTarGzArchive(ctx context.Context, token, owner, repo, ref string) (io.ReadCloser, error) {
+	m.Token = token
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	repos, ok := m.Archives[owner]
+	if !ok {
+		return nil, client.NewResponseError(http.StatusNotFound, "archive: owner not found")
+	}
+	refs, ok := repos[repo]
+	if !ok {
+		return nil, client.NewResponseError(http.StatusNotFound, "archive: repo not found")
+	}
+	content, ok := refs[ref]
+	if !ok {
+		return nil, client.NewResponseError(http.StatusNotFound, "archive: ref not found")
+	}
+	return io.NopCloser(strings.NewReader(content)), nil
+}
+
+type secretManagerClientMock struct {
+	Values map[string]string
+}
+
+func (m *secretManagerClientMock) GetValueVariant(_ context.Context, secretRequest external_models.SmSecretVariantRequest) (external_models.SmSecretValueVariant, error, int) {
+	value, ok := m.Values[secretRequest.ID]
+	if !ok {
+		return external_models.SmSecretValueVariant{}, errors.New("not found"), http.StatusNotFound
+	}
+	return external_models.SmSecretValueVariant{Value: value}, nil, 0
+}
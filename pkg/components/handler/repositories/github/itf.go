@@ -5,9 +5,14 @@ import (
 	"io"
 
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/github/client"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
 )
 
 type gitHubClient interface {
-	GetLastCommit(ctx context.Context, owner, repo, ref string) (client.GitCommit, error)
-	GetRepoTarGzArchive(ctx context.Context, owner, repo, ref string) (io.ReadCloser, error)
+	GetLastCommit(ctx context.Context, token, owner, repo, ref string) (client.GitCommit, error)
+	GetRepoTarGzArchive(ctx context.Context, token, owner, repo, ref string) (io.ReadCloser, error)
+}
+
+type secretManagerClient interface {
+	GetValueVariant(ctx context.Context, secretRequest external_models.SmSecretVariantRequest) (variant external_models.SmSecretValueVariant, err error, errCode int)
 }
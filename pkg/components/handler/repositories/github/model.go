@@ -1,15 +1,17 @@
 package github
 
+import handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+
 type Source struct {
-	Owner      string    `json:"owner"`
-	Repository string    `json:"repository"`
-	Reference  string    `json:"reference"`
-	Priority   int       `json:"priority"`
-	Channels   []Channel `json:"channels"`
+	handler_repositories_remote.SourceBase
+	Owner      string `json:"owner"`
+	Repository string `json:"repository"`
+	Reference  string `json:"reference"`
+	// Token optionally references a secret holding a token used for authentication.
+	Token *SecretRef `json:"token"`
 }
 
-type Channel struct {
-	Name      string   `json:"name"`
-	Priority  int      `json:"priority"`
-	Blacklist []string `json:"blacklist"`
+type SecretRef struct {
+	Id   string  `json:"id"`
+	Item *string `json:"item"`
 }
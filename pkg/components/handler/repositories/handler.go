@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"slices"
 	"strings"
@@ -13,19 +14,24 @@ import (
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
 	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_signature "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/signature"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
 )
 
 type Handler struct {
 	repositoryHandlers map[string]repositoryHandler
 	lookUpMap          map[string]map[string]map[string]moduleWrapper // {moduleId:{source:{channel:variant}}}
+	refreshStates      map[string]refreshState
 	mu                 sync.RWMutex
 }
 
 func New(handlers ...repositoryHandler) *Handler {
 	h := Handler{
 		repositoryHandlers: make(map[string]repositoryHandler),
+		refreshStates:      make(map[string]refreshState),
 	}
 	for _, handler := range handlers {
 		h.repositoryHandlers[handler.RepositoryType()] = handler
@@ -34,6 +40,17 @@ func New(handlers ...repositoryHandler) *Handler {
 }
 
 func (h *Handler) Init(ctx context.Context) error {
+	repositories, errs := h.getRepositories(ctx)
+	h.updateLookupMap(ctx, repositories)
+	if len(errs) > 0 {
+		return helper_errors.Join(errs...)
+	}
+	return nil
+}
+
+// getRepositories returns the repositories of all handlers, repositories with colliding sources or priorities are
+// skipped.
+func (h *Handler) getRepositories(ctx context.Context) (map[string]Repository, []error) {
 	var errs []error
 	repositories := make(map[string]Repository)
 	priorities := make(map[int]struct{})
@@ -55,11 +72,7 @@ func (h *Handler) Init(ctx context.Context) error {
 			repositories[source] = repo
 		}
 	}
-	h.updateLookupMap(ctx, repositories)
-	if len(errs) > 0 {
-		return helper_errors.Join(errs...)
-	}
-	return nil
+	return repositories, errs
 }
 
 func (h *Handler) RefreshRepositories(
@@ -108,6 +121,9 @@ func (h *Handler) RefreshRepositories(
 				continue
 			}
 			result.Refresh, err = doRepoRefresh(ctx, repo, filter)
+			if result.Refresh {
+				h.setRefreshState(source, err)
+			}
 			if err != nil {
 				logger.ErrorContext(
 					ctx,
@@ -117,6 +133,11 @@ func (h *Handler) RefreshRepositories(
 					slog_keys.Error, err.Error(),
 				)
 				result.ErrorResult = lib_models.NewErrorResult(err.Error())
+				var rlErr *lib_errors.ErrRateLimited
+				if errors.As(err, &rlErr) {
+					until := rlErr.Until
+					result.RateLimitedUntil = &until
+				}
 			}
 			repositories[source] = repo
 			priorities[repo.Priority()] = struct{}{}
@@ -146,17 +167,49 @@ func (h *Handler) GetRepositories(ctx context.Context) ([]lib_models.Repository,
 			continue
 		}
 		for source, repo := range repositories {
+			state := h.refreshStates[source]
 			repos = append(repos, lib_models.Repository{
-				Type:     repoType,
-				Source:   source,
-				Priority: repo.Priority(),
-				Channels: repo.Channels(),
+				Type:             repoType,
+				Source:           source,
+				Priority:         repo.Priority(),
+				Channels:         repo.Channels(),
+				LastRefresh:      state.lastRefresh,
+				LastRefreshError: state.lastError,
 			})
 		}
 	}
 	return repos, nil
 }
 
+// GetRepositoryDefinitions returns the source definitions of all repositories that can be recreated via
+// CreateRepository.
+func (h *Handler) GetRepositoryDefinitions(ctx context.Context) ([]pkg_models.RepositoryDefinition, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	var definitions []pkg_models.RepositoryDefinition
+	for repoType, handler := range h.repositoryHandlers {
+		repositories, err := handler.GetRepositories(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get repositories: %s %w", repoType, err)
+		}
+		for source, repo := range repositories {
+			data, err := repo.Definition()
+			if err != nil {
+				return nil, fmt.Errorf("get repository definition: %s %w", source, err)
+			}
+			if data == nil {
+				continue
+			}
+			definitions = append(definitions, pkg_models.RepositoryDefinition{
+				Type:   repoType,
+				Source: source,
+				Data:   data,
+			})
+		}
+	}
+	return definitions, nil
+}
+
 func (h *Handler) CreateRepository(ctx context.Context, repositoryType string, data []byte) error {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -190,9 +243,117 @@ func (h *Handler) DeleteRepository(ctx context.Context, source string) error {
 			return err
 		}
 	}
+	delete(h.refreshStates, source)
 	return nil
 }
 
+// StageModulePackage stages an uploaded module package via the repository handler for packages. Staging does not
+// block other operations, the returned ID is passed to AddModulePackage or DiscardModulePackage.
+func (h *Handler) StageModulePackage(ctx context.Context, r io.Reader) (string, error) {
+	handler, err := h.getPackageRepositoryHandler()
+	if err != nil {
+		logger.ErrorContext(ctx, "stage module package", slog_keys.Error, err)
+		return "", err
+	}
+	stageId, err := handler.StagePackage(ctx, r)
+	if err != nil {
+		logger.ErrorContext(ctx, "stage module package", slog_keys.Error, err)
+		return "", err
+	}
+	return stageId, nil
+}
+
+// AddModulePackage stores a staged module package via the repository handler for packages and makes the module
+// available.
+func (h *Handler) AddModulePackage(ctx context.Context, channel, stageId string) (pkg_models.RepositoryModule, error) {
+	return h.addModulePackage(ctx, channel, func(handler packageRepositoryHandler) (pkg_models.RepositoryModuleBase, error) {
+		return handler.AddPackage(ctx, channel, stageId)
+	})
+}
+
+// DiscardModulePackage removes a staged module package.
+func (h *Handler) DiscardModulePackage(ctx context.Context, stageId string) error {
+	handler, err := h.getPackageRepositoryHandler()
+	if err != nil {
+		return err
+	}
+	return handler.DiscardPackage(ctx, stageId)
+}
+
+// AddModulePackageFS stores a module directory via the repository handler for packages and makes the module
+// available.
+func (h *Handler) AddModulePackageFS(ctx context.Context, channel string, fSys fs.FS) (pkg_models.RepositoryModule, error) {
+	return h.addModulePackage(ctx, channel, func(handler packageRepositoryHandler) (pkg_models.RepositoryModuleBase, error) {
+		return handler.AddPackageFS(ctx, channel, fSys)
+	})
+}
+
+func (h *Handler) addModulePackage(
+	ctx context.Context,
+	channel string,
+	add func(handler packageRepositoryHandler) (pkg_models.RepositoryModuleBase, error),
+) (pkg_models.RepositoryModule, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	handler, err := h.getPackageRepositoryHandler()
+	if err != nil {
+		logger.ErrorContext(ctx, "add module package", slog_keys.Channel, channel, slog_keys.Error, err)
+		return pkg_models.RepositoryModule{}, err
+	}
+	modBase, err := add(handler)
+	if err != nil {
+		logger.ErrorContext(ctx, "add module package", slog_keys.Channel, channel, slog_keys.Error, err)
+		return pkg_models.RepositoryModule{}, err
+	}
+	h.refreshLookupMap(ctx)
+	variant, err := h.getModuleVariant(modBase.Id, modBase.Source, modBase.Channel)
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"add module package",
+			slog_keys.Source, modBase.Source,
+			slog_keys.Channel, modBase.Channel,
+			slog_keys.ModuleId, modBase.Id,
+			slog_keys.Error, err,
+		)
+		return pkg_models.RepositoryModule{}, err
+	}
+	return variant.RepositoryModule, nil
+}
+
+func (h *Handler) DeleteModulePackage(ctx context.Context, channel, moduleId string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	handler, err := h.getPackageRepositoryHandler()
+	if err != nil {
+		logger.ErrorContext(ctx, "delete module package", slog_keys.Channel, channel, slog_keys.ModuleId, moduleId, slog_keys.Error, err)
+		return err
+	}
+	if err = handler.DeletePackage(ctx, channel, moduleId); err != nil {
+		logger.ErrorContext(ctx, "delete module package", slog_keys.Channel, channel, slog_keys.ModuleId, moduleId, slog_keys.Error, err)
+		return err
+	}
+	h.refreshLookupMap(ctx)
+	return nil
+}
+
+func (h *Handler) getPackageRepositoryHandler() (packageRepositoryHandler, error) {
+	for _, handler := range h.repositoryHandlers {
+		if pkgHandler, ok := handler.(packageRepositoryHandler); ok {
+			return pkgHandler, nil
+		}
+	}
+	return nil, lib_errors.New[lib_errors.ErrNotFound]("repository handler for module packages not found")
+}
+
+func (h *Handler) refreshLookupMap(ctx context.Context) {
+	repositories, errs := h.getRepositories(ctx)
+	for _, err := range errs {
+		logger.ErrorContext(ctx, "refresh module lookup map", slog_keys.Error, err)
+	}
+	h.updateLookupMap(ctx, repositories)
+}
+
 func (h *Handler) GetModules(_ context.Context, filter pkg_models.RepositoryModulesFilter) ([]pkg_models.RepositoryModule, error) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -296,6 +457,23 @@ func (h *Handler) GetModuleFS(ctx context.Context, id, source, channel string) (
 	return fSys, nil
 }
 
+// VerifyModuleFS verifies a module file system according to the verification requirements of the source.
+func (h *Handler) VerifyModuleFS(ctx context.Context, source string, fSys fs.FS) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, handler := range h.repositoryHandlers {
+		repos, err := handler.GetRepositories(ctx)
+		if err != nil {
+			return err
+		}
+		if repo, ok := repos[source]; ok {
+			_, err = getVerifiedModule(repo.Verification(), fSys)
+			return err
+		}
+	}
+	return lib_errors.New[lib_errors.ErrNotFound]("source not found")
+}
+
 func (h *Handler) updateLookupMap(ctx context.Context, repositories map[string]Repository) map[string][]lib_models.RepositoryChannelErrorResult {
 	lookupMap := make(map[string]map[string]map[string]moduleWrapper)
 	errResults := make(map[string][]lib_models.RepositoryChannelErrorResult)
@@ -319,7 +497,7 @@ func (h *Handler) updateLookupMap(ctx context.Context, repositories map[string]R
 				continue
 			}
 			for ref, fSys := range fsMap {
-				mod, err := helper_modfile.GetModule(fSys)
+				mod, err := getVerifiedModule(repo.Verification(), fSys)
 				if err != nil {
 					logger.ErrorContext(
 						ctx,
@@ -420,6 +598,45 @@ func filterSources(source, channel string, filter map[string]map[string]struct{}
 	return true
 }
 
+// getVerifiedModule checks the module signature, if trusted keys are set, and the image references, if digests are
+// required, before returning the module.
+func getVerifiedModule(verification pkg_models.RepositoryVerification, fSys fs.FS) (external_models.ModuleLibModule, error) {
+	if len(verification.TrustedKeys) > 0 {
+		keys, err := helper_signature.ParsePublicKeys(verification.TrustedKeys)
+		if err != nil {
+			return external_models.ModuleLibModule{}, fmt.Errorf("verification failed: %w", err)
+		}
+		if err = helper_signature.Verify(fSys, keys); err != nil {
+			return external_models.ModuleLibModule{}, fmt.Errorf("verification failed: %w", err)
+		}
+	}
+	mod, err := helper_modfile.GetModule(fSys)
+	if err != nil {
+		return external_models.ModuleLibModule{}, err
+	}
+	if verification.RequireImageDigests {
+		for ref, service := range mod.Services {
+			if !strings.Contains(service.Image, "@sha256:") {
+				return external_models.ModuleLibModule{}, fmt.Errorf("verification failed: image of service '%s' not pinned by digest", ref)
+			}
+		}
+	}
+	return mod, nil
+}
+
+// setRefreshState records the time of a successful refresh or the error of a failed refresh. The time of the last
+// successful refresh is retained on failure.
+func (h *Handler) setRefreshState(source string, err error) {
+	state := h.refreshStates[source]
+	if err != nil {
+		state.lastError = err.Error()
+	} else {
+		state.lastRefresh = helper_time.Now()
+		state.lastError = ""
+	}
+	h.refreshStates[source] = state
+}
+
 func doRepoRefresh(ctx context.Context, repo Repository, filter lib_models.RepositoriesRefreshFilter) (bool, error) {
 	if (len(filter.Types) > 0 || len(filter.Sources) > 0) && !(slices.Contains(filter.Types, repo.Type()) || slices.Contains(filter.Sources, repo.Source())) {
 		return false, nil
@@ -30,6 +30,7 @@ import (
 	handler_repositories "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories"
 	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
 	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 )
 
@@ -72,6 +73,15 @@ func (h *Handler) Channels() []lib_models.RepositoryChannel {
 	}
 }
 
+func (h *Handler) Verification() pkg_models.RepositoryVerification {
+	return pkg_models.RepositoryVerification{}
+}
+
+// Definition returns nil, the host directory repository is provided via configuration.
+func (h *Handler) Definition() ([]byte, error) {
+	return nil, nil
+}
+
 func (h *Handler) Refresh(_ context.Context) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
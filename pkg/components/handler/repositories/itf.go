@@ -2,9 +2,11 @@ package repositories
 
 import (
 	"context"
+	"io"
 	"io/fs"
 
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 )
 
 type Repository interface {
@@ -12,6 +14,8 @@ type Repository interface {
 	Priority() int
 	Source() string
 	Channels() []lib_models.RepositoryChannel
+	Verification() pkg_models.RepositoryVerification
+	Definition() ([]byte, error)
 	Refresh(ctx context.Context) error
 	GetFileSystemsMap(ctx context.Context, channel string) (map[string]fs.FS, error)
 	GetFileSystem(ctx context.Context, channel, fsRef string) (fs.FS, error)
@@ -24,3 +28,12 @@ type repositoryHandler interface {
 	CreateRepository(ctx context.Context, data []byte) error
 	DeleteRepository(ctx context.Context, source string) error
 }
+
+// packageRepositoryHandler is implemented by repository handlers that store uploaded module packages.
+type packageRepositoryHandler interface {
+	StagePackage(ctx context.Context, r io.Reader) (string, error)
+	AddPackage(ctx context.Context, channel, stageId string) (pkg_models.RepositoryModuleBase, error)
+	DiscardPackage(ctx context.Context, stageId string) error
+	AddPackageFS(ctx context.Context, channel string, fSys fs.FS) (pkg_models.RepositoryModuleBase, error)
+	DeletePackage(ctx context.Context, channel, moduleId string) error
+}
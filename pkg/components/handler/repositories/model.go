@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 )
 
@@ -9,3 +11,8 @@ type moduleWrapper struct {
 	RepoType string
 	FSysRef  string
 }
+
+type refreshState struct {
+	lastRefresh time.Time
+	lastError   string
+}
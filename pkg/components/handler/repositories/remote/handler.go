@@ -0,0 +1,208 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"sync"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	handler_repositories "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	helper_signature "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/signature"
+)
+
+const (
+	reposDir   = "repositories"
+	sourcesDir = "sources"
+)
+
+type Config struct {
+	WorkdirPath   string
+	ArchiveLimits helper_archive.Limits
+}
+
+// Handler manages the sources of a remote repository type. Sources are stored as files in the working directory.
+type Handler[S any] struct {
+	repoType      string
+	sourceType    SourceType[S]
+	repositories  map[string]*Repository
+	workdirPath   string
+	archiveLimits helper_archive.Limits
+	mu            sync.RWMutex
+}
+
+func New[S any](repoType string, sourceType SourceType[S], config Config) *Handler[S] {
+	return &Handler[S]{
+		repoType:      repoType,
+		sourceType:    sourceType,
+		workdirPath:   config.WorkdirPath,
+		archiveLimits: config.ArchiveLimits,
+	}
+}
+
+func (h *Handler[S]) RepositoryType() string {
+	return h.repoType
+}
+
+func (h *Handler[S]) Init() error {
+	err := os.MkdirAll(path.Join(h.workdirPath, sourcesDir), 0775)
+	if err != nil {
+		return err
+	}
+	dirEntries, err := os.ReadDir(path.Join(h.workdirPath, sourcesDir))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	h.repositories = make(map[string]*Repository)
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+		source, err := readSourceFile[S](path.Join(h.workdirPath, sourcesDir, dirEntry.Name()))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		srcString, err := h.sourceType.SourceString(source)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		h.repositories[srcString] = h.newRepository(source, srcString)
+	}
+	if len(errs) > 0 {
+		return helper_errors.Join(errs...)
+	}
+	return nil
+}
+
+func (h *Handler[S]) GetRepositories(_ context.Context) (map[string]handler_repositories.Repository, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	tmp := make(map[string]handler_repositories.Repository)
+	for src, repo := range h.repositories {
+		tmp[src] = repo
+	}
+	return tmp, nil
+}
+
+func (h *Handler[S]) GetRepository(_ context.Context, source string) (handler_repositories.Repository, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	repo, ok := h.repositories[source]
+	if !ok {
+		return nil, lib_errors.New[lib_errors.ErrNotFound]("source not found")
+	}
+	return repo, nil
+}
+
+func (h *Handler[S]) CreateRepository(_ context.Context, data []byte) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var src S
+	err := json.Unmarshal(data, &src)
+	if err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	src, err = h.sourceType.Prepare(src)
+	if err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	if _, err = helper_signature.ParsePublicKeys(h.sourceType.Base(src).Verification.TrustedKeys); err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	srcString, err := h.sourceType.SourceString(src)
+	if err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	_, ok := h.repositories[srcString]
+	if ok {
+		return lib_errors.New[lib_errors.ErrExists]("source already exists")
+	}
+	err = writeSourceFile(path.Join(h.workdirPath, sourcesDir, h.sourceType.FsName(srcString, src)), src)
+	if err != nil {
+		return err
+	}
+	h.repositories[srcString] = h.newRepository(src, srcString)
+	return nil
+}
+
+func (h *Handler[S]) DeleteRepository(_ context.Context, source string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	repo, ok := h.repositories[source]
+	if !ok {
+		return nil
+	}
+	err := os.RemoveAll(path.Join(h.workdirPath, reposDir, repo.fsName))
+	if err != nil {
+		return err
+	}
+	err = os.RemoveAll(path.Join(h.workdirPath, sourcesDir, repo.fsName))
+	if err != nil {
+		return err
+	}
+	delete(h.repositories, source)
+	return nil
+}
+
+func (h *Handler[S]) newRepository(src S, srcString string) *Repository {
+	fsName := h.sourceType.FsName(srcString, src)
+	return &Repository{
+		repoType:      h.repoType,
+		srcString:     srcString,
+		fsName:        fsName,
+		source:        src,
+		base:          h.sourceType.Base(src),
+		blacklist:     h.sourceType.Blacklist(),
+		fetcher:       h.sourceType.NewFetcher(src),
+		workdirPath:   path.Join(h.workdirPath, reposDir, fsName),
+		archiveLimits: h.archiveLimits,
+	}
+}
+
+func readSourceFile[S any](p string) (S, error) {
+	var src S
+	file, err := os.Open(p)
+	if err != nil {
+		return src, err
+	}
+	defer file.Close()
+	jd := json.NewDecoder(file)
+	err = jd.Decode(&src)
+	if err != nil {
+		return src, err
+	}
+	return src, nil
+}
+
+func writeSourceFile[S any](p string, src S) error {
+	file, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	je := json.NewEncoder(file)
+	je.SetIndent("", "\t")
+	return je.Encode(src)
+}
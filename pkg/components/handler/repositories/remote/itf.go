@@ -0,0 +1,48 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"context"
+	"io"
+)
+
+// SourceType implements the parts of a repository type that depend on its source model.
+type SourceType[S any] interface {
+	// Prepare normalizes and validates a new source.
+	Prepare(src S) (S, error)
+	// SourceString returns the unique source string, e.g. 'github.com/owner/repo'.
+	SourceString(src S) (string, error)
+	// FsName returns the name of the source file and repository directory.
+	FsName(srcString string, src S) string
+	// Base returns the attributes shared by all source models.
+	Base(src S) SourceBase
+	// NewFetcher returns a fetcher providing the archives of the source.
+	NewFetcher(src S) Fetcher
+	// Blacklist returns directory names excluded from all channels.
+	Blacklist() []string
+}
+
+// Fetcher provides the archives of a source. Archives must be gzip compressed tar or zip archives with a single root
+// directory holding the channel directories.
+type Fetcher interface {
+	// Revision returns an identifier of the current revision of the source, e.g. a commit hash. The archive is only
+	// fetched if the revision differs from the revision of the current files.
+	Revision(ctx context.Context) (string, error)
+	// Fetch writes the archive of a revision to w.
+	Fetch(ctx context.Context, revision string, w io.Writer) error
+}
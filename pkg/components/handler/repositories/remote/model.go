@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+
+// SourceBase holds the attributes shared by the source models of all remote repository types.
+type SourceBase struct {
+	Priority     int                               `json:"priority"`
+	Channels     []Channel                         `json:"channels"`
+	Verification pkg_models.RepositoryVerification `json:"verification"`
+}
+
+type Channel struct {
+	Name      string   `json:"name"`
+	Priority  int      `json:"priority"`
+	Blacklist []string `json:"blacklist"`
+}
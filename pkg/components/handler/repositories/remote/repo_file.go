@@ -1,4 +1,20 @@
-package github
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
 
 import (
 	"encoding/json"
@@ -6,7 +22,6 @@ import (
 	"os"
 	"path"
 
-	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/github/client"
 	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
 )
 
@@ -16,8 +31,17 @@ const (
 )
 
 type repoFile struct {
-	GitCommit client.GitCommit `json:"git_commit"`
-	Path      string           `json:"path"`
+	Revision string `json:"revision"`
+	Path     string `json:"path"`
+}
+
+// legacyRepoFile holds the revision fields of repo files written by previous versions.
+type legacyRepoFile struct {
+	repoFile
+	GitCommit struct {
+		Sha string `json:"sha"`
+	} `json:"git_commit"`
+	Checksum string `json:"checksum"`
 }
 
 func readRepoFile(p string) (repoFile, error) {
@@ -29,11 +53,17 @@ func readRepoFile(p string) (repoFile, error) {
 		return repoFile{}, err
 	}
 	defer file.Close()
-	var repo repoFile
+	var repo legacyRepoFile
 	if err = json.NewDecoder(file).Decode(&repo); err != nil {
 		return repoFile{}, err
 	}
-	return repo, nil
+	if repo.Revision == "" {
+		repo.Revision = repo.GitCommit.Sha
+	}
+	if repo.Revision == "" {
+		repo.Revision = repo.Checksum
+	}
+	return repo.repoFile, nil
 }
 
 func writeRepoFile(pth string, mr repoFile) error {
@@ -14,7 +14,7 @@
  * limitations under the License.
  */
 
-package github
+package remote
 
 import (
 	"encoding/json"
@@ -22,16 +22,12 @@ import (
 	"path"
 	"reflect"
 	"testing"
-
-	"github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/github/client"
 )
 
 func Test_readRepoFile(t *testing.T) {
 	a := repoFile{
-		GitCommit: client.GitCommit{
-			Sha: "test_sha",
-		},
-		Path: "test_source",
+		Revision: "test_sha",
+		Path:     "test_source",
 	}
 	tempDir := t.TempDir()
 	err := createTestFile(a, path.Join(tempDir, repoFileName))
@@ -45,6 +41,19 @@ func Test_readRepoFile(t *testing.T) {
 	if !reflect.DeepEqual(b, a) {
 		t.Errorf("excpected %v, got %v", a, b)
 	}
+	t.Run("legacy", func(t *testing.T) {
+		b, err = readRepoFile("test/repo_1")
+		if err != nil {
+			t.Error(err)
+		}
+		a2 := repoFile{
+			Revision: "test_sha",
+			Path:     "sha_ref/mods",
+		}
+		if !reflect.DeepEqual(b, a2) {
+			t.Errorf("excpected %v, got %v", a2, b)
+		}
+	})
 	t.Run("does not exist", func(t *testing.T) {
 		b, err = readRepoFile("test")
 		if err != nil {
@@ -58,10 +67,8 @@ func Test_readRepoFile(t *testing.T) {
 
 func Test_writeRepoFile(t *testing.T) {
 	a := repoFile{
-		GitCommit: client.GitCommit{
-			Sha: "test_sha",
-		},
-		Path: "test_source",
+		Revision: "test_sha",
+		Path:     "test_source",
 	}
 	tempDir := t.TempDir()
 	err := writeRepoFile(tempDir, a)
@@ -77,7 +84,7 @@ func Test_writeRepoFile(t *testing.T) {
 	}
 	t.Run("file exists", func(t *testing.T) {
 		aNew := a
-		aNew.GitCommit.Sha = "test_sha2"
+		aNew.Revision = "test_sha2"
 		err = writeRepoFile(tempDir, aNew)
 		if err != nil {
 			t.Error(err)
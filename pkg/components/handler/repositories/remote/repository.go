@@ -0,0 +1,203 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"sync"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+const archiveFileSuffix = ".archive"
+
+type Repository struct {
+	repoType      string
+	srcString     string
+	fsName        string
+	source        any
+	base          SourceBase
+	blacklist     []string
+	fetcher       Fetcher
+	workdirPath   string
+	archiveLimits helper_archive.Limits
+	mu            sync.RWMutex
+}
+
+func (r *Repository) Type() string {
+	return r.repoType
+}
+
+func (r *Repository) Priority() int {
+	return r.base.Priority
+}
+
+func (r *Repository) Source() string {
+	return r.srcString
+}
+
+func (r *Repository) Channels() []lib_models.RepositoryChannel {
+	var channels []lib_models.RepositoryChannel
+	for _, channel := range r.base.Channels {
+		channels = append(channels, lib_models.RepositoryChannel{Name: channel.Name, Priority: channel.Priority})
+	}
+	return channels
+}
+
+func (r *Repository) Verification() pkg_models.RepositoryVerification {
+	return r.base.Verification
+}
+
+func (r *Repository) Definition() ([]byte, error) {
+	return json.Marshal(r.source)
+}
+
+func (r *Repository) GetFileSystemsMap(_ context.Context, channelName string) (map[string]fs.FS, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	channel, err := r.getChannel(channelName)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := readRepoFile(r.workdirPath)
+	if err != nil {
+		return nil, err
+	}
+	dirEntries, err := os.ReadDir(path.Join(r.workdirPath, repo.Path, channel.Name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	fsMap := make(map[string]fs.FS)
+	for _, entry := range dirEntries {
+		if entry.IsDir() && !slices.Contains(r.blacklist, entry.Name()) && !slices.Contains(channel.Blacklist, entry.Name()) {
+			fsMap[entry.Name()] = os.DirFS(path.Join(r.workdirPath, repo.Path, channel.Name, entry.Name()))
+		}
+	}
+	return fsMap, nil
+}
+
+func (r *Repository) GetFileSystem(_ context.Context, channelName, fsRef string) (fs.FS, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	channel, err := r.getChannel(channelName)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := readRepoFile(r.workdirPath)
+	if err != nil {
+		return nil, err
+	}
+	dirEntries, err := os.ReadDir(path.Join(r.workdirPath, repo.Path, channel.Name))
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range dirEntries {
+		if entry.IsDir() && entry.Name() == fsRef {
+			return os.DirFS(path.Join(r.workdirPath, repo.Path, channel.Name, entry.Name())), nil
+		}
+	}
+	return nil, errors.New("reference not found")
+}
+
+// Refresh fetches and extracts the archive if the revision of the source differs from the revision of the current
+// files. The archive is stored in the working directory until extracted.
+func (r *Repository) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err := os.MkdirAll(r.workdirPath, 0775)
+	if err != nil {
+		return err
+	}
+	oldRepo, err := readRepoFile(r.workdirPath)
+	if err != nil {
+		return err
+	}
+	revision, err := r.fetcher.Revision(ctx)
+	if err != nil {
+		return err
+	}
+	if revision == "" || revision == "." || revision == ".." || path.Base(revision) != revision {
+		return fmt.Errorf("invalid revision '%s'", revision)
+	}
+	if revision == oldRepo.Revision {
+		return nil
+	}
+	newRepo := repoFile{Revision: revision}
+	archivePath := path.Join(r.workdirPath, revision+archiveFileSuffix)
+	defer func() {
+		if e := os.Remove(archivePath); e != nil && !os.IsNotExist(e) {
+			logger.ErrorContext(ctx, "remove repository archive", slog_keys.Source, r.srcString, slog_keys.Error, e)
+		}
+	}()
+	if err = r.fetchArchive(ctx, revision, archivePath); err != nil {
+		return err
+	}
+	rootDir, err := helper_archive.ExtractFile(archivePath, path.Join(r.workdirPath, revision), r.archiveLimits)
+	if err != nil {
+		err = fmt.Errorf("extract archive: %w", err)
+		if e := os.RemoveAll(path.Join(r.workdirPath, revision)); e != nil {
+			return helper_errors.Join(err, e)
+		}
+		return err
+	}
+	newRepo.Path = path.Join(revision, rootDir)
+	if err = writeRepoFile(r.workdirPath, newRepo); err != nil {
+		if e := os.RemoveAll(path.Join(r.workdirPath, revision)); e != nil {
+			return helper_errors.Join(err, e)
+		}
+		return err
+	}
+	if oldRepo.Revision != "" {
+		if err = os.RemoveAll(path.Join(r.workdirPath, oldRepo.Revision)); err != nil {
+			logger.ErrorContext(ctx, "remove old repository files", slog_keys.Source, r.srcString, slog_keys.Error, err)
+		}
+	}
+	return nil
+}
+
+func (r *Repository) fetchArchive(ctx context.Context, revision, name string) error {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0664)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return r.fetcher.Fetch(ctx, revision, file)
+}
+
+func (r *Repository) getChannel(name string) (Channel, error) {
+	i := slices.IndexFunc(r.base.Channels, func(channel Channel) bool {
+		return channel.Name == name
+	})
+	if i < 0 {
+		return Channel{}, errors.New("channel not found")
+	}
+	return r.base.Channels[i], nil
+}
@@ -0,0 +1,330 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+)
+
+func TestRepository_Channels(t *testing.T) {
+	r := &Repository{
+		base: SourceBase{
+			Channels: []Channel{
+				{
+					Name:     "test_channel",
+					Priority: 1,
+				},
+			},
+		},
+	}
+	a := []lib_models.RepositoryChannel{{Name: "test_channel", Priority: 1}}
+	b := r.Channels()
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expect %v, got %v", a, b)
+	}
+}
+
+func TestRepository_FileSystemsMap(t *testing.T) {
+	r := &Repository{
+		base: SourceBase{
+			Channels: []Channel{
+				{
+					Name:      "test_channel",
+					Blacklist: []string{"test_dir"},
+				},
+			},
+		},
+		workdirPath: "./test/repo_1",
+	}
+	a := map[string]fs.FS{
+		"test_mod_1": os.DirFS("test/repo_1/sha_ref/mods/test_channel/test_mod_1"),
+		"test_mod_2": os.DirFS("test/repo_1/sha_ref/mods/test_channel/test_mod_2"),
+	}
+	b, err := r.GetFileSystemsMap(context.Background(), "test_channel")
+	if err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected %v, got %v", a, b)
+	}
+	t.Run("common blacklist", func(t *testing.T) {
+		r2 := &Repository{
+			base:        SourceBase{Channels: []Channel{{Name: "test_channel"}}},
+			blacklist:   []string{"test_dir"},
+			workdirPath: "./test/repo_1",
+		}
+		b, err = r2.GetFileSystemsMap(context.Background(), "test_channel")
+		if err != nil {
+			t.Error(err)
+		}
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("expected %v, got %v", a, b)
+		}
+	})
+	t.Run("no repo file", func(t *testing.T) {
+		r2 := &Repository{
+			base:        SourceBase{Channels: []Channel{{Name: "test_channel"}}},
+			workdirPath: "./test/repo_2",
+		}
+		fsMap, err := r2.GetFileSystemsMap(context.Background(), "test_channel")
+		if err != nil {
+			t.Error(err)
+		}
+		if len(fsMap) > 0 {
+			t.Error("expect empty map")
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		_, err = r.GetFileSystemsMap(context.Background(), "test")
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestRepository_FileSystem(t *testing.T) {
+	r := &Repository{
+		base: SourceBase{
+			Channels: []Channel{
+				{
+					Name:      "test_channel",
+					Blacklist: []string{"test_dir"},
+				},
+			},
+		},
+		workdirPath: "./test/repo_1",
+	}
+	a := os.DirFS("test/repo_1/sha_ref/mods/test_channel/test_mod_1")
+	b, err := r.GetFileSystem(context.Background(), "test_channel", "test_mod_1")
+	if err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected %v, got %v", a, b)
+	}
+	t.Run("error", func(t *testing.T) {
+		t.Run("fs ref does not exist", func(t *testing.T) {
+			_, err = r.GetFileSystem(context.Background(), "test_channel", "test")
+			if err == nil {
+				t.Error("expected error")
+			}
+		})
+		t.Run("channel does not exist", func(t *testing.T) {
+			_, err = r.GetFileSystem(context.Background(), "test", "test_mod_1")
+			if err == nil {
+				t.Error("expected error")
+			}
+		})
+	})
+}
+
+func TestRepository_Refresh(t *testing.T) {
+	mockFetcher := &fetcherMock{
+		Rev:      "1234",
+		Archives: map[string]string{"1234": "./test/test.tar.gz"},
+	}
+	tempDir := t.TempDir()
+	r := &Repository{
+		base:        SourceBase{Channels: []Channel{{Name: "test_channel"}}},
+		fetcher:     mockFetcher,
+		workdirPath: path.Join(tempDir, "repo"),
+	}
+	err := r.Refresh(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+	rf, err := readRepoFile(path.Join(tempDir, "repo"))
+	if err != nil {
+		t.Error(err)
+	}
+	if rf.Revision != "1234" {
+		t.Errorf("expect 1234, got %s", rf.Revision)
+	}
+	if rf.Path != "1234/test" {
+		t.Errorf("expect 1234/test, got %s", rf.Path)
+	}
+	_, err = os.Stat(path.Join(tempDir, "repo/1234/test/test_channel/test_mod/Modfile.yml"))
+	if err != nil {
+		t.Error(err)
+	}
+	if _, err = os.Stat(path.Join(tempDir, "repo", "1234"+archiveFileSuffix)); !os.IsNotExist(err) {
+		t.Error("expected archive file to be removed")
+	}
+	t.Run("unchanged revision", func(t *testing.T) {
+		mockFetcher.Calls = 0
+		err = r.Refresh(context.Background())
+		if err != nil {
+			t.Error(err)
+		}
+		if mockFetcher.Calls != 0 {
+			t.Error("expected no fetch")
+		}
+	})
+	t.Run("refresh existing", func(t *testing.T) {
+		mockFetcher.Rev = "5678"
+		mockFetcher.Archives = map[string]string{"5678": "./test/test.tar.gz"}
+		err = r.Refresh(context.Background())
+		if err != nil {
+			t.Error(err)
+		}
+		rf, err = readRepoFile(path.Join(tempDir, "repo"))
+		if err != nil {
+			t.Error(err)
+		}
+		if rf.Revision != "5678" {
+			t.Errorf("expect 5678, got %s", rf.Revision)
+		}
+		if rf.Path != "5678/test" {
+			t.Errorf("expect 5678/test, got %s", rf.Path)
+		}
+		_, err = os.Stat(path.Join(tempDir, "repo/5678/test/test_channel/test_mod/Modfile.yml"))
+		if err != nil {
+			t.Error(err)
+		}
+		_, err = os.Stat(path.Join(tempDir, "repo/1234"))
+		if !os.IsNotExist(err) {
+			t.Error("expected old files to be removed")
+		}
+	})
+	t.Run("fetch error", func(t *testing.T) {
+		mockFetcher.Rev = "9012"
+		err = r.Refresh(context.Background())
+		if err == nil {
+			t.Error("expected error")
+		}
+		_, err = os.Stat(path.Join(tempDir, "repo/9012"))
+		if !os.IsNotExist(err) {
+			t.Error("expected extracted files to be removed")
+		}
+		rf, err = readRepoFile(path.Join(tempDir, "repo"))
+		if err != nil {
+			t.Error(err)
+		}
+		if rf.Revision != "5678" {
+			t.Errorf("expect 5678, got %s", rf.Revision)
+		}
+	})
+	t.Run("invalid revision", func(t *testing.T) {
+		for _, rev := range []string{"", ".", "..", "../test"} {
+			mockFetcher.Rev = rev
+			if err = r.Refresh(context.Background()); err == nil {
+				t.Errorf("expected error for '%s'", rev)
+			}
+		}
+	})
+}
+
+func TestRepository_Refresh_Zip(t *testing.T) {
+	tempDir := t.TempDir()
+	archivePath := path.Join(tempDir, "test.zip")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zipWriter := zip.NewWriter(file)
+	for _, name := range []string{"test/", "test/test_channel/", "test/test_channel/test_mod/"} {
+		if _, err = zipWriter.Create(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w, err := zipWriter.Create("test/test_channel/test_mod/Modfile.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write([]byte("test")); err != nil {
+		t.Fatal(err)
+	}
+	if err = zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	mockFetcher := &fetcherMock{
+		Rev:      "1234",
+		Archives: map[string]string{"1234": archivePath},
+	}
+	r := &Repository{
+		base:          SourceBase{Channels: []Channel{{Name: "test_channel"}}},
+		fetcher:       mockFetcher,
+		workdirPath:   path.Join(tempDir, "repo"),
+		archiveLimits: helper_archive.Limits{MaxFiles: 1},
+	}
+	if err = r.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	fsMap, err := r.GetFileSystemsMap(context.Background(), "test_channel")
+	if err != nil {
+		t.Error(err)
+	}
+	if _, ok := fsMap["test_mod"]; !ok {
+		t.Error("expected test_mod")
+	}
+	t.Run("limit exceeded", func(t *testing.T) {
+		tempDir2 := t.TempDir()
+		r2 := &Repository{
+			base:          r.base,
+			fetcher:       mockFetcher,
+			workdirPath:   path.Join(tempDir2, "repo"),
+			archiveLimits: helper_archive.Limits{MaxFileSize: 3},
+		}
+		err = r2.Refresh(context.Background())
+		if !errors.Is(err, helper_archive.ErrLimitExceeded) {
+			t.Errorf("expected %v, got %v", helper_archive.ErrLimitExceeded, err)
+		}
+		_, err = os.Stat(path.Join(tempDir2, "repo", "1234"))
+		if !os.IsNotExist(err) {
+			t.Error("expected extracted files to be removed")
+		}
+	})
+}
+
+type fetcherMock struct {
+	Rev      string
+	Archives map[string]string
+	Calls    int
+}
+
+func (m *fetcherMock) Revision(_ context.Context) (string, error) {
+	return m.Rev, nil
+}
+
+func (m *fetcherMock) Fetch(_ context.Context, revision string, w io.Writer) error {
+	m.Calls++
+	filePath, ok := m.Archives[revision]
+	if !ok {
+		return errors.New("not found")
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tarball
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+type httpDownloader struct {
+	httpClient HTTPClient
+}
+
+func (d *httpDownloader) Download(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= 400 {
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		if err != nil || len(b) == 0 {
+			return nil, NewResponseError(res.StatusCode, res.Status)
+		}
+		return nil, NewResponseError(res.StatusCode, string(b))
+	}
+	return res.Body, nil
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tarball
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_http "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/http"
+)
+
+const repoType = "tarball"
+
+type Config struct {
+	WorkdirPath   string
+	Timeout       time.Duration
+	ArchiveLimits helper_archive.Limits
+}
+
+func New(config Config) *handler_repositories_remote.Handler[Source] {
+	return handler_repositories_remote.New[Source](
+		repoType,
+		&sourceType{downloader: &httpDownloader{httpClient: helper_http.NewClient(config.Timeout)}},
+		handler_repositories_remote.Config{
+			WorkdirPath:   config.WorkdirPath,
+			ArchiveLimits: config.ArchiveLimits,
+		},
+	)
+}
+
+type sourceType struct {
+	downloader downloader
+}
+
+func (t *sourceType) Prepare(src Source) (Source, error) {
+	src.Checksum = strings.ToLower(src.Checksum)
+	if err := validateSource(src); err != nil {
+		return Source{}, err
+	}
+	return src, nil
+}
+
+// SourceString returns the url without scheme and query, e.g. 'example.com/modules.tar.gz'.
+func (t *sourceType) SourceString(src Source) (string, error) {
+	u, err := url.Parse(src.Url)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", errors.New("invalid url")
+	}
+	return path.Join(u.Host, u.Path), nil
+}
+
+func (t *sourceType) FsName(srcString string, _ Source) string {
+	return strings.NewReplacer("/", "_", ".", "_", ":", "_").Replace(srcString)
+}
+
+func (t *sourceType) Base(src Source) handler_repositories_remote.SourceBase {
+	return src.SourceBase
+}
+
+func (t *sourceType) NewFetcher(src Source) handler_repositories_remote.Fetcher {
+	return &fetcher{
+		downloader: t.downloader,
+		source:     src,
+	}
+}
+
+func (t *sourceType) Blacklist() []string {
+	return nil
+}
+
+// fetcher uses the configured checksum as revision, the archive is only downloaded if the checksum changes.
+type fetcher struct {
+	downloader downloader
+	source     Source
+}
+
+func (f *fetcher) Revision(_ context.Context) (string, error) {
+	return f.source.Checksum, nil
+}
+
+// Fetch downloads the archive and returns an error if the checksum of the archive does not match the revision.
+func (f *fetcher) Fetch(ctx context.Context, revision string, w io.Writer) error {
+	repoArchive, err := f.downloader.Download(ctx, f.source.Url)
+	if err != nil {
+		return err
+	}
+	defer repoArchive.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(w, hash), repoArchive); err != nil {
+		return err
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != revision {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", revision, checksum)
+	}
+	return nil
+}
+
+func validateSource(src Source) error {
+	u, err := url.Parse(src.Url)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("unsupported url scheme '%s'", u.Scheme)
+	}
+	b, err := hex.DecodeString(src.Checksum)
+	if err != nil {
+		return fmt.Errorf("invalid checksum: %w", err)
+	}
+	if len(b) != sha256.Size {
+		return errors.New("invalid checksum: expected sha256")
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tarball
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"testing"
+
+	handler_repositories "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories"
+	handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+)
+
+const testArchiveChecksum = "1e749a01c0e3d6781257e6f5584066675643f63577d9ac6dd1aa4f3097d6d56c"
+
+func TestHandler_Refresh(t *testing.T) {
+	mockDownloader := &downloaderMock{
+		Archives: map[string]string{
+			"https://example.com/test.tar.gz": "./test/test.tar.gz",
+		},
+	}
+	tempDir := t.TempDir()
+	h := handler_repositories_remote.New[Source](
+		repoType,
+		&sourceType{downloader: mockDownloader},
+		handler_repositories_remote.Config{WorkdirPath: tempDir},
+	)
+	if err := h.Init(); err != nil {
+		t.Fatal(err)
+	}
+	src := Source{
+		SourceBase: handler_repositories_remote.SourceBase{
+			Channels: []handler_repositories_remote.Channel{{Name: "test_channel"}},
+		},
+		Url:      "https://example.com/test.tar.gz",
+		Checksum: testArchiveChecksum,
+	}
+	repo := createTestRepository(t, h, src)
+	if repo.Source() != "example.com/test.tar.gz" {
+		t.Errorf("expected example.com/test.tar.gz, got %s", repo.Source())
+	}
+	err := repo.Refresh(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsMap, err := repo.GetFileSystemsMap(context.Background(), "test_channel")
+	if err != nil {
+		t.Error(err)
+	}
+	if _, ok := fsMap["test_mod"]; !ok {
+		t.Error("expected test_mod")
+	}
+	_, err = os.Stat(path.Join(tempDir, "repositories", "example_com_test_tar_gz", testArchiveChecksum, "test"))
+	if err != nil {
+		t.Error(err)
+	}
+	t.Run("unchanged checksum", func(t *testing.T) {
+		mockDownloader.Calls = 0
+		err = repo.Refresh(context.Background())
+		if err != nil {
+			t.Error(err)
+		}
+		if mockDownloader.Calls != 0 {
+			t.Error("expected no download")
+		}
+	})
+	t.Run("checksum mismatch", func(t *testing.T) {
+		src2 := src
+		src2.Url = "https://example.com/test2.tar.gz"
+		src2.Checksum = "0000000000000000000000000000000000000000000000000000000000000000"
+		mockDownloader.Archives[src2.Url] = "./test/test.tar.gz"
+		repo2 := createTestRepository(t, h, src2)
+		err = repo2.Refresh(context.Background())
+		if err == nil {
+			t.Error("expected error")
+		}
+		_, err = os.Stat(path.Join(tempDir, "repositories", "example_com_test2_tar_gz", src2.Checksum))
+		if !os.IsNotExist(err) {
+			t.Error("expected extracted files to be removed")
+		}
+		fsMap, err = repo2.GetFileSystemsMap(context.Background(), "test_channel")
+		if err != nil {
+			t.Error(err)
+		}
+		if len(fsMap) > 0 {
+			t.Error("expected empty map")
+		}
+	})
+}
+
+func createTestRepository(t *testing.T, h *handler_repositories_remote.Handler[Source], src Source) handler_repositories.Repository {
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = h.CreateRepository(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+	srcString, err := (&sourceType{}).SourceString(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := h.GetRepository(context.Background(), srcString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+func Test_validateSource(t *testing.T) {
+	err := validateSource(Source{Url: "https://example.com/test.tar.gz", Checksum: testArchiveChecksum})
+	if err != nil {
+		t.Error(err)
+	}
+	t.Run("invalid scheme", func(t *testing.T) {
+		err = validateSource(Source{Url: "file:///test.tar.gz", Checksum: testArchiveChecksum})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("invalid checksum", func(t *testing.T) {
+		err = validateSource(Source{Url: "https://example.com/test.tar.gz", Checksum: "1234"})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+type downloaderMock struct {
+	Archives map[string]string
+	Calls    int
+}
+
+func (m *downloaderMock) Download(_ context.Context, url string) (io.ReadCloser, error) {
+	m.Calls++
+	filePath, ok := m.Archives[url]
+	if !ok {
+		return nil, NewResponseError(http.StatusNotFound, "not found")
+	}
+	return os.Open(filePath)
+}
@@ -0,0 +1,27 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tarball
+
+import handler_repositories_remote "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories/remote"
+
+// Source defines a gzip compressed tar or a zip archive available via http(s). The archive must contain a single root
+// directory holding the channel directories, like archives created from git repositories.
+type Source struct {
+	handler_repositories_remote.SourceBase
+	Url      string `json:"url"`
+	Checksum string `json:"checksum"` // hex encoded SHA-256 checksum of the archive
+}
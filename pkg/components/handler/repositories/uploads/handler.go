@@ -0,0 +1,456 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package uploads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	module_lib_validation "github.com/SENERGY-Platform/mgw-module-lib/validation"
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	handler_repositories "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/repositories"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	helper_file_sys "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/file_sys"
+	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_stage "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/stage"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+const repoType = "uploads"
+const sourceName = "uploads"
+const defaultChannel = "default"
+
+// Uploaded packages are staged in a directory below the working directory, the leading dot prevents stage
+// directories from being treated as channels.
+const (
+	stagePrefix = ".stage-"
+	archiveFile = "package"
+	extractDir  = "extracted"
+	previousDir = "previous"
+)
+
+var channelRegExp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+var modfileNames = []string{"Modfile.yml", "Modfile.yaml"}
+
+type Config struct {
+	WorkdirPath   string
+	Priority      int
+	MaxUploadSize int64 // zero disables the limit
+	ArchiveLimits helper_archive.Limits
+}
+
+// Handler provides a single repository holding uploaded module packages. Packages are stored per channel in
+// directories named after the module ID.
+type Handler struct {
+	stageArea helper_stage.Area
+	config    Config
+	mu        sync.RWMutex
+}
+
+func New(config Config) *Handler {
+	return &Handler{
+		stageArea: helper_stage.New(config.WorkdirPath, stagePrefix, "package"),
+		config:    config,
+	}
+}
+
+func (h *Handler) Type() string {
+	return repoType
+}
+
+func (h *Handler) Priority() int {
+	return h.config.Priority
+}
+
+func (h *Handler) Source() string {
+	return sourceName
+}
+
+func (h *Handler) Channels() []lib_models.RepositoryChannel {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	channels, err := h.getChannels()
+	if err != nil {
+		logger.Error("get channels", slog_keys.Error, err)
+	}
+	var repoChannels []lib_models.RepositoryChannel
+	for _, channel := range channels {
+		repoChannels = append(repoChannels, lib_models.RepositoryChannel{Name: channel})
+	}
+	return repoChannels
+}
+
+func (h *Handler) Verification() pkg_models.RepositoryVerification {
+	return pkg_models.RepositoryVerification{}
+}
+
+// Definition returns nil, the uploads repository is provided via configuration.
+func (h *Handler) Definition() ([]byte, error) {
+	return nil, nil
+}
+
+// Refresh validates all stored packages.
+func (h *Handler) Refresh(_ context.Context) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	channels, err := h.getChannels()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, channel := range channels {
+		dirEntries, err := os.ReadDir(path.Join(h.config.WorkdirPath, channel))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, dirEntry := range dirEntries {
+			if !dirEntry.IsDir() {
+				continue
+			}
+			if _, err = validateModule(os.DirFS(path.Join(h.config.WorkdirPath, channel, dirEntry.Name()))); err != nil {
+				errs = append(errs, fmt.Errorf("'%s/%s' %w", channel, dirEntry.Name(), err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return helper_errors.Join(errs...)
+	}
+	return nil
+}
+
+func (h *Handler) GetFileSystemsMap(ctx context.Context, channel string) (map[string]fs.FS, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	dirEntries, err := os.ReadDir(path.Join(h.config.WorkdirPath, channel))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("channel '%s' not defined", channel)
+		}
+		return nil, err
+	}
+	fsMap := make(map[string]fs.FS)
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+		dirFs := os.DirFS(path.Join(h.config.WorkdirPath, channel, dirEntry.Name()))
+		if _, err = validateModule(dirFs); err != nil {
+			logger.ErrorContext(
+				ctx,
+				"get file systems map",
+				slog_keys.Channel, channel,
+				slog_keys.DirName, dirEntry.Name(),
+				slog_keys.Error, err,
+			)
+			continue
+		}
+		fsMap[dirEntry.Name()] = dirFs
+	}
+	return fsMap, nil
+}
+
+func (h *Handler) GetFileSystem(_ context.Context, channel, fsRef string) (fs.FS, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	dirEntries, err := os.ReadDir(path.Join(h.config.WorkdirPath, channel))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("channel '%s' not defined", channel)
+		}
+		return nil, err
+	}
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() && dirEntry.Name() == fsRef {
+			dirFs := os.DirFS(path.Join(h.config.WorkdirPath, channel, dirEntry.Name()))
+			if _, err = validateModule(dirFs); err != nil {
+				return nil, fmt.Errorf("'%s' %w", dirEntry.Name(), err)
+			}
+			return dirFs, nil
+		}
+	}
+	return nil, errors.New("reference not found")
+}
+
+// Init creates the working directory with the default channel and removes packages staged before a restart.
+func (h *Handler) Init() error {
+	if err := os.MkdirAll(path.Join(h.config.WorkdirPath, defaultChannel), 0775); err != nil {
+		return err
+	}
+	return h.stageArea.Clean()
+}
+
+func (h *Handler) RepositoryType() string {
+	return repoType
+}
+
+func (h *Handler) GetRepositories(_ context.Context) (map[string]handler_repositories.Repository, error) {
+	return map[string]handler_repositories.Repository{sourceName: h}, nil
+}
+
+func (h *Handler) GetRepository(_ context.Context, source string) (handler_repositories.Repository, error) {
+	if source != sourceName {
+		return nil, fmt.Errorf("source '%s' not defined", source)
+	}
+	return h, nil
+}
+
+func (h *Handler) CreateRepository(_ context.Context, _ []byte) error {
+	return errors.New("not supported")
+}
+
+func (h *Handler) DeleteRepository(_ context.Context, source string) error {
+	if source == sourceName {
+		return errors.New("not supported")
+	}
+	return nil
+}
+
+// StagePackage extracts a module package (tar.gz or zip) and returns an ID for AddPackage. The Modfile must be
+// located at the archive root or in the single directory at the archive root. Staging does not block other
+// operations of the handler.
+func (h *Handler) StagePackage(ctx context.Context, r io.Reader) (string, error) {
+	id, stagePath, err := h.stageArea.Create()
+	if err != nil {
+		logger.ErrorContext(ctx, "stage package, create directory", slog_keys.Error, err)
+		return "", err
+	}
+	if err = h.stagePackage(stagePath, r); err != nil {
+		if e := h.stageArea.Remove(id); e != nil {
+			logger.ErrorContext(ctx, "stage package, remove directory", slog_keys.Error, e)
+		}
+		return "", err
+	}
+	return id, nil
+}
+
+// AddPackage stores a staged module package in the channel, the default channel is used if no channel is provided.
+// An existing package of the same module is replaced. The staged package is removed afterward.
+func (h *Handler) AddPackage(ctx context.Context, channel, stageId string) (pkg_models.RepositoryModuleBase, error) {
+	stagePath, err := h.stageArea.Path(stageId)
+	if err != nil {
+		return pkg_models.RepositoryModuleBase{}, err
+	}
+	defer func() {
+		if e := h.stageArea.Remove(stageId); e != nil {
+			logger.ErrorContext(ctx, "add package, remove stage directory", slog_keys.Error, e)
+		}
+	}()
+	if channel == "" {
+		channel = defaultChannel
+	}
+	if !channelRegExp.MatchString(channel) {
+		return pkg_models.RepositoryModuleBase{}, lib_errors.New[lib_errors.ErrInvalidInput](fmt.Sprintf("invalid channel name '%s'", channel))
+	}
+	modPath, err := getModulePath(path.Join(stagePath, extractDir))
+	if err != nil {
+		return pkg_models.RepositoryModuleBase{}, lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.storePackage(channel, stagePath, modPath)
+}
+
+// DiscardPackage removes a staged module package.
+func (h *Handler) DiscardPackage(ctx context.Context, stageId string) error {
+	if err := h.stageArea.Remove(stageId); err != nil {
+		logger.ErrorContext(ctx, "discard package", slog_keys.Error, err)
+		return err
+	}
+	return nil
+}
+
+// AddPackageFS copies a module directory and stores the module in the channel, the default channel is used if no
+// channel is provided. The Modfile must be located at the root of the file system. An existing package of the same
+// module is replaced.
+func (h *Handler) AddPackageFS(ctx context.Context, channel string, fSys fs.FS) (pkg_models.RepositoryModuleBase, error) {
+	if channel == "" {
+		channel = defaultChannel
+	}
+	if !channelRegExp.MatchString(channel) {
+		return pkg_models.RepositoryModuleBase{}, lib_errors.New[lib_errors.ErrInvalidInput](fmt.Sprintf("invalid channel name '%s'", channel))
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	stageId, stagePath, err := h.stageArea.Create()
+	if err != nil {
+		return pkg_models.RepositoryModuleBase{}, err
+	}
+	defer func() {
+		if e := h.stageArea.Remove(stageId); e != nil {
+			logger.ErrorContext(ctx, "add package, remove stage directory", slog_keys.Error, e)
+		}
+	}()
+	if err = helper_file_sys.CopyAll(fSys, path.Join(stagePath, extractDir)); err != nil {
+		return pkg_models.RepositoryModuleBase{}, err
+	}
+	if !hasModfile(path.Join(stagePath, extractDir)) {
+		return pkg_models.RepositoryModuleBase{}, lib_errors.New[lib_errors.ErrInvalidInput]("modfile not found at root")
+	}
+	return h.storePackage(channel, stagePath, path.Join(stagePath, extractDir))
+}
+
+// DeletePackage removes the package of a module from the channel. Channels other than the default channel are
+// removed if empty.
+func (h *Handler) DeletePackage(_ context.Context, channel, moduleId string) error {
+	if channel == "" {
+		channel = defaultChannel
+	}
+	if !channelRegExp.MatchString(channel) {
+		return lib_errors.New[lib_errors.ErrInvalidInput](fmt.Sprintf("invalid channel name '%s'", channel))
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	pkgPath := path.Join(h.config.WorkdirPath, channel, getFsName(moduleId))
+	mod, err := helper_modfile.GetModule(os.DirFS(pkgPath))
+	if err != nil || mod.ID != moduleId {
+		return lib_errors.New[lib_errors.ErrNotFound]("package not found")
+	}
+	if err = os.RemoveAll(pkgPath); err != nil {
+		return err
+	}
+	if channel != defaultChannel {
+		dirEntries, err := os.ReadDir(path.Join(h.config.WorkdirPath, channel))
+		if err == nil && len(dirEntries) == 0 {
+			return os.Remove(path.Join(h.config.WorkdirPath, channel))
+		}
+	}
+	return nil
+}
+
+func (h *Handler) stagePackage(stagePath string, r io.Reader) error {
+	if err := helper_stage.WriteFile(path.Join(stagePath, archiveFile), r, h.config.MaxUploadSize); err != nil {
+		return err
+	}
+	_, err := helper_archive.ExtractFile(path.Join(stagePath, archiveFile), path.Join(stagePath, extractDir), h.config.ArchiveLimits)
+	if err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](fmt.Errorf("extract package: %w", err))
+	}
+	if _, err = getModulePath(path.Join(stagePath, extractDir)); err != nil {
+		return lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	return nil
+}
+
+// storePackage validates the staged module and moves it to the channel.
+func (h *Handler) storePackage(channel, stagePath, modPath string) (pkg_models.RepositoryModuleBase, error) {
+	mod, err := validateModule(os.DirFS(modPath))
+	if err != nil {
+		return pkg_models.RepositoryModuleBase{}, lib_errors.Wrap[lib_errors.ErrInvalidInput](fmt.Errorf("invalid module: %w", err))
+	}
+	if err = os.MkdirAll(path.Join(h.config.WorkdirPath, channel), 0775); err != nil {
+		return pkg_models.RepositoryModuleBase{}, err
+	}
+	pkgPath := path.Join(h.config.WorkdirPath, channel, getFsName(mod.ID))
+	if err = h.replacePackage(pkgPath, modPath, path.Join(stagePath, previousDir), mod.ID); err != nil {
+		return pkg_models.RepositoryModuleBase{}, err
+	}
+	return pkg_models.RepositoryModuleBase{
+		Id:      mod.ID,
+		Source:  sourceName,
+		Channel: channel,
+	}, nil
+}
+
+// replacePackage moves the module to the package path. An existing package is moved aside first and restored if the
+// new package can't be moved in place.
+func (h *Handler) replacePackage(pkgPath, modPath, previousPath, moduleId string) error {
+	_, err := os.Stat(pkgPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		return os.Rename(modPath, pkgPath)
+	}
+	if existing, err := helper_modfile.GetModule(os.DirFS(pkgPath)); err == nil && existing.ID != moduleId {
+		return lib_errors.New[lib_errors.ErrExists](fmt.Sprintf("package directory used by module '%s'", existing.ID))
+	}
+	if err = os.Rename(pkgPath, previousPath); err != nil {
+		return err
+	}
+	if err = os.Rename(modPath, pkgPath); err != nil {
+		if e := os.Rename(previousPath, pkgPath); e != nil {
+			return helper_errors.Join(err, e)
+		}
+		return err
+	}
+	return nil
+}
+
+func (h *Handler) getChannels() ([]string, error) {
+	dirEntries, err := os.ReadDir(h.config.WorkdirPath)
+	if err != nil {
+		return nil, err
+	}
+	var channels []string
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() && channelRegExp.MatchString(dirEntry.Name()) {
+			channels = append(channels, dirEntry.Name())
+		}
+	}
+	return channels, nil
+}
+
+// getModulePath returns the directory containing the Modfile, either the archive root or the single directory at
+// the archive root.
+func getModulePath(extractPath string) (string, error) {
+	modPath, ok, err := helper_stage.FindRoot(extractPath, hasModfile)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errors.New("modfile not found at archive root")
+	}
+	return modPath, nil
+}
+
+func hasModfile(dirPath string) bool {
+	for _, name := range modfileNames {
+		if info, err := os.Lstat(path.Join(dirPath, name)); err == nil && info.Mode().IsRegular() {
+			return true
+		}
+	}
+	return false
+}
+
+func validateModule(dirFs fs.FS) (external_models.ModuleLibModule, error) {
+	mod, err := helper_modfile.GetModule(dirFs)
+	if err != nil {
+		return external_models.ModuleLibModule{}, err
+	}
+	if err = module_lib_validation.Validate(mod); err != nil {
+		return external_models.ModuleLibModule{}, err
+	}
+	return mod, nil
+}
+
+func getFsName(moduleId string) string {
+	return strings.NewReplacer("/", "_", ".", "_", ":", "_").Replace(moduleId)
+}
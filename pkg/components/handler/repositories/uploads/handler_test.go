@@ -0,0 +1,193 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package uploads
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"testing"
+	"testing/fstest"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	helper_archive "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/archive"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+const testModfile = `modfileVersion: "v1"
+id: github.com/org/repo
+name: Test Module
+description: Module for tests.
+license: Apache-2.0 license
+author: Tester
+version: v1.0.0
+type: add-on
+deploymentType: single
+services:
+  test-srv:
+    name: Test Service
+    image: ghcr.io/org/repo:test
+`
+
+func newTestPackage(t *testing.T, files map[string]string) *bytes.Buffer {
+	var buf bytes.Buffer
+	w := helper_archive.NewTarGzWriter(&buf)
+	for name, data := range files {
+		if err := w.AddFile(name, 0664, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func newTestHandler(t *testing.T) *Handler {
+	h := New(Config{WorkdirPath: t.TempDir()})
+	if err := h.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func addTestPackage(t *testing.T, h *Handler, channel string, files map[string]string) (pkg_models.RepositoryModuleBase, error) {
+	stageId, err := h.StagePackage(context.Background(), newTestPackage(t, files))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h.AddPackage(context.Background(), channel, stageId)
+}
+
+func TestHandler_AddPackage(t *testing.T) {
+	h := newTestHandler(t)
+	modBase, err := addTestPackage(t, h, "test", map[string]string{"repo/Modfile.yml": testModfile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modBase.Id != "github.com/org/repo" || modBase.Source != sourceName || modBase.Channel != "test" {
+		t.Errorf("unexpected result %+v", modBase)
+	}
+	fsMap, err := h.GetFileSystemsMap(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fsMap[getFsName("github.com/org/repo")]; !ok {
+		t.Error("expected package in channel")
+	}
+	if len(h.Channels()) != 2 {
+		t.Errorf("expected 2 channels, got %d", len(h.Channels()))
+	}
+	t.Run("replace", func(t *testing.T) {
+		_, err = addTestPackage(t, h, "test", map[string]string{"Modfile.yml": testModfile})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		if err = h.DeletePackage(context.Background(), "test", "github.com/org/repo"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = os.Stat(path.Join(h.config.WorkdirPath, "test")); !os.IsNotExist(err) {
+			t.Error("expected empty channel to be removed")
+		}
+	})
+}
+
+func TestHandler_AddPackageFS(t *testing.T) {
+	h := newTestHandler(t)
+	modBase, err := h.AddPackageFS(context.Background(), "", fstest.MapFS{
+		"Modfile.yml":   {Data: []byte(testModfile)},
+		"dir/test.conf": {Data: []byte("test")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modBase.Id != "github.com/org/repo" || modBase.Channel != defaultChannel {
+		t.Errorf("unexpected result %+v", modBase)
+	}
+	if _, err = os.Stat(path.Join(h.config.WorkdirPath, defaultChannel, getFsName(modBase.Id), "dir/test.conf")); err != nil {
+		t.Error(err)
+	}
+	t.Run("missing modfile", func(t *testing.T) {
+		_, err = h.AddPackageFS(context.Background(), "", fstest.MapFS{"dir/Modfile.yml": {Data: []byte(testModfile)}})
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+}
+
+func TestHandler_AddPackage_Error(t *testing.T) {
+	h := newTestHandler(t)
+	t.Run("invalid channel", func(t *testing.T) {
+		_, err := addTestPackage(t, h, ".stage-test", map[string]string{"Modfile.yml": testModfile})
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("stage not found", func(t *testing.T) {
+		_, err := h.AddPackage(context.Background(), "", "../test")
+		if !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+	t.Run("missing modfile", func(t *testing.T) {
+		_, err := h.StagePackage(context.Background(), newTestPackage(t, map[string]string{"repo/sub/Modfile.yml": testModfile}))
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("invalid archive", func(t *testing.T) {
+		_, err := h.StagePackage(context.Background(), bytes.NewBufferString("test"))
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("exceeds upload size", func(t *testing.T) {
+		h.config.MaxUploadSize = 1
+		defer func() { h.config.MaxUploadSize = 0 }()
+		_, err := h.StagePackage(context.Background(), newTestPackage(t, map[string]string{"Modfile.yml": testModfile}))
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got %v", err)
+		}
+	})
+	t.Run("discard", func(t *testing.T) {
+		stageId, err := h.StagePackage(context.Background(), newTestPackage(t, map[string]string{"Modfile.yml": testModfile}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = h.DiscardPackage(context.Background(), stageId); err != nil {
+			t.Error(err)
+		}
+	})
+	dirEntries, err := os.ReadDir(h.config.WorkdirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirEntries) != 1 {
+		t.Error("expected stage directories to be removed")
+	}
+}
+
+func TestHandler_DeletePackage(t *testing.T) {
+	h := newTestHandler(t)
+	err := h.DeletePackage(context.Background(), "", "github.com/org/repo")
+	if !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
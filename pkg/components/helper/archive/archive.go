@@ -7,54 +7,76 @@ import (
 	"io/fs"
 	"os"
 	"path"
-	"strings"
 )
 
-func ExtractTarGz(rc io.Reader, targetPath string) (string, error) {
-	gzipReader, err := gzip.NewReader(rc)
-	if err != nil {
-		return "", err
-	}
-	defer gzipReader.Close()
-	tarReader := tar.NewReader(gzipReader)
-	var rootDir string
-	for {
-		tarHeader, err := tarReader.Next()
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			return "", err
-		}
-		if tarHeader.Typeflag == tar.TypeDir {
-			if rootDir == "" {
-				parts := strings.Split(tarHeader.Name, string(os.PathSeparator))
-				if len(parts) > 0 {
-					rootDir = parts[0]
-				}
-			}
-			if err = os.MkdirAll(path.Join(targetPath, tarHeader.Name), fs.FileMode(tarHeader.Mode)); err != nil {
-				return "", err
-			}
-		}
-		if tarHeader.Typeflag == tar.TypeReg {
-			if err = writeFile(path.Join(targetPath, tarHeader.Name), tarHeader.Mode, tarReader); err != nil {
-				return "", err
-			}
-		}
+type TarGzWriter struct {
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+}
+
+func NewTarGzWriter(w io.Writer) *TarGzWriter {
+	gzipWriter := gzip.NewWriter(w)
+	return &TarGzWriter{
+		gzipWriter: gzipWriter,
+		tarWriter:  tar.NewWriter(gzipWriter),
 	}
-	return rootDir, nil
 }
 
-func writeFile(name string, mode int64, reader *tar.Reader) error {
-	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.FileMode(mode))
+func (w *TarGzWriter) AddFile(name string, mode int64, data []byte) error {
+	err := w.tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     mode,
+		Size:     int64(len(data)),
+	})
 	if err != nil {
 		return err
 	}
-	defer file.Close()
-	_, err = io.Copy(file, reader)
-	if err != nil {
+	_, err = w.tarWriter.Write(data)
+	return err
+}
+
+// AddDir adds the directory located at srcPath and its contents as name. Only directories and regular files are
+// added, other file types are skipped.
+func (w *TarGzWriter) AddDir(name, srcPath string) error {
+	return fs.WalkDir(os.DirFS(srcPath), ".", func(p string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+		tarHeader, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		tarHeader.Name = path.Join(name, p)
+		if info.IsDir() {
+			tarHeader.Name += "/"
+		}
+		if err = w.tarWriter.WriteHeader(tarHeader); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		file, err := os.Open(path.Join(srcPath, p))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(w.tarWriter, file)
+		return err
+	})
+}
+
+func (w *TarGzWriter) Close() error {
+	if err := w.tarWriter.Close(); err != nil {
 		return err
 	}
-	return nil
+	return w.gzipWriter.Close()
 }
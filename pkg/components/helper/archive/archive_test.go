@@ -17,6 +17,7 @@
 package archive
 
 import (
+	"bytes"
 	"os"
 	"path"
 	"testing"
@@ -29,7 +30,7 @@ func TestExtractTarGz(t *testing.T) {
 	}
 	defer file.Close()
 	tempDir := t.TempDir()
-	rootDir, err := ExtractTarGz(file, tempDir)
+	rootDir, err := ExtractTarGz(file, tempDir, Limits{})
 	if err != nil {
 		t.Error()
 	}
@@ -46,9 +47,48 @@ func TestExtractTarGz(t *testing.T) {
 			t.Fatal(err)
 		}
 		defer invalidFile.Close()
-		_, err = ExtractTarGz(invalidFile, tempDir)
+		_, err = ExtractTarGz(invalidFile, tempDir, Limits{})
 		if err == nil {
 			t.Error("expected error")
 		}
 	})
 }
+
+func TestTarGzWriter(t *testing.T) {
+	srcDir := t.TempDir()
+	if err := os.MkdirAll(path.Join(srcDir, "a/b"), 0775); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(srcDir, "a/b/test.txt"), []byte("test"), 0664); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := NewTarGzWriter(&buf)
+	if err := w.AddDir("root", srcDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddFile("root/file.json", 0664, []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tempDir := t.TempDir()
+	rootDir, err := ExtractTarGz(&buf, tempDir, Limits{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootDir != "root" {
+		t.Errorf("expected %s got %s", "root", rootDir)
+	}
+	for name, expected := range map[string]string{"root/a/b/test.txt": "test", "root/file.json": "{}"} {
+		b, err := os.ReadFile(path.Join(tempDir, name))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(b) != expected {
+			t.Errorf("expected %s got %s", expected, string(b))
+		}
+	}
+}
@@ -0,0 +1,452 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Limits restricts the extraction of untrusted archives. Zero values disable the respective limit.
+type Limits struct {
+	MaxTotalSize int64 // size of all extracted files in bytes
+	MaxFiles     int   // number of extracted entries, directories are not counted
+	MaxFileSize  int64 // size of a single extracted file in bytes
+}
+
+var (
+	ErrIllegalPath     = errors.New("illegal path")
+	ErrLinkEscape      = errors.New("link target outside of root directory")
+	ErrLimitExceeded   = errors.New("limit exceeded")
+	ErrUnsupportedType = errors.New("unsupported entry type")
+	ErrUnknownFormat   = errors.New("unknown archive format")
+)
+
+// EntryError reports the archive entry that caused an extraction to fail.
+type EntryError struct {
+	Name string
+	Err  error
+}
+
+func (e *EntryError) Error() string {
+	return fmt.Sprintf("entry '%s': %s", e.Name, e.Err)
+}
+
+func (e *EntryError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+const maxLinkTargetSize = 4096
+
+// ExtractFile detects the format of the archive file and extracts it via ExtractTarGz or ExtractZip.
+func ExtractFile(name, targetPath string, limits Limits) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	magic := make([]byte, len(zipMagic))
+	n, err := io.ReadFull(file, magic)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	switch {
+	case bytes.HasPrefix(magic[:n], gzipMagic):
+		return ExtractTarGz(file, targetPath, limits)
+	case bytes.HasPrefix(magic[:n], zipMagic):
+		info, err := file.Stat()
+		if err != nil {
+			return "", err
+		}
+		return ExtractZip(file, info.Size(), targetPath, limits)
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// ExtractTarGz extracts a gzip compressed tar archive to the target path and returns the name of the first
+// directory at the archive root. Entries with absolute paths or parent directory references, links pointing outside
+// the target path and entries other than directories, regular files and links are rejected. Permissions are
+// sanitized, see sanitizeMode.
+func ExtractTarGz(r io.Reader, targetPath string, limits Limits) (string, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return "", err
+	}
+	defer gzipReader.Close()
+	e, err := newExtractor(targetPath, limits)
+	if err != nil {
+		return "", err
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		tarHeader, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", err
+		}
+		if err = e.addTarEntry(tarHeader, tarReader); err != nil {
+			return "", &EntryError{Name: tarHeader.Name, Err: err}
+		}
+	}
+	if err = e.validateLinks(); err != nil {
+		return "", err
+	}
+	return e.rootDir, nil
+}
+
+// ExtractZip extracts a zip archive to the target path with the same restrictions as ExtractTarGz.
+func ExtractZip(r io.ReaderAt, size int64, targetPath string, limits Limits) (string, error) {
+	zipReader, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", err
+	}
+	e, err := newExtractor(targetPath, limits)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range zipReader.File {
+		if err = e.addZipEntry(file); err != nil {
+			return "", &EntryError{Name: file.Name, Err: err}
+		}
+	}
+	if err = e.validateLinks(); err != nil {
+		return "", err
+	}
+	return e.rootDir, nil
+}
+
+type link struct {
+	name string
+	path string
+}
+
+type extractor struct {
+	rootPath  string
+	limits    Limits
+	rootDir   string
+	totalSize int64
+	files     int
+	links     []link
+}
+
+func newExtractor(targetPath string, limits Limits) (*extractor, error) {
+	if err := os.MkdirAll(targetPath, 0775); err != nil {
+		return nil, err
+	}
+	rootPath, err := filepath.Abs(targetPath)
+	if err != nil {
+		return nil, err
+	}
+	rootPath, err = filepath.EvalSymlinks(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	return &extractor{rootPath: rootPath, limits: limits}, nil
+}
+
+func (e *extractor) addTarEntry(tarHeader *tar.Header, tarReader *tar.Reader) error {
+	switch tarHeader.Typeflag {
+	case tar.TypeDir:
+		return e.addDir(tarHeader.Name, fs.FileMode(tarHeader.Mode))
+	case tar.TypeReg:
+		return e.addFile(tarHeader.Name, fs.FileMode(tarHeader.Mode), tarHeader.Size, tarReader)
+	case tar.TypeSymlink:
+		return e.addSymlink(tarHeader.Name, tarHeader.Linkname)
+	case tar.TypeLink:
+		return e.addHardlink(tarHeader.Name, tarHeader.Linkname)
+	case tar.TypeXGlobalHeader:
+		return nil
+	default:
+		return fmt.Errorf("%w '%c'", ErrUnsupportedType, tarHeader.Typeflag)
+	}
+}
+
+func (e *extractor) addZipEntry(file *zip.File) error {
+	mode := file.Mode()
+	switch {
+	case mode.IsDir():
+		return e.addDir(file.Name, mode)
+	case mode.IsRegular():
+		rc, err := file.Open()
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		return e.addFile(file.Name, mode, int64(file.UncompressedSize64), rc)
+	case mode&fs.ModeSymlink != 0:
+		rc, err := file.Open()
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		b, err := io.ReadAll(io.LimitReader(rc, maxLinkTargetSize+1))
+		if err != nil {
+			return err
+		}
+		if len(b) > maxLinkTargetSize {
+			return fmt.Errorf("%w: link target exceeds %d bytes", ErrIllegalPath, maxLinkTargetSize)
+		}
+		return e.addSymlink(file.Name, string(b))
+	default:
+		return fmt.Errorf("%w '%s'", ErrUnsupportedType, mode.Type())
+	}
+}
+
+func (e *extractor) addDir(name string, mode fs.FileMode) error {
+	name, err := cleanName(name)
+	if err != nil {
+		return err
+	}
+	if name == "." {
+		return nil
+	}
+	if e.rootDir == "" {
+		e.rootDir, _, _ = strings.Cut(name, "/")
+	}
+	dirPath, err := e.resolve(filepath.Join(e.rootPath, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(dirPath, sanitizeMode(mode, true))
+}
+
+func (e *extractor) addFile(name string, mode fs.FileMode, size int64, r io.Reader) error {
+	filePath, err := e.prepareEntry(name)
+	if err != nil {
+		return err
+	}
+	if err = e.checkSize(size); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, sanitizeMode(mode, false))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if maxSize, ok := e.maxSize(); ok {
+		// declared sizes of zip entries can't be trusted, reading one additional byte reveals a violation
+		r = io.LimitReader(r, maxSize+1)
+	}
+	n, err := io.Copy(file, r)
+	if err != nil {
+		return err
+	}
+	if err = e.checkSize(n); err != nil {
+		return err
+	}
+	e.totalSize += n
+	return nil
+}
+
+// addSymlink creates a symbolic link if the target lies within the root directory. Since the target may not exist
+// yet, only the lexical target is checked here, the resolved targets are checked via validateLinks.
+func (e *extractor) addSymlink(name, target string) error {
+	linkPath, err := e.prepareEntry(name)
+	if err != nil {
+		return err
+	}
+	if target == "" || path.IsAbs(target) || !filepath.IsLocal(filepath.FromSlash(path.Join(path.Dir(name), target))) {
+		return fmt.Errorf("%w: '%s'", ErrLinkEscape, target)
+	}
+	if err = os.Symlink(target, linkPath); err != nil {
+		return err
+	}
+	e.links = append(e.links, link{name: name, path: linkPath})
+	return nil
+}
+
+// addHardlink creates a hard link to a regular file that has already been extracted. The target is relative to the
+// archive root.
+func (e *extractor) addHardlink(name, target string) error {
+	linkPath, err := e.prepareEntry(name)
+	if err != nil {
+		return err
+	}
+	cleanTarget, err := cleanName(target)
+	if err != nil {
+		return fmt.Errorf("%w: '%s'", ErrLinkEscape, target)
+	}
+	targetPath, err := filepath.EvalSymlinks(filepath.Join(e.rootPath, filepath.FromSlash(cleanTarget)))
+	if err != nil {
+		return err
+	}
+	if !e.isInside(targetPath) {
+		return fmt.Errorf("%w: '%s'", ErrLinkEscape, target)
+	}
+	info, err := os.Lstat(targetPath)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%w: link target '%s' is not a regular file", ErrUnsupportedType, target)
+	}
+	return os.Link(targetPath, linkPath)
+}
+
+// prepareEntry checks the limit for the number of entries, creates missing parent directories and removes an
+// existing entry of the same name. The returned path has resolved parent directories.
+func (e *extractor) prepareEntry(name string) (string, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", err
+	}
+	if name == "." {
+		return "", fmt.Errorf("%w: root directory", ErrIllegalPath)
+	}
+	e.files++
+	if e.limits.MaxFiles > 0 && e.files > e.limits.MaxFiles {
+		return "", fmt.Errorf("%w: more than %d files", ErrLimitExceeded, e.limits.MaxFiles)
+	}
+	entryPath := filepath.Join(e.rootPath, filepath.FromSlash(name))
+	dirPath, err := e.resolve(filepath.Dir(entryPath))
+	if err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(dirPath, 0775); err != nil {
+		return "", err
+	}
+	entryPath = filepath.Join(dirPath, filepath.Base(entryPath))
+	info, err := os.Lstat(entryPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return entryPath, nil
+		}
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%w: directory exists", ErrIllegalPath)
+	}
+	if err = os.Remove(entryPath); err != nil {
+		return "", err
+	}
+	return entryPath, nil
+}
+
+// resolve evaluates links of the existing part of p and fails if the result lies outside the root directory.
+func (e *extractor) resolve(p string) (string, error) {
+	existing := p
+	var missing []string
+	for {
+		_, err := os.Lstat(existing)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		missing = append(missing, filepath.Base(existing))
+		existing = filepath.Dir(existing)
+	}
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", err
+	}
+	slices.Reverse(missing)
+	resolved = filepath.Join(append([]string{resolved}, missing...)...)
+	if !e.isInside(resolved) {
+		return "", fmt.Errorf("%w: path resolves to '%s'", ErrLinkEscape, resolved)
+	}
+	return resolved, nil
+}
+
+// validateLinks checks the resolved targets of all created symbolic links, which can be affected by entries
+// extracted after the link.
+func (e *extractor) validateLinks() error {
+	for _, l := range e.links {
+		resolved, err := filepath.EvalSymlinks(l.path)
+		if err != nil {
+			return &EntryError{Name: l.name, Err: fmt.Errorf("%w: %s", ErrLinkEscape, err)}
+		}
+		if !e.isInside(resolved) {
+			return &EntryError{Name: l.name, Err: fmt.Errorf("%w: link resolves to '%s'", ErrLinkEscape, resolved)}
+		}
+	}
+	return nil
+}
+
+func (e *extractor) isInside(p string) bool {
+	rel, err := filepath.Rel(e.rootPath, p)
+	if err != nil {
+		return false
+	}
+	return filepath.IsLocal(rel)
+}
+
+// maxSize returns the number of bytes the next file may have.
+func (e *extractor) maxSize() (int64, bool) {
+	maxSize := e.limits.MaxFileSize
+	if e.limits.MaxTotalSize > 0 && (maxSize <= 0 || e.limits.MaxTotalSize-e.totalSize < maxSize) {
+		maxSize = e.limits.MaxTotalSize - e.totalSize
+	}
+	return maxSize, maxSize > 0 || e.limits.MaxTotalSize > 0
+}
+
+func (e *extractor) checkSize(size int64) error {
+	if e.limits.MaxFileSize > 0 && size > e.limits.MaxFileSize {
+		return fmt.Errorf("%w: file size exceeds %d bytes", ErrLimitExceeded, e.limits.MaxFileSize)
+	}
+	if e.limits.MaxTotalSize > 0 && e.totalSize+size > e.limits.MaxTotalSize {
+		return fmt.Errorf("%w: total size exceeds %d bytes", ErrLimitExceeded, e.limits.MaxTotalSize)
+	}
+	return nil
+}
+
+// cleanName rejects absolute names and names containing parent directory references and returns the cleaned name.
+func cleanName(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("%w: empty name", ErrIllegalPath)
+	}
+	if path.IsAbs(name) || strings.HasPrefix(name, `\`) || filepath.VolumeName(name) != "" {
+		return "", fmt.Errorf("%w: absolute path", ErrIllegalPath)
+	}
+	if slices.Contains(strings.Split(strings.ReplaceAll(name, `\`, "/"), "/"), "..") {
+		return "", fmt.Errorf("%w: parent directory reference", ErrIllegalPath)
+	}
+	return path.Clean(name), nil
+}
+
+// sanitizeMode keeps the permission bits only, so setuid, setgid and sticky bits are dropped. Write access for
+// others is removed and the owner is always granted access.
+func sanitizeMode(mode fs.FileMode, dir bool) fs.FileMode {
+	perm := mode.Perm() &^ 0o002
+	if dir {
+		return perm | 0o700
+	}
+	return perm | 0o600
+}
@@ -0,0 +1,271 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path"
+	"testing"
+)
+
+type testEntry struct {
+	name     string
+	typeflag byte
+	mode     int64
+	linkname string
+	data     string
+}
+
+func newTestTarGz(t *testing.T, entries []testEntry) *bytes.Buffer {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		mode := entry.mode
+		if mode == 0 {
+			mode = 0664
+		}
+		err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: entry.typeflag,
+			Name:     entry.name,
+			Linkname: entry.linkname,
+			Mode:     mode,
+			Size:     int64(len(entry.data)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tarWriter.Write([]byte(entry.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestExtractTarGz_Restrictions(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []testEntry
+		limits   Limits
+		expected error
+	}{
+		{
+			name:     "parent directory reference",
+			entries:  []testEntry{{name: "root/../../evil", typeflag: tar.TypeReg, data: "test"}},
+			expected: ErrIllegalPath,
+		},
+		{
+			name:     "absolute path",
+			entries:  []testEntry{{name: "/tmp/evil", typeflag: tar.TypeReg, data: "test"}},
+			expected: ErrIllegalPath,
+		},
+		{
+			name:     "symlink escape",
+			entries:  []testEntry{{name: "root/link", typeflag: tar.TypeSymlink, linkname: "../../evil"}},
+			expected: ErrLinkEscape,
+		},
+		{
+			name:     "absolute symlink",
+			entries:  []testEntry{{name: "root/link", typeflag: tar.TypeSymlink, linkname: "/etc"}},
+			expected: ErrLinkEscape,
+		},
+		{
+			name: "symlink chain escape",
+			entries: []testEntry{
+				{name: "a/link", typeflag: tar.TypeSymlink, linkname: ".."},
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "a/link/../.."},
+			},
+			expected: ErrLinkEscape,
+		},
+		{
+			name: "write through symlink",
+			entries: []testEntry{
+				{name: "a/link", typeflag: tar.TypeSymlink, linkname: ".."},
+				{name: "link", typeflag: tar.TypeSymlink, linkname: "a/link/../.."},
+				{name: "link/evil", typeflag: tar.TypeReg, data: "test"},
+			},
+			expected: ErrLinkEscape,
+		},
+		{
+			name:     "hardlink escape",
+			entries:  []testEntry{{name: "root/link", typeflag: tar.TypeLink, linkname: "../evil"}},
+			expected: ErrLinkEscape,
+		},
+		{
+			name:     "unsupported type",
+			entries:  []testEntry{{name: "root/fifo", typeflag: tar.TypeFifo}},
+			expected: ErrUnsupportedType,
+		},
+		{
+			name: "max files",
+			entries: []testEntry{
+				{name: "root/a", typeflag: tar.TypeReg, data: "test"},
+				{name: "root/b", typeflag: tar.TypeReg, data: "test"},
+			},
+			limits:   Limits{MaxFiles: 1},
+			expected: ErrLimitExceeded,
+		},
+		{
+			name:     "max file size",
+			entries:  []testEntry{{name: "root/a", typeflag: tar.TypeReg, data: "test"}},
+			limits:   Limits{MaxFileSize: 3},
+			expected: ErrLimitExceeded,
+		},
+		{
+			name: "max total size",
+			entries: []testEntry{
+				{name: "root/a", typeflag: tar.TypeReg, data: "test"},
+				{name: "root/b", typeflag: tar.TypeReg, data: "test"},
+			},
+			limits:   Limits{MaxTotalSize: 6, MaxFileSize: 4},
+			expected: ErrLimitExceeded,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			targetPath := path.Join(tempDir, "target")
+			_, err := ExtractTarGz(newTestTarGz(t, tc.entries), targetPath, tc.limits)
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			var entryErr *EntryError
+			if !errors.As(err, &entryErr) {
+				t.Errorf("expected entry error, got %T", err)
+			}
+			if _, err = os.Lstat(path.Join(tempDir, "evil")); !os.IsNotExist(err) {
+				t.Error("expected no file outside of target path")
+			}
+		})
+	}
+}
+
+func TestExtractTarGz_Links(t *testing.T) {
+	tempDir := t.TempDir()
+	buf := newTestTarGz(t, []testEntry{
+		{name: "root/", typeflag: tar.TypeDir, mode: 0775},
+		{name: "root/a/test.txt", typeflag: tar.TypeReg, mode: 04777, data: "test"},
+		{name: "root/b/symlink", typeflag: tar.TypeSymlink, linkname: "../a/test.txt"},
+		{name: "root/b/hardlink", typeflag: tar.TypeLink, linkname: "root/a/test.txt"},
+	})
+	rootDir, err := ExtractTarGz(buf, tempDir, Limits{MaxFiles: 3, MaxFileSize: 4, MaxTotalSize: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootDir != "root" {
+		t.Errorf("expected %s got %s", "root", rootDir)
+	}
+	for _, name := range []string{"root/b/symlink", "root/b/hardlink"} {
+		b, err := os.ReadFile(path.Join(tempDir, name))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(b) != "test" {
+			t.Errorf("expected test got %s", string(b))
+		}
+	}
+	info, err := os.Stat(path.Join(tempDir, "root/a/test.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&(os.ModeSetuid|0o002) != 0 {
+		t.Errorf("expected sanitized mode, got %s", info.Mode())
+	}
+}
+
+func TestExtractZip(t *testing.T) {
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	if _, err := zipWriter.Create("root/"); err != nil {
+		t.Fatal(err)
+	}
+	w, err := zipWriter.Create("root/test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write([]byte("test")); err != nil {
+		t.Fatal(err)
+	}
+	fileHeader := &zip.FileHeader{Name: "root/link"}
+	fileHeader.SetMode(os.ModeSymlink | 0777)
+	if w, err = zipWriter.CreateHeader(fileHeader); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write([]byte("test.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err = zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	tempDir := t.TempDir()
+	rootDir, err := ExtractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), tempDir, Limits{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootDir != "root" {
+		t.Errorf("expected %s got %s", "root", rootDir)
+	}
+	b, err := os.ReadFile(path.Join(tempDir, "root/link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "test" {
+		t.Errorf("expected test got %s", string(b))
+	}
+	t.Run("parent directory reference", func(t *testing.T) {
+		var buf bytes.Buffer
+		zipWriter := zip.NewWriter(&buf)
+		if _, err := zipWriter.Create("../evil"); err != nil {
+			t.Fatal(err)
+		}
+		if err := zipWriter.Close(); err != nil {
+			t.Fatal(err)
+		}
+		_, err = ExtractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), t.TempDir(), Limits{})
+		if !errors.Is(err, ErrIllegalPath) {
+			t.Errorf("expected %v, got %v", ErrIllegalPath, err)
+		}
+	})
+}
+
+func TestExtractFile(t *testing.T) {
+	rootDir, err := ExtractFile("./test/test.tar.gz", t.TempDir(), Limits{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootDir != "test" {
+		t.Errorf("expected %s got %s", "test", rootDir)
+	}
+	t.Run("unknown format", func(t *testing.T) {
+		_, err = ExtractFile("archive_test.go", t.TempDir(), Limits{})
+		if !errors.Is(err, ErrUnknownFormat) {
+			t.Errorf("expected %v, got %v", ErrUnknownFormat, err)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type CycleError struct {
+	Nodes []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("dependency cycle: %s", strings.Join(e.Nodes, " -> "))
+}
+
+// TopologicalOrder returns the keys of the provided map ordered so that each node follows its dependencies. Nodes are
+// visited in lexical order for a stable result, dependencies not contained in the map are ignored.
+func TopologicalOrder(nodes map[string][]string) ([]string, error) {
+	keys := make([]string, 0, len(nodes))
+	for key := range nodes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := make(map[string]int)
+	order := make([]string, 0, len(nodes))
+	var path []string
+	var visit func(node string) error
+	visit = func(node string) error {
+		switch states[node] {
+		case visited:
+			return nil
+		case visiting:
+			i := slices.Index(path, node)
+			return &CycleError{Nodes: append(slices.Clone(path[i:]), node)}
+		}
+		states[node] = visiting
+		path = append(path, node)
+		dependencies := slices.Clone(nodes[node])
+		slices.Sort(dependencies)
+		for _, dependency := range dependencies {
+			if _, ok := nodes[dependency]; !ok {
+				continue
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[node] = visited
+		order = append(order, node)
+		return nil
+	}
+	for _, key := range keys {
+		if err := visit(key); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTopologicalOrder(t *testing.T) {
+	order, err := TopologicalOrder(map[string][]string{
+		"a": {"b", "c"},
+		"b": {"c", "x"},
+		"c": nil,
+		"d": nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(order, []string{"c", "b", "a", "d"}) {
+		t.Errorf("expected [c b a d], got %v", order)
+	}
+	t.Run("cycle", func(t *testing.T) {
+		_, err = TopologicalOrder(map[string][]string{
+			"a": {"b"},
+			"b": {"c"},
+			"c": {"a"},
+		})
+		var cycleErr *CycleError
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf("expected cycle error, got %v", err)
+		}
+		if !slices.Equal(cycleErr.Nodes, []string{"a", "b", "c", "a"}) {
+			t.Errorf("expected [a b c a], got %v", cycleErr.Nodes)
+		}
+	})
+	t.Run("self reference", func(t *testing.T) {
+		if _, err = TopologicalOrder(map[string][]string{"a": {"a"}}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package progress
+
+import "context"
+
+// Reporter is implemented by jobs to expose the progress of long-running operations.
+type Reporter interface {
+	SetSteps(total int)
+	NextStep(stage string)
+	SetStage(stage string)
+	SetItem(item, status string)
+}
+
+const contextKeyReporter = "progress_reporter"
+
+func NewContext(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, contextKeyReporter, reporter)
+}
+
+// The following functions update the progress of the reporter provided by the context. If the context does not
+// contain a reporter, the functions have no effect.
+
+func SetSteps(ctx context.Context, total int) {
+	if reporter, ok := ctx.Value(contextKeyReporter).(Reporter); ok {
+		reporter.SetSteps(total)
+	}
+}
+
+func NextStep(ctx context.Context, stage string) {
+	if reporter, ok := ctx.Value(contextKeyReporter).(Reporter); ok {
+		reporter.NextStep(stage)
+	}
+}
+
+func SetStage(ctx context.Context, stage string) {
+	if reporter, ok := ctx.Value(contextKeyReporter).(Reporter); ok {
+		reporter.SetStage(stage)
+	}
+}
+
+func SetItem(ctx context.Context, item, status string) {
+	if reporter, ok := ctx.Value(contextKeyReporter).(Reporter); ok {
+		reporter.SetItem(item, status)
+	}
+}
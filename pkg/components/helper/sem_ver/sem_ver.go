@@ -0,0 +1,94 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"fmt"
+	"strings"
+
+	module_lib_sem_ver "github.com/SENERGY-Platform/mgw-module-lib/util/sem_ver"
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	"golang.org/x/mod/semver"
+)
+
+// GetUpdateType classifies the update from one version to another as major, minor or patch update. Updates between
+// pre-releases of the same version are patch updates. An empty string is returned if the new version is not higher.
+func GetUpdateType(from, to string) (string, error) {
+	res, err := module_lib_sem_ver.CompareSemVer(to, from)
+	if err != nil {
+		return "", err
+	}
+	if res < 1 {
+		return "", nil
+	}
+	if semver.Major(to) != semver.Major(from) {
+		return lib_constants.UpdateTypeMajor, nil
+	}
+	if semver.MajorMinor(to) != semver.MajorMinor(from) {
+		return lib_constants.UpdateTypeMinor, nil
+	}
+	return lib_constants.UpdateTypePatch, nil
+}
+
+// ValidateConstraint checks if a version constraint is valid. Constraints use the range syntax of Modfiles, e.g.
+// ">=v1.4.0;<v2.0.0", or one of the following shorthands:
+//   - "~v1.4.2" or "~v1.4" matches patch updates (>=v1.4.2;<v1.5.0) and "~v1" matches minor updates (>=v1;<v2.0.0)
+//   - "^v1.4.2" matches minor and patch updates (>=v1.4.2;<v2.0.0), the leftmost non-zero number is kept for
+//     versions below v1.0.0 ("^v0.4.2" matches >=v0.4.2;<v0.5.0)
+func ValidateConstraint(constraint string) error {
+	r, err := toRange(constraint)
+	if err != nil {
+		return err
+	}
+	return module_lib_sem_ver.ValidateSemVerRange(r)
+}
+
+// InConstraint returns true if the version satisfies the constraint, see ValidateConstraint for the syntax.
+func InConstraint(constraint, version string) (bool, error) {
+	r, err := toRange(constraint)
+	if err != nil {
+		return false, err
+	}
+	return module_lib_sem_ver.InSemVerRange(r, version)
+}
+
+// toRange expands shorthand constraints to the range syntax of Modfiles, other constraints are returned as is.
+func toRange(constraint string) (string, error) {
+	op := constraint[:min(len(constraint), 1)]
+	if op != "~" && op != "^" {
+		return constraint, nil
+	}
+	v := constraint[1:]
+	if !semver.IsValid(v) {
+		return "", fmt.Errorf("format '%s' invalid", v)
+	}
+	var major, minor, patch int
+	if _, err := fmt.Sscanf(semver.Canonical(v), "v%d.%d.%d", &major, &minor, &patch); err != nil {
+		return "", fmt.Errorf("format '%s' invalid", v)
+	}
+	n := strings.Count(strings.TrimSuffix(strings.TrimSuffix(v, semver.Build(v)), semver.Prerelease(v)), ".") + 1
+	var upper string
+	switch {
+	case n == 1 || (op == "^" && major > 0):
+		upper = fmt.Sprintf("v%d.0.0", major+1)
+	case op == "~" || minor > 0 || n == 2:
+		upper = fmt.Sprintf("v%d.%d.0", major, minor+1)
+	default:
+		upper = fmt.Sprintf("v%d.%d.%d", major, minor, patch+1)
+	}
+	return module_lib_sem_ver.GreaterEqual + v + ";" + module_lib_sem_ver.Less + upper, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sem_ver
+
+import (
+	"testing"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+)
+
+func TestGetUpdateType(t *testing.T) {
+	cases := []struct {
+		from, to, expected string
+	}{
+		{"v1.4.2", "v2.0.0", lib_constants.UpdateTypeMajor},
+		{"v1.4.2", "v1.5.0", lib_constants.UpdateTypeMinor},
+		{"v1.4.2", "v1.4.3", lib_constants.UpdateTypePatch},
+		{"v1.4.2-rc.1", "v1.4.2", lib_constants.UpdateTypePatch},
+		{"v1.4.2", "v1.4.2", ""},
+		{"v1.4.2", "v1.4.1", ""},
+		{"v2.0.0", "v1.9.9", ""},
+	}
+	for _, c := range cases {
+		updateType, err := GetUpdateType(c.from, c.to)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if updateType != c.expected {
+			t.Errorf("%s -> %s: expected '%s', got '%s'", c.from, c.to, c.expected, updateType)
+		}
+	}
+	if _, err := GetUpdateType("v1.0.0", "latest"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestInConstraint(t *testing.T) {
+	cases := []struct {
+		constraint string
+		matching   []string
+		other      []string
+	}{
+		{"=v1.4.2", []string{"v1.4.2"}, []string{"v1.4.1", "v1.4.2-rc.1"}},
+		{">=v1.2;<v2", []string{"v1.2.0", "v1.9.9"}, []string{"v1.1.9", "v2.0.0"}},
+		{">v1.2.0;<=v1.3.0", []string{"v1.2.1", "v1.3.0"}, []string{"v1.2.0", "v1.3.1"}},
+		{"~v1.4", []string{"v1.4.0", "v1.4.7"}, []string{"v1.5.0", "v1.3.0"}},
+		{"~v1.4.2", []string{"v1.4.2", "v1.4.9"}, []string{"v1.4.1", "v1.5.0"}},
+		{"~v1", []string{"v1.0.0", "v1.9.0"}, []string{"v2.0.0"}},
+		{"^v1.4.2", []string{"v1.4.2", "v1.9.0"}, []string{"v1.4.1", "v2.0.0"}},
+		{"^v0.4.2", []string{"v0.4.2", "v0.4.9"}, []string{"v0.5.0"}},
+		{"^v0.0.3", []string{"v0.0.3"}, []string{"v0.0.4"}},
+	}
+	for _, c := range cases {
+		if err := ValidateConstraint(c.constraint); err != nil {
+			t.Errorf("%s: %s", c.constraint, err)
+			continue
+		}
+		for _, v := range c.matching {
+			if ok, err := InConstraint(c.constraint, v); err != nil || !ok {
+				t.Errorf("%s: expected %s to match", c.constraint, v)
+			}
+		}
+		for _, v := range c.other {
+			if ok, err := InConstraint(c.constraint, v); err != nil || ok {
+				t.Errorf("%s: expected %s not to match", c.constraint, v)
+			}
+		}
+	}
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"", "~", "~1.4", "v1.4.2", "^v1.4.2.1", ">=v2;<v1", "=>v1.0.0", "latest"} {
+			if err := ValidateConstraint(s); err == nil {
+				t.Errorf("%s: expected error", s)
+			}
+		}
+	})
+}
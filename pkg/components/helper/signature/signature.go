@@ -0,0 +1,180 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signature
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+// ManifestFile lists the sha256 checksums of all module files in the format of sha256sum ('<checksum>  <path>').
+// SignatureFile contains the base64 encoded ed25519 signature of the manifest file.
+const (
+	ManifestFile  = "MANIFEST.sha256"
+	SignatureFile = "MANIFEST.sha256.sig"
+)
+
+func ParsePublicKeys(keys []string) ([]ed25519.PublicKey, error) {
+	var publicKeys []ed25519.PublicKey
+	for _, key := range keys {
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key '%s': %w", key, err)
+		}
+		if len(b) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key '%s': expected ed25519 key", key)
+		}
+		publicKeys = append(publicKeys, b)
+	}
+	return publicKeys, nil
+}
+
+// CreateManifest returns a manifest of all regular files except the manifest and signature files.
+func CreateManifest(fSys fs.FS) ([]byte, error) {
+	checksums, err := getChecksums(fSys)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for p := range checksums {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	var buf bytes.Buffer
+	for _, p := range paths {
+		buf.WriteString(checksums[p] + "  " + p + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// Verify checks if the manifest is signed by one of the provided keys and if the manifest matches the files.
+func Verify(fSys fs.FS, keys []ed25519.PublicKey) error {
+	manifest, err := fs.ReadFile(fSys, ManifestFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return errors.New("module not signed: manifest missing")
+		}
+		return err
+	}
+	sigFile, err := fs.ReadFile(fSys, SignatureFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return errors.New("module not signed: signature missing")
+		}
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sigFile)))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !slices.ContainsFunc(keys, func(key ed25519.PublicKey) bool {
+		return ed25519.Verify(key, manifest, sig)
+	}) {
+		return errors.New("invalid signature: no trusted key")
+	}
+	expected, err := parseManifest(manifest)
+	if err != nil {
+		return err
+	}
+	checksums, err := getChecksums(fSys)
+	if err != nil {
+		return err
+	}
+	for p, checksum := range checksums {
+		expectedChecksum, ok := expected[p]
+		if !ok {
+			return fmt.Errorf("file '%s' not in manifest", p)
+		}
+		if checksum != expectedChecksum {
+			return fmt.Errorf("file '%s' checksum mismatch", p)
+		}
+	}
+	for p := range expected {
+		if _, ok := checksums[p]; !ok {
+			return fmt.Errorf("file '%s' missing", p)
+		}
+	}
+	return nil
+}
+
+func parseManifest(b []byte) (map[string]string, error) {
+	entries := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		checksum, p, ok := strings.Cut(line, "  ")
+		if !ok || p == "" {
+			return nil, fmt.Errorf("invalid manifest entry '%s'", line)
+		}
+		entries[strings.TrimPrefix(p, "./")] = strings.ToLower(checksum)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func getChecksums(fSys fs.FS) (map[string]string, error) {
+	checksums := make(map[string]string)
+	err := fs.WalkDir(fSys, ".", func(p string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEntry.IsDir() || p == ManifestFile || p == SignatureFile {
+			return nil
+		}
+		if !dirEntry.Type().IsRegular() {
+			return fmt.Errorf("file '%s' is not a regular file", p)
+		}
+		checksum, err := getChecksum(fSys, p)
+		if err != nil {
+			return err
+		}
+		checksums[p] = checksum
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return checksums, nil
+}
+
+func getChecksum(fSys fs.FS, p string) (string, error) {
+	file, err := fSys.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
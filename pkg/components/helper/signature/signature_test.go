@@ -0,0 +1,96 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signature
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+	"testing/fstest"
+)
+
+func TestVerify(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fSys := fstest.MapFS{
+		"Modfile.yml":   {Data: []byte("test")},
+		"dir/test.conf": {Data: []byte("test")},
+	}
+	manifest, err := CreateManifest(fSys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fSys[ManifestFile] = &fstest.MapFile{Data: manifest}
+	fSys[SignatureFile] = &fstest.MapFile{Data: []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, manifest)))}
+	keys, err := ParsePublicKeys([]string{base64.StdEncoding.EncodeToString(publicKey)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(fSys, keys); err != nil {
+		t.Error(err)
+	}
+	t.Run("untrusted key", func(t *testing.T) {
+		otherKey, _, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = Verify(fSys, []ed25519.PublicKey{otherKey}); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("tampered file", func(t *testing.T) {
+		fSys["dir/test.conf"] = &fstest.MapFile{Data: []byte("tampered")}
+		defer func() {
+			fSys["dir/test.conf"] = &fstest.MapFile{Data: []byte("test")}
+		}()
+		if err = Verify(fSys, keys); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("additional file", func(t *testing.T) {
+		fSys["dir/other.conf"] = &fstest.MapFile{Data: []byte("test")}
+		defer delete(fSys, "dir/other.conf")
+		if err = Verify(fSys, keys); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("missing file", func(t *testing.T) {
+		delete(fSys, "dir/test.conf")
+		defer func() {
+			fSys["dir/test.conf"] = &fstest.MapFile{Data: []byte("test")}
+		}()
+		if err = Verify(fSys, keys); err == nil {
+			t.Error("expected error")
+		}
+	})
+	t.Run("unsigned", func(t *testing.T) {
+		if err = Verify(fstest.MapFS{"Modfile.yml": {Data: []byte("test")}}, keys); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestParsePublicKeys(t *testing.T) {
+	if _, err := ParsePublicKeys([]string{"invalid"}); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := ParsePublicKeys([]string{base64.StdEncoding.EncodeToString([]byte("short"))}); err == nil {
+		t.Error("expected error")
+	}
+}
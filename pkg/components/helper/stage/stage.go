@@ -0,0 +1,142 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+)
+
+// Area manages uploads staged below a working directory. Each staged upload is a directory named after the prefix
+// and a random suffix, the directory name serves as ID.
+type Area struct {
+	path   string
+	prefix string
+	name   string
+}
+
+// New creates an area for uploads staged in the directory. The name is used in error messages.
+func New(dirPath, prefix, name string) Area {
+	return Area{
+		path:   dirPath,
+		prefix: prefix,
+		name:   name,
+	}
+}
+
+// Clean removes uploads staged before a restart.
+func (a Area) Clean() error {
+	return Clean(a.path, a.prefix)
+}
+
+// Create creates the directory of a new staged upload and returns the ID and path.
+func (a Area) Create() (string, string, error) {
+	stagePath, err := os.MkdirTemp(a.path, a.prefix)
+	if err != nil {
+		return "", "", err
+	}
+	return path.Base(stagePath), stagePath, nil
+}
+
+// Path returns the path of a staged upload. IDs that do not reference a directory of the area are treated as not
+// found.
+func (a Area) Path(id string) (string, error) {
+	if path.Base(id) != id || !strings.HasPrefix(id, a.prefix) {
+		return "", lib_errors.New[lib_errors.ErrNotFound]("staged " + a.name + " not found")
+	}
+	stagePath := path.Join(a.path, id)
+	if _, err := os.Stat(stagePath); err != nil {
+		if os.IsNotExist(err) {
+			return "", lib_errors.New[lib_errors.ErrNotFound]("staged " + a.name + " not found")
+		}
+		return "", err
+	}
+	return stagePath, nil
+}
+
+// Remove removes a staged upload.
+func (a Area) Remove(id string) error {
+	stagePath, err := a.Path(id)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(stagePath)
+}
+
+// Clean removes all entries of a directory starting with one of the prefixes.
+func Clean(dirPath string, prefixes ...string) error {
+	dirEntries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return err
+	}
+	for _, dirEntry := range dirEntries {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(dirEntry.Name(), prefix) {
+				if err = os.RemoveAll(path.Join(dirPath, dirEntry.Name())); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// WriteFile writes the content read from r to a new file, e.g. an uploaded archive. Content exceeding the limit is
+// rejected, zero disables the limit.
+func WriteFile(name string, r io.Reader, limit int64) error {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0664)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if limit > 0 {
+		r = io.LimitReader(r, limit+1)
+	}
+	n, err := io.Copy(file, r)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && n > limit {
+		return lib_errors.New[lib_errors.ErrInvalidInput](fmt.Sprintf("upload exceeds size limit of %d bytes", limit))
+	}
+	return nil
+}
+
+// FindRoot returns the directory matched by the function, either the extraction path or the single directory at the
+// extraction path. Archives often contain a single top level directory.
+func FindRoot(extractPath string, match func(dirPath string) bool) (string, bool, error) {
+	candidates := []string{extractPath}
+	dirEntries, err := os.ReadDir(extractPath)
+	if err != nil {
+		return "", false, err
+	}
+	if len(dirEntries) == 1 && dirEntries[0].IsDir() {
+		candidates = append(candidates, path.Join(extractPath, dirEntries[0].Name()))
+	}
+	for _, candidate := range candidates {
+		if match(candidate) {
+			return candidate, true, nil
+		}
+	}
+	return "", false, nil
+}
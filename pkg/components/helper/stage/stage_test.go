@@ -0,0 +1,123 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stage
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+)
+
+func TestArea(t *testing.T) {
+	tmpDir := t.TempDir()
+	a := New(tmpDir, "test-", "test")
+	id, stagePath, err := a.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stagePath != path.Join(tmpDir, id) {
+		t.Errorf("expected: %s, got: %s", path.Join(tmpDir, id), stagePath)
+	}
+	p, err := a.Path(id)
+	if err != nil {
+		t.Error(err)
+	}
+	if p != stagePath {
+		t.Errorf("expected: %s, got: %s", stagePath, p)
+	}
+	if err = a.Remove(id); err != nil {
+		t.Error(err)
+	}
+	if _, err = os.Stat(stagePath); !os.IsNotExist(err) {
+		t.Error("expected stage directory to be removed")
+	}
+	t.Run("not found", func(t *testing.T) {
+		for _, id := range []string{id, "../" + id, "other", ""} {
+			if _, err := a.Path(id); !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+				t.Errorf("'%s' expected not found error, got: %v", id, err)
+			}
+		}
+	})
+	t.Run("clean", func(t *testing.T) {
+		if _, _, err := a.Create(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Mkdir(path.Join(tmpDir, "keep"), 0775); err != nil {
+			t.Fatal(err)
+		}
+		if err := a.Clean(); err != nil {
+			t.Fatal(err)
+		}
+		dirEntries, err := os.ReadDir(tmpDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dirEntries) != 1 || dirEntries[0].Name() != "keep" {
+			t.Errorf("expected only 'keep', got: %v", dirEntries)
+		}
+	})
+}
+
+func TestWriteFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := WriteFile(path.Join(tmpDir, "test"), strings.NewReader("test"), 4); err != nil {
+		t.Error(err)
+	}
+	b, err := os.ReadFile(path.Join(tmpDir, "test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "test" {
+		t.Errorf("expected: %s, got: %s", "test", string(b))
+	}
+	if err = WriteFile(path.Join(tmpDir, "test"), strings.NewReader("test"), 0); err != nil {
+		t.Error(err)
+	}
+	t.Run("exceeds limit", func(t *testing.T) {
+		err := WriteFile(path.Join(tmpDir, "test"), strings.NewReader("test"), 3)
+		if !lib_errors.IsOf[lib_errors.ErrInvalidInput](err) {
+			t.Errorf("expected invalid input error, got: %v", err)
+		}
+	})
+}
+
+func TestFindRoot(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(path.Join(tmpDir, "root", "target"), 0775); err != nil {
+		t.Fatal(err)
+	}
+	match := func(dirPath string) bool {
+		_, err := os.Stat(path.Join(dirPath, "target"))
+		return err == nil
+	}
+	p, ok, err := FindRoot(tmpDir, match)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || p != path.Join(tmpDir, "root") {
+		t.Errorf("expected: %s, got: %s", path.Join(tmpDir, "root"), p)
+	}
+	if err = os.Mkdir(path.Join(tmpDir, "other"), 0775); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err = FindRoot(tmpDir, match); err != nil || ok {
+		t.Errorf("expected no match, got: %v %v", ok, err)
+	}
+}
@@ -37,6 +37,7 @@ type MgwCoreConfig struct {
 
 type SqlConfig = helper_sql_db.Config
 type DatabaseConfig struct {
+	Dialect               string                   `json:"dialect" env_var:"DATABASE_DIALECT"`
 	Address               string                   `json:"address" env_var:"DATABASE_ADDRESS"`
 	Database              string                   `json:"database" env_var:"DATABASE_NAME"`
 	User                  string                   `json:"user" env_var:"DATABASE_USER"`
@@ -45,6 +46,7 @@ type DatabaseConfig struct {
 	MaxOpenConnections    int                      `json:"max_open_connections" env_var:"DATABASE_MAX_OPEN_CONNECTIONS"`
 	MaxIdleConnections    int                      `json:"max_idle_connections" env_var:"DATABASE_MAX_IDLE_CONNECTIONS"`
 	ConnectionMaxLifetime sb_config_types.Duration `json:"connection_max_lifetime" env_var:"DATABASE_CONNECTION_MAX_LIFETIME"`
+	Path                  string                   `json:"path" env_var:"DATABASE_PATH"`
 }
 
 type ModulesHandlerConfig struct {
@@ -55,6 +57,15 @@ type DeploymentsHandlerConfig struct {
 	WorkdirPath                string                   `json:"workdir_path" env_var:"DEPLOYMENTS_HANDLER_WORKDIR_PATH"`
 	RuntimeMonitorStartupDelay sb_config_types.Duration `json:"runtime_monitor_startup_delay" env_var:"DEPLOYMENTS_HANDLER_RUNTIME_MONITOR_STARTUP_DELAY"`
 	RuntimeMonitorLoopDelay    sb_config_types.Duration `json:"runtime_monitor_loop_delay" env_var:"DEPLOYMENTS_HANDLER_RUNTIME_MONITOR_LOOP_DELAY"`
+	RestartBackoffDelay        sb_config_types.Duration `json:"restart_backoff_delay" env_var:"DEPLOYMENTS_HANDLER_RESTART_BACKOFF_DELAY"`
+	RestartBackoffMaxDelay     sb_config_types.Duration `json:"restart_backoff_max_delay" env_var:"DEPLOYMENTS_HANDLER_RESTART_BACKOFF_MAX_DELAY"`
+	RestartResetDelay          sb_config_types.Duration `json:"restart_reset_delay" env_var:"DEPLOYMENTS_HANDLER_RESTART_RESET_DELAY"`
+	CrashLoopMaxRestarts       int                      `json:"crash_loop_max_restarts" env_var:"DEPLOYMENTS_HANDLER_CRASH_LOOP_MAX_RESTARTS"`
+	RestartUnhealthy           bool                     `json:"restart_unhealthy" env_var:"DEPLOYMENTS_HANDLER_RESTART_UNHEALTHY"`
+	RuntimeEventsMaxEntries    int                      `json:"runtime_events_max_entries" env_var:"DEPLOYMENTS_HANDLER_RUNTIME_EVENTS_MAX_ENTRIES"`
+	WaitForHealthyDependencies bool                     `json:"wait_for_healthy_dependencies" env_var:"DEPLOYMENTS_HANDLER_WAIT_FOR_HEALTHY_DEPENDENCIES"`
+	DependencyHealthyTimeout   sb_config_types.Duration `json:"dependency_healthy_timeout" env_var:"DEPLOYMENTS_HANDLER_DEPENDENCY_HEALTHY_TIMEOUT"`
+	SnapshotsMaxEntries        int                      `json:"snapshots_max_entries" env_var:"DEPLOYMENTS_HANDLER_SNAPSHOTS_MAX_ENTRIES"`
 }
 
 type AuxDeploymentsHandlerConfig struct {
@@ -62,46 +73,122 @@ type AuxDeploymentsHandlerConfig struct {
 	RuntimeMonitorLoopDelay    sb_config_types.Duration `json:"runtime_monitor_loop_delay" env_var:"AUX_DEPLOYMENTS_HANDLER_RUNTIME_MONITOR_LOOP_DELAY"`
 }
 
+type BackupHandlerConfig struct {
+	WorkdirPath string `json:"workdir_path" env_var:"BACKUP_HANDLER_WORKDIR_PATH"`
+}
+
+// BundlesHandlerConfig configures the import of offline bundles. Bundles contain container images, thus separate
+// archive limits apply. The image load timeout applies to requests of the container engine wrapper client used for
+// loading images.
+type BundlesHandlerConfig struct {
+	WorkdirPath      string                   `json:"workdir_path" env_var:"BUNDLES_HANDLER_WORKDIR_PATH"`
+	ImageLoadTimeout sb_config_types.Duration `json:"image_load_timeout" env_var:"BUNDLES_HANDLER_IMAGE_LOAD_TIMEOUT"`
+	MaxUploadSize    int64                    `json:"max_upload_size" env_var:"BUNDLES_HANDLER_MAX_UPLOAD_SIZE"`
+	MaxTotalSize     int64                    `json:"max_total_size" env_var:"BUNDLES_HANDLER_MAX_TOTAL_SIZE"`
+	MaxFiles         int                      `json:"max_files" env_var:"BUNDLES_HANDLER_MAX_FILES"`
+	MaxFileSize      int64                    `json:"max_file_size" env_var:"BUNDLES_HANDLER_MAX_FILE_SIZE"`
+}
+
 type HostDirRepositoryHandlerConfig struct {
 	WorkdirPath string `json:"workdir_path" env_var:"HOST_DIR_HANDLER_WORKDIR_PATH"`
 	Priority    int    `json:"priority" env_var:"HOST_DIR_HANDLER_PRIORITY"`
 }
 
+type UploadsRepositoryHandlerConfig struct {
+	WorkdirPath   string `json:"workdir_path" env_var:"UPLOADS_HANDLER_WORKDIR_PATH"`
+	Priority      int    `json:"priority" env_var:"UPLOADS_HANDLER_PRIORITY"`
+	MaxUploadSize int64  `json:"max_upload_size" env_var:"UPLOADS_HANDLER_MAX_UPLOAD_SIZE"`
+}
+
 type GitHubRepositoriesHandlerConfig struct {
 	BaseUrl     string                   `json:"base_url" env_var:"GITHUB_HANDLER_BASE_URL"`
 	WorkdirPath string                   `json:"workdir_path" env_var:"GITHUB_HANDLER_WORKDIR_PATH"`
 	Timeout     sb_config_types.Duration `json:"timeout" env_var:"GITHUB_HANDLER_HTTP_TIMEOUT"`
 }
 
+type GitLabRepositoriesHandlerConfig struct {
+	WorkdirPath string                   `json:"workdir_path" env_var:"GITLAB_HANDLER_WORKDIR_PATH"`
+	Timeout     sb_config_types.Duration `json:"timeout" env_var:"GITLAB_HANDLER_HTTP_TIMEOUT"`
+}
+
+type GiteaRepositoriesHandlerConfig struct {
+	WorkdirPath string                   `json:"workdir_path" env_var:"GITEA_HANDLER_WORKDIR_PATH"`
+	Timeout     sb_config_types.Duration `json:"timeout" env_var:"GITEA_HANDLER_HTTP_TIMEOUT"`
+}
+
+type TarballRepositoriesHandlerConfig struct {
+	WorkdirPath string                   `json:"workdir_path" env_var:"TARBALL_HANDLER_WORKDIR_PATH"`
+	Timeout     sb_config_types.Duration `json:"timeout" env_var:"TARBALL_HANDLER_HTTP_TIMEOUT"`
+}
+
+// ArchiveLimitsConfig restricts the extraction of repository archives and uploaded backups. Sizes are in bytes,
+// zero disables the respective limit.
+type ArchiveLimitsConfig struct {
+	MaxTotalSize int64 `json:"max_total_size" env_var:"ARCHIVE_MAX_TOTAL_SIZE"`
+	MaxFiles     int   `json:"max_files" env_var:"ARCHIVE_MAX_FILES"`
+	MaxFileSize  int64 `json:"max_file_size" env_var:"ARCHIVE_MAX_FILE_SIZE"`
+}
+
 type JobsHandlerConfig struct {
 	MaxJobAge        sb_config_types.Duration `json:"max_job_age" env_var:"JOBS_HANDLER_MAX_JOB_AGE"`
 	CleanupLoopDelay sb_config_types.Duration `json:"cleanup_loop_delay" env_var:"JOBS_HANDLER_CLEANUP_LOOP_DELAY"`
 }
 
+type EventsHandlerConfig struct {
+	BufferSize int `json:"buffer_size" env_var:"EVENTS_HANDLER_BUFFER_SIZE"`
+}
+
+type RepositoriesRefreshSchedulerConfig struct {
+	StartupDelay  sb_config_types.Duration `json:"startup_delay" env_var:"REPOSITORIES_REFRESH_SCHEDULER_STARTUP_DELAY"`
+	Interval      sb_config_types.Duration `json:"interval" env_var:"REPOSITORIES_REFRESH_SCHEDULER_INTERVAL"`
+	Jitter        sb_config_types.Duration `json:"jitter" env_var:"REPOSITORIES_REFRESH_SCHEDULER_JITTER"`
+	RetryDelay    sb_config_types.Duration `json:"retry_delay" env_var:"REPOSITORIES_REFRESH_SCHEDULER_RETRY_DELAY"`
+	MaxRetryDelay sb_config_types.Duration `json:"max_retry_delay" env_var:"REPOSITORIES_REFRESH_SCHEDULER_MAX_RETRY_DELAY"`
+}
+
+type AuthConfig struct {
+	Enabled          bool   `json:"enabled" env_var:"AUTH_ENABLED"`
+	ApiKeysPath      string `json:"api_keys_path" env_var:"AUTH_API_KEYS_PATH"`
+	JwtPublicKeyPath string `json:"jwt_public_key_path" env_var:"AUTH_JWT_PUBLIC_KEY_PATH"`
+	JwtRolesClaim    string `json:"jwt_roles_claim" env_var:"AUTH_JWT_ROLES_CLAIM"`
+	JwtIssuer        string `json:"jwt_issuer" env_var:"AUTH_JWT_ISSUER"`
+	JwtAudience      string `json:"jwt_audience" env_var:"AUTH_JWT_AUDIENCE"`
+}
+
 type LoggerConfig struct {
 	struct_logger.Config
 	HttpAccessLog bool `json:"http_access_log" env_var:"HTTP_ACCESS_LOG"`
 }
 
 type Config struct {
-	ServerPort                uint                            `json:"server_port" env_var:"SERVER_PORT"`
-	ManagerIdPath             string                          `json:"manager_id_path" env_var:"MANAGER_ID_PATH"`
-	CoreId                    string                          `json:"core_id" env_var:"CORE_ID"`
-	ModuleContainerNetwork    string                          `json:"module_container_network" env_var:"MODULE_CONTAINER_NETWORK"`
-	UseUTC                    bool                            `json:"use_utc" env_var:"USE_UTC"`
-	JobPollInterval           sb_config_types.Duration        `json:"job_poll_interval" env_var:"JOB_POLL_INTERVAL"`
-	ImageNameEscapeDepth      int                             `json:"image_name_escape_depth" env_var:"IMAGE_NAME_ESCAPE_DEPTH"`
-	HostDeploymentsPath       string                          `json:"host_deployments_path" env_var:"HOST_DEPLOYMENTS_PATH"`
-	HostSecretsPath           string                          `json:"host_secrets_path" env_var:"HOST_SECRETS_PATH"`
-	Logger                    LoggerConfig                    `json:"logger"`
-	MgwCore                   MgwCoreConfig                   `json:"mgw_core"`
-	Database                  DatabaseConfig                  `json:"database"`
-	ModulesHandler            ModulesHandlerConfig            `json:"modules_handler"`
-	DeploymentsHandler        DeploymentsHandlerConfig        `json:"deployments_handler"`
-	AuxDeploymentsHandler     AuxDeploymentsHandlerConfig     `json:"aux_deployments_handler"`
-	HostDirRepositoryHandler  HostDirRepositoryHandlerConfig  `json:"host_dir_repository_handler"`
-	GitHubRepositoriesHandler GitHubRepositoriesHandlerConfig `json:"github_repositories_handler"`
-	JobsHandler               JobsHandlerConfig               `json:"jobs_handler"`
+	ServerPort                   uint                               `json:"server_port" env_var:"SERVER_PORT"`
+	ManagerIdPath                string                             `json:"manager_id_path" env_var:"MANAGER_ID_PATH"`
+	CoreId                       string                             `json:"core_id" env_var:"CORE_ID"`
+	ModuleContainerNetwork       string                             `json:"module_container_network" env_var:"MODULE_CONTAINER_NETWORK"`
+	UseUTC                       bool                               `json:"use_utc" env_var:"USE_UTC"`
+	JobPollInterval              sb_config_types.Duration           `json:"job_poll_interval" env_var:"JOB_POLL_INTERVAL"`
+	ImageNameEscapeDepth         int                                `json:"image_name_escape_depth" env_var:"IMAGE_NAME_ESCAPE_DEPTH"`
+	HostDeploymentsPath          string                             `json:"host_deployments_path" env_var:"HOST_DEPLOYMENTS_PATH"`
+	HostSecretsPath              string                             `json:"host_secrets_path" env_var:"HOST_SECRETS_PATH"`
+	Logger                       LoggerConfig                       `json:"logger"`
+	Auth                         AuthConfig                         `json:"auth"`
+	MgwCore                      MgwCoreConfig                      `json:"mgw_core"`
+	Database                     DatabaseConfig                     `json:"database"`
+	ModulesHandler               ModulesHandlerConfig               `json:"modules_handler"`
+	DeploymentsHandler           DeploymentsHandlerConfig           `json:"deployments_handler"`
+	AuxDeploymentsHandler        AuxDeploymentsHandlerConfig        `json:"aux_deployments_handler"`
+	BackupHandler                BackupHandlerConfig                `json:"backup_handler"`
+	BundlesHandler               BundlesHandlerConfig               `json:"bundles_handler"`
+	HostDirRepositoryHandler     HostDirRepositoryHandlerConfig     `json:"host_dir_repository_handler"`
+	UploadsRepositoryHandler     UploadsRepositoryHandlerConfig     `json:"uploads_repository_handler"`
+	GitHubRepositoriesHandler    GitHubRepositoriesHandlerConfig    `json:"github_repositories_handler"`
+	GitLabRepositoriesHandler    GitLabRepositoriesHandlerConfig    `json:"gitlab_repositories_handler"`
+	GiteaRepositoriesHandler     GiteaRepositoriesHandlerConfig     `json:"gitea_repositories_handler"`
+	TarballRepositoriesHandler   TarballRepositoriesHandlerConfig   `json:"tarball_repositories_handler"`
+	ArchiveLimits                ArchiveLimitsConfig                `json:"archive_limits"`
+	JobsHandler                  JobsHandlerConfig                  `json:"jobs_handler"`
+	EventsHandler                EventsHandlerConfig                `json:"events_handler"`
+	RepositoriesRefreshScheduler RepositoriesRefreshSchedulerConfig `json:"repositories_refresh_scheduler"`
 }
 
 var defaultConfig = Config{
@@ -117,15 +204,20 @@ var defaultConfig = Config{
 			TimeUtc:    true,
 		},
 	},
+	Auth: AuthConfig{
+		JwtRolesClaim: "roles",
+	},
 	MgwCore: MgwCoreConfig{
 		Timeout: sb_config_types.Duration(time.Second * 30),
 	},
 	Database: DatabaseConfig{
+		Dialect:               "mysql",
 		Database:              "module_manager",
 		Timeout:               sb_config_types.Duration(time.Second * 30),
 		MaxOpenConnections:    25,
 		MaxIdleConnections:    25,
 		ConnectionMaxLifetime: sb_config_types.Duration(time.Minute * 5),
+		Path:                  "/opt/module-manager/data/module_manager.db",
 	},
 	ModulesHandler: ModulesHandlerConfig{
 		WorkdirPath: "/opt/module-manager/modules",
@@ -134,24 +226,74 @@ var defaultConfig = Config{
 		WorkdirPath:                "/opt/module-manager/deployments",
 		RuntimeMonitorStartupDelay: sb_config_types.Duration(time.Second * 30),
 		RuntimeMonitorLoopDelay:    sb_config_types.Duration(time.Second * 5),
+		RestartBackoffDelay:        sb_config_types.Duration(time.Second * 10),
+		RestartBackoffMaxDelay:     sb_config_types.Duration(time.Minute * 5),
+		RestartResetDelay:          sb_config_types.Duration(time.Minute * 10),
+		CrashLoopMaxRestarts:       10,
+		RuntimeEventsMaxEntries:    100,
+		DependencyHealthyTimeout:   sb_config_types.Duration(time.Minute * 2),
+		SnapshotsMaxEntries:        5,
 	},
 	AuxDeploymentsHandler: AuxDeploymentsHandlerConfig{
 		RuntimeMonitorStartupDelay: sb_config_types.Duration(time.Second * 30),
 		RuntimeMonitorLoopDelay:    sb_config_types.Duration(time.Second * 5),
 	},
+	BackupHandler: BackupHandlerConfig{
+		WorkdirPath: "/opt/module-manager/backup",
+	},
+	BundlesHandler: BundlesHandlerConfig{
+		WorkdirPath:      "/opt/module-manager/bundles",
+		ImageLoadTimeout: sb_config_types.Duration(time.Minute * 30),
+		MaxUploadSize:    16 << 30,
+		MaxTotalSize:     16 << 30,
+		MaxFiles:         100000,
+		MaxFileSize:      8 << 30,
+	},
 	HostDirRepositoryHandler: HostDirRepositoryHandlerConfig{
 		WorkdirPath: "/opt/module-manager/repositories/host_dir",
 		Priority:    0,
 	},
+	UploadsRepositoryHandler: UploadsRepositoryHandlerConfig{
+		WorkdirPath:   "/opt/module-manager/repositories/uploads",
+		Priority:      -1,
+		MaxUploadSize: 256 << 20,
+	},
 	GitHubRepositoriesHandler: GitHubRepositoriesHandlerConfig{
 		BaseUrl:     "https://api.github.com",
 		WorkdirPath: "/opt/module-manager/repositories/github",
 		Timeout:     sb_config_types.Duration(time.Minute),
 	},
+	GitLabRepositoriesHandler: GitLabRepositoriesHandlerConfig{
+		WorkdirPath: "/opt/module-manager/repositories/gitlab",
+		Timeout:     sb_config_types.Duration(time.Minute),
+	},
+	GiteaRepositoriesHandler: GiteaRepositoriesHandlerConfig{
+		WorkdirPath: "/opt/module-manager/repositories/gitea",
+		Timeout:     sb_config_types.Duration(time.Minute),
+	},
+	TarballRepositoriesHandler: TarballRepositoriesHandlerConfig{
+		WorkdirPath: "/opt/module-manager/repositories/tarball",
+		Timeout:     sb_config_types.Duration(time.Minute),
+	},
+	ArchiveLimits: ArchiveLimitsConfig{
+		MaxTotalSize: 1 << 30,
+		MaxFiles:     10000,
+		MaxFileSize:  256 << 20,
+	},
 	JobsHandler: JobsHandlerConfig{
 		MaxJobAge:        sb_config_types.Duration(time.Hour * 24),
 		CleanupLoopDelay: sb_config_types.Duration(time.Minute * 5),
 	},
+	EventsHandler: EventsHandlerConfig{
+		BufferSize: 64,
+	},
+	RepositoriesRefreshScheduler: RepositoriesRefreshSchedulerConfig{
+		StartupDelay:  sb_config_types.Duration(time.Minute),
+		Interval:      sb_config_types.Duration(time.Hour * 6),
+		Jitter:        sb_config_types.Duration(time.Minute * 15),
+		RetryDelay:    sb_config_types.Duration(time.Minute),
+		MaxRetryDelay: sb_config_types.Duration(time.Hour),
+	},
 }
 
 func New(path string) (Config, error) {
@@ -20,10 +20,14 @@ import "flag"
 
 var ConfPath string
 var ManagerId string
+var MigrationsStatus bool
+var MigrateDown int
 
 func ParseFlags() {
 	flag.StringVar(&ConfPath, "config", "", "path to config JSON file")
 	flag.StringVar(&ManagerId, "manager-id", "", "override manager id")
+	flag.BoolVar(&MigrationsStatus, "migrations-status", false, "print database schema migrations status and exit")
+	flag.IntVar(&MigrateDown, "migrate-down", -1, "revert database schema migrations down to version and exit")
 	flag.Parse()
 	return
 }
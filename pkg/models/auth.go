@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import "slices"
+
+type Role string
+
+// Roles ordered by privileges, each role includes the privileges of the preceding roles.
+const (
+	RoleRead    Role = "read"
+	RoleOperate Role = "operate"
+	RoleAdmin   Role = "admin"
+)
+
+var roles = []Role{RoleRead, RoleOperate, RoleAdmin}
+
+func (r Role) Valid() bool {
+	return slices.Contains(roles, r)
+}
+
+// Includes returns true if the role grants the privileges of the provided role.
+func (r Role) Includes(role Role) bool {
+	i := slices.Index(roles, r)
+	return i >= 0 && i >= slices.Index(roles, role)
+}
+
+type Identity struct {
+	Subject string
+	Method  string
+	Role    Role
+}
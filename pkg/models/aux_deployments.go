@@ -51,6 +51,7 @@ type AuxiliaryDeploymentVolumeMount struct {
 
 type AuxiliaryDeploymentParent struct {
 	Id                   string
+	ModuleId             string
 	Enabled              bool
 	AuxiliaryDeployments map[string]AuxiliaryDeployment
 }
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const BackupVersion = 2
+
+// Backup holds the database state and repository source definitions of a module manager instance. Module and
+// deployment files are stored alongside in the backup archive.
+type Backup struct {
+	Version      int                    `json:"version"`
+	Created      time.Time              `json:"created"`
+	Tables       []DatabaseTable        `json:"tables"`
+	Repositories []RepositoryDefinition `json:"repositories"`
+}
+
+// DatabaseTable contains the rows of a table. Row values are ordered as defined by columns.
+type DatabaseTable struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+}
+
+type RepositoryDefinition struct {
+	Type   string          `json:"type"`
+	Source string          `json:"source"`
+	Data   json.RawMessage `json:"data"`
+}
@@ -20,6 +20,7 @@ const (
 	EnvVariableDeploymentId    = "MGW_DID"
 	EnvVariableAuxDeploymentId = "MGW_AID"
 	EnvVariableCoreId          = "MGW_CID"
+	EnvVariableDeploymentToken = "MGW_DTK" // token for the restricted API, rotated if containers are recreated
 )
 
 const (
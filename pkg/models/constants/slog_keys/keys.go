@@ -51,6 +51,9 @@ const (
 	Volumes            = "volumes"
 	ManagerId          = "manager_id"
 	CoreId             = "core_id"
+	EventType          = "event_type"
+	Restarts           = "restarts"
+	SnapshotId         = "snapshot_id"
 	Error              = attributes.ErrorKey
 	Method             = attributes.MethodKey
 	Path               = attributes.PathKey
@@ -17,6 +17,7 @@
 package models
 
 import (
+	"io/fs"
 	"time"
 
 	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
@@ -25,21 +26,29 @@ import (
 
 type Deployment struct {
 	DeploymentBase
-	Containers    map[string]DeploymentContainer
-	Volumes       map[string]DeploymentVolume
-	HostResources map[string]DeploymentHostResource
-	Secrets       map[string]DeploymentSecret
-	Configs       map[string]DeploymentUserConfig
-	GlobalConfigs map[string]DeploymentGlobalConfig
-	Files         map[string]DeploymentFile
-	FileGroups    map[string]DeploymentFileGroup
-	State         int // health state determined by container states
+	Containers        map[string]DeploymentContainer
+	Volumes           map[string]DeploymentVolume
+	HostResources     map[string]DeploymentHostResource
+	Secrets           map[string]DeploymentSecret
+	Configs           map[string]DeploymentUserConfig
+	GlobalConfigs     map[string]DeploymentGlobalConfig
+	Files             map[string]DeploymentFile
+	FileGroups        map[string]DeploymentFileGroup
+	DependencyTargets map[string]DeploymentDependencyTarget
+	State             int // health state determined by container states
+	LastStartError    *DeploymentStartError
 }
 
 type DeploymentReduced struct {
 	DeploymentBase
-	Containers map[string]DeploymentContainer
-	State      lib_constants.DeploymentState // health state determined by container states
+	Containers     map[string]DeploymentContainer
+	State          lib_constants.DeploymentState // health state determined by container states
+	LastStartError *DeploymentStartError
+}
+
+type DeploymentStartError struct {
+	Err  error
+	Time time.Time
 }
 
 type DeploymentContainer struct {
@@ -57,6 +66,7 @@ type DeploymentsFilterWithState struct {
 type DeploymentBase struct {
 	Id            string
 	ModuleId      string
+	Name          string // instance name, empty for the primary deployment of a module
 	ModuleSource  string
 	ModuleChannel string
 	ModuleVersion string
@@ -129,6 +139,7 @@ type DeploymentFileGroupFile struct {
 type DeploymentsFilter struct {
 	Ids       []string
 	ModuleIds []string
+	Names     []string
 	Enabled   int
 }
 
@@ -144,22 +155,56 @@ type DeploymentsSecretsFilter struct {
 	AsEnv         int
 }
 
+// DeploymentDependencyTarget defines the deployment of a required module used by a deployment. Dependencies without
+// a target resolve to the primary deployment of the required module.
+type DeploymentDependencyTarget struct {
+	DeploymentId string
+	ModuleId     string
+	TargetId     string
+}
+
+type DeploymentDependencyTargetsFilter struct {
+	DeploymentIds []string
+	TargetIds     []string
+}
+
 type DeploymentGlobalConfigsFilter struct {
 	Ids           []string
 	DeploymentIds []string
 }
 
+type DeploymentSnapshot struct {
+	Id               string
+	DeploymentId     string
+	ModuleId         string
+	ModuleSource     string
+	ModuleChannel    string
+	ModuleVersion    string
+	UserInput        DeploymentUserInput
+	Containers       []DeploymentContainerBase
+	Created          time.Time
+	ModuleFileSystem fs.FS
+}
+
 type DeploymentUserInput struct {
-	ModuleId      string
-	HostResources map[string]string                                  // {ref:resourceID}
-	Secrets       map[string]string                                  // {ref:secretID}
-	Configs       map[string]Value                                   // {ref:Config}
-	GlobalConfigs map[string]string                                  // {ref:configID}
-	Files         map[string][]byte                                  // {ref:data}
-	FileGroups    map[string]map[string]DeploymentFileGroupUserInput // {ref:{path:FileGroupUserInput}}
+	ModuleId          string
+	HostResources     map[string]string                                  // {ref:resourceID}
+	Secrets           map[string]string                                  // {ref:secretID}
+	Configs           map[string]Value                                   // {ref:Config}
+	GlobalConfigs     map[string]string                                  // {ref:configID}
+	Files             map[string][]byte                                  // {ref:data}
+	FileGroups        map[string]map[string]DeploymentFileGroupUserInput // {ref:{path:FileGroupUserInput}}
+	DependencyTargets map[string]string                                  // {moduleID:deploymentID}
 }
 
 type DeploymentFileGroupUserInput struct {
 	Format string
 	Data   []byte
 }
+
+// DeploymentCredential stores the SHA-256 hash of the token a deployment uses to access the restricted API.
+type DeploymentCredential struct {
+	DeploymentId string
+	TokenHash    string
+	Created      time.Time
+}
@@ -49,6 +49,8 @@ const (
 	ModuleLibStringType  = module_lib.StringType
 )
 
+const ModuleLibMultipleDeployment = module_lib.MultipleDeployment
+
 type CewContainer = cew_model.Container
 type CewContainersFilter = cew_model.ContainerFilter
 type CewVolume = cew_model.Volume
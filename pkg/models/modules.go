@@ -58,3 +58,10 @@ type ModulesFilter struct {
 	Source  string
 	Channel string
 }
+
+// ModuleVersionConstraint restricts the versions an installed module is updated to by update all change requests.
+type ModuleVersionConstraint struct {
+	ModuleId   string
+	Constraint string
+	Updated    time.Time
+}
@@ -29,6 +29,14 @@ type RepositoryModule struct {
 	Version string
 }
 
+// RepositoryVerification defines integrity requirements for modules provided by a repository. If trusted keys are
+// set, modules must be signed by one of the keys. If image digests are required, service images must be referenced
+// by digest. TrustedKeys are base64 encoded ed25519 public keys.
+type RepositoryVerification struct {
+	TrustedKeys         []string `json:"trusted_keys"`
+	RequireImageDigests bool     `json:"require_image_digests"`
+}
+
 type RepositoryModulesFilter struct {
 	Ids     []string
 	Name    string
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import "time"
+
+// SchemaMigration contains the SQL statements to upgrade (Up) and downgrade (Down) the database schema to and from
+// Version.
+type SchemaMigration struct {
+	Version int
+	Name    string
+	Up      []byte
+	Down    []byte
+	// DisableForeignKeys suspends foreign key enforcement while the migration is applied, required by SQLite to
+	// rebuild tables referenced by other tables. Violations are checked before the migration is committed.
+	DisableForeignKeys bool
+}
+
+type SchemaMigrationStatus struct {
+	Version  int
+	Name     string
+	Checksum string
+	Applied  time.Time
+	State    string
+}
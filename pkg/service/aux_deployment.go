@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"maps"
-	"slices"
 
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
@@ -86,7 +85,7 @@ func (s *Service) CreateAuxiliaryDeployment(
 	if err != nil {
 		return lib_models.Job{}, err
 	}
-	job, err := s.jobsHandler.CreateJob("create auxiliary deployment")
+	job, err := s.jobsHandler.CreateDeploymentJob(deploymentId, "create auxiliary deployment")
 	if err != nil {
 		return lib_models.Job{}, err
 	}
@@ -117,11 +116,12 @@ func (s *Service) CreateAuxiliaryDeployment(
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
 		}
-		dependencyDeployments, err := s.deploymentsHandler.GetReducedDeploymentsByModuleIds(ctx, pkg_models.DeploymentsFilterWithState{
-			DeploymentsFilter: pkg_models.DeploymentsFilter{
-				ModuleIds: slices.Collect(maps.Keys(module.Dependencies)),
-			},
-		})
+		dependencyDeployments, err := s.getDependencyDeployments(
+			ctx,
+			module,
+			activeDeployment,
+			make(map[string]pkg_models.DeploymentReduced),
+		)
 		if err != nil {
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
@@ -163,7 +163,7 @@ func (s *Service) UpdateAuxiliaryDeployment(
 	if err != nil {
 		return lib_models.Job{}, err
 	}
-	job, err := s.jobsHandler.CreateJob("update auxiliary deployment")
+	job, err := s.jobsHandler.CreateDeploymentJob(deploymentId, "update auxiliary deployment")
 	if err != nil {
 		return lib_models.Job{}, err
 	}
@@ -192,11 +192,12 @@ func (s *Service) UpdateAuxiliaryDeployment(
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
 		}
-		dependencyDeployments, err := s.deploymentsHandler.GetReducedDeploymentsByModuleIds(ctx, pkg_models.DeploymentsFilterWithState{
-			DeploymentsFilter: pkg_models.DeploymentsFilter{
-				ModuleIds: slices.Collect(maps.Keys(module.Dependencies)),
-			},
-		})
+		dependencyDeployments, err := s.getDependencyDeployments(
+			ctx,
+			module,
+			activeDeployment,
+			make(map[string]pkg_models.DeploymentReduced),
+		)
 		if err != nil {
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
@@ -237,7 +238,7 @@ func (s *Service) RecreateAuxiliaryDeployments(
 	if err != nil {
 		return lib_models.Job{}, err
 	}
-	job, err := s.jobsHandler.CreateJob("recreate auxiliary deployments")
+	job, err := s.jobsHandler.CreateDeploymentJob(deploymentId, "recreate auxiliary deployments")
 	if err != nil {
 		return lib_models.Job{}, err
 	}
@@ -267,11 +268,12 @@ func (s *Service) RecreateAuxiliaryDeployments(
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
 		}
-		dependencyDeployments, err := s.deploymentsHandler.GetReducedDeploymentsByModuleIds(ctx, pkg_models.DeploymentsFilterWithState{
-			DeploymentsFilter: pkg_models.DeploymentsFilter{
-				ModuleIds: slices.Collect(maps.Keys(module.Dependencies)),
-			},
-		})
+		dependencyDeployments, err := s.getDependencyDeployments(
+			ctx,
+			module,
+			activeDeployment,
+			make(map[string]pkg_models.DeploymentReduced),
+		)
 		if err != nil {
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
@@ -332,7 +334,7 @@ func (s *Service) DeleteAuxiliaryDeployments(
 	if err != nil {
 		return lib_models.Job{}, err
 	}
-	job, err := s.jobsHandler.CreateJob("delete auxiliary deployments")
+	job, err := s.jobsHandler.CreateDeploymentJob(deploymentId, "delete auxiliary deployments")
 	if err != nil {
 		return lib_models.Job{}, err
 	}
@@ -483,15 +485,41 @@ func (s *Service) recreateAuxDeployments(
 	module pkg_models.Module,
 	deploymentId string,
 	cacheDependencyDeployments map[string]pkg_models.DeploymentReduced,
+	filter lib_models.AuxiliaryDeploymentsFilter,
 ) ([]lib_models.AuxiliaryDeploymentBatchResult, error) {
 	activeDeployment, err := s.deploymentsHandler.GetDeployment(ctx, deploymentId)
 	if err != nil {
 		return nil, err
 	}
+	dependencyDeployments, err := s.getDependencyDeployments(ctx, module, activeDeployment, cacheDependencyDeployments)
+	if err != nil {
+		return nil, err
+	}
+	return s.auxDeploymentsHandler.RecreateDeployments(
+		ctx,
+		module,
+		activeDeployment,
+		dependencyDeployments,
+		lib_models.AuxiliaryDeploymentsFilterWithState{
+			AuxiliaryDeploymentsFilter: filter,
+		},
+	)
+}
+
+// getDependencyDeployments returns the deployments required by a deployment keyed by module ID. Primary deployments are
+// read into the provided cache, selected dependency targets replace the primary deployments in the returned map.
+func (s *Service) getDependencyDeployments(
+	ctx context.Context,
+	module pkg_models.Module,
+	deployment pkg_models.Deployment,
+	cacheDependencyDeployments map[string]pkg_models.DeploymentReduced,
+) (map[string]pkg_models.DeploymentReduced, error) {
 	var idsNotInCache []string
 	for id := range module.Dependencies {
-		_, ok := cacheDependencyDeployments[id]
-		if !ok {
+		if _, ok := deployment.DependencyTargets[id]; ok {
+			continue
+		}
+		if _, ok := cacheDependencyDeployments[id]; !ok {
 			idsNotInCache = append(idsNotInCache, id)
 		}
 	}
@@ -506,15 +534,26 @@ func (s *Service) recreateAuxDeployments(
 		}
 		maps.Copy(cacheDependencyDeployments, dependencyDeployments)
 	}
-	return s.auxDeploymentsHandler.RecreateDeployments(
-		ctx,
-		module,
-		activeDeployment,
-		cacheDependencyDeployments,
-		lib_models.AuxiliaryDeploymentsFilterWithState{
-			AuxiliaryDeploymentsFilter: lib_models.AuxiliaryDeploymentsFilter{
-				Recreate: 1,
-			},
+	if len(deployment.DependencyTargets) == 0 {
+		return cacheDependencyDeployments, nil
+	}
+	var targetIds []string
+	for _, target := range deployment.DependencyTargets {
+		targetIds = append(targetIds, target.TargetId)
+	}
+	targetDeployments, err := s.deploymentsHandler.GetReducedDeployments(ctx, pkg_models.DeploymentsFilterWithState{
+		DeploymentsFilter: pkg_models.DeploymentsFilter{
+			Ids: targetIds,
 		},
-	)
+	})
+	if err != nil {
+		return nil, err
+	}
+	dependencyDeployments := maps.Clone(cacheDependencyDeployments)
+	for moduleId, target := range deployment.DependencyTargets {
+		if targetDeployment, ok := targetDeployments[target.TargetId]; ok {
+			dependencyDeployments[moduleId] = targetDeployment
+		}
+	}
+	return dependencyDeployments, nil
 }
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	handler_jobs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/jobs"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// ExportBackup writes a backup archive to w. Changes are blocked while the backup is created, the archive is written
+// afterward.
+func (s *Service) ExportBackup(ctx context.Context, w io.Writer) error {
+	backup, err := s.exportBackup(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := backup.Close(); err != nil {
+			logger.ErrorContext(ctx, "export backup, remove file", slog_keys.Error, err)
+		}
+	}()
+	_, err = io.Copy(w, backup)
+	return err
+}
+
+func (s *Service) exportBackup(ctx context.Context) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{deploymentJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		return nil, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	return s.backupHandler.Export(ctx)
+}
+
+// RestoreBackup stages the backup archive read from r and starts a job that restores the backup and recreates all
+// deployments and auxiliary deployments. A backup can only be restored if no modules are installed. The archive is
+// staged before changes are blocked.
+func (s *Service) RestoreBackup(ctx context.Context, r io.Reader) (lib_models.Job, error) {
+	stageId, err := s.backupHandler.Stage(ctx, r)
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, err := s.createRestoreBackupJob(ctx)
+	if err != nil {
+		_ = s.backupHandler.Discard(ctx, stageId)
+		return lib_models.Job{}, err
+	}
+	go func() {
+		jobResult := lib_models.BackupRestoreJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"restore backup",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setRestoreBackupJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		repoResults, err := s.backupHandler.Restore(job.Context(), stageId)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		jobResult.Repositories = repoResults
+		for _, repoResult := range repoResults {
+			if repoResult.HasError {
+				jobResult.RepositoriesErrNum++
+			}
+		}
+		handlerModules, err := s.modulesHandler.GetModules(ctx, pkg_models.ModulesFilterWithName{}, false)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		if len(handlerModules) == 0 {
+			return
+		}
+		recreateDepResults, err := s.deploymentsHandler.RecreateDeployments(job.Context(), handlerModules, nil)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		for _, recreateDepResult := range recreateDepResults {
+			if recreateDepResult.HasError {
+				jobResult.DeploymentsErrNum++
+			}
+		}
+		jobResult.Deployments = s.recreateUpdatedAuxDeployments(
+			ctx,
+			recreateDepResults,
+			handlerModules,
+			lib_models.AuxiliaryDeploymentsFilter{},
+		)
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+func (s *Service) createRestoreBackupJob(ctx context.Context) (*handler_jobs.Job, error) {
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{deploymentJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		return nil, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	modules, err := s.modulesHandler.GetModules(ctx, pkg_models.ModulesFilterWithName{}, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(modules) > 0 {
+		return nil, lib_errors.New[lib_errors.ErrExists]("modules installed")
+	}
+	return s.jobsHandler.CreateSlotJob(moduleJobSlotNum, "restore backup")
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+// ImportBundle stages the offline bundle read from r and starts a job that loads the included images and stores the
+// included modules in the channel of the repository for module packages. Afterward, the modules can be installed
+// without access to a registry.
+func (s *Service) ImportBundle(ctx context.Context, channel string, r io.Reader) (lib_models.Job, error) {
+	stageId, err := s.bundlesHandler.Stage(ctx, r)
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{repositoryJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		_ = s.bundlesHandler.Discard(ctx, stageId)
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	job, err := s.jobsHandler.CreateSlotJob(repositoryJobSlotNum, "import bundle")
+	if err != nil {
+		_ = s.bundlesHandler.Discard(ctx, stageId)
+		return lib_models.Job{}, err
+	}
+	s.changeRequest = nil
+	go func() {
+		jobResult := lib_models.BundleImportJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"import bundle",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setImportBundleJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		imageResults, moduleResults, err := s.bundlesHandler.Import(job.Context(), stageId, channel)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		jobResult.Images = imageResults
+		for _, imageResult := range imageResults {
+			if imageResult.HasError {
+				jobResult.ImagesErrNum++
+			}
+		}
+		jobResult.Modules = moduleResults
+		for _, moduleResult := range moduleResults {
+			if moduleResult.HasError {
+				jobResult.ModulesErrNum++
+			}
+		}
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
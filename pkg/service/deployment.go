@@ -17,12 +17,14 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"maps"
 	"slices"
+	"strings"
 
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
@@ -204,32 +206,7 @@ func (s *Service) UpdateDeployments(ctx context.Context, userInputs []lib_models
 				jobResult.ResultsErrNum++
 			}
 		}
-		cacheDependencyDeployments := make(map[string]pkg_models.DeploymentReduced)
-		for _, updateDepResult := range updateDepResults {
-			result := lib_models.DeploymentUpdateResult{DeploymentResult: updateDepResult}
-			if !updateDepResult.HasError {
-				module, ok := handlerModules[updateDepResult.ModuleId]
-				if ok {
-					result.AuxiliaryDeployments.Results, err = s.recreateAuxDeployments(
-						ctx,
-						module,
-						updateDepResult.Id,
-						cacheDependencyDeployments,
-					)
-					if err != nil {
-						result.AuxiliaryDeployments.ErrorResult = lib_models.NewErrorResult(err.Error())
-					}
-					for _, res := range result.AuxiliaryDeployments.Results {
-						if res.HasError {
-							result.AuxiliaryDeployments.ResultsErrNum++
-						}
-					}
-				} else {
-					result.AuxiliaryDeployments.ErrorResult = lib_models.NewErrorResult("missing module")
-				}
-			}
-			jobResult.Results = append(jobResult.Results, result)
-		}
+		jobResult.Results = s.recreateUpdatedAuxDeployments(ctx, updateDepResults, handlerModules, lib_models.AuxiliaryDeploymentsFilter{Recreate: 1})
 	}()
 	return lib_models.Job{
 		Id:          job.Id,
@@ -282,7 +259,7 @@ func (s *Service) RecreateDeployments(ctx context.Context, moduleIds []string) (
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 			return
 		}
-		jobResult.Results, err = s.deploymentsHandler.RecreateDeployments(job.Context(), handlerModules)
+		jobResult.Results, err = s.deploymentsHandler.RecreateDeployments(job.Context(), handlerModules, nil)
 		if err != nil {
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 		}
@@ -336,68 +313,11 @@ func (s *Service) DeleteDeployments(ctx context.Context, moduleIds []string, all
 			logJobDone(ctx, job)
 		}()
 		logJobStart(ctx, job)
-		deploymentIds, err := s.deploymentsHandler.GetDeploymentIds(ctx, pkg_models.DeploymentsFilter{
-			ModuleIds: moduleIds,
-		})
-		if err != nil {
-			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
-			return
-		}
-		auxResults := make(map[string]lib_models.AuxiliaryDeploymentDeleteResult)
-		var toDelete []string
-		for id := range deploymentIds {
-			var auxResult lib_models.AuxiliaryDeploymentDeleteResult
-			auxResult.Results, auxResult.VolumeResults, err = s.deleteAuxDeployments(ctx, id)
-			if err != nil {
-				auxResult.ErrorResult = lib_models.NewErrorResult(err.Error())
-			}
-			for _, res := range auxResult.Results {
-				if res.HasError {
-					auxResult.ResultsErrNum++
-				}
-			}
-			for _, res := range auxResult.VolumeResults {
-				if res.HasError {
-					auxResult.VolumeResultsErrNum++
-				}
-			}
-			auxResults[id] = auxResult
-			if !auxResult.HasError && auxResult.ResultsErrNum+auxResult.VolumeResultsErrNum == 0 {
-				toDelete = append(toDelete, id)
-			}
-		}
-		deleteResults, err := s.deploymentsHandler.DeleteDeployments(
-			ctx,
-			pkg_models.DeploymentsFilterWithState{
-				DeploymentsFilter: pkg_models.DeploymentsFilter{
-					Ids: toDelete,
-				},
-			},
-			false,
-		)
+		var err error
+		jobResult.Results, err = s.deleteDeployments(ctx, pkg_models.DeploymentsFilter{ModuleIds: moduleIds})
 		if err != nil {
 			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
 		}
-		deleteResultsMap := maps.Collect(helper_slices.AllFunc(deleteResults, func(item lib_models.DeploymentResult) string {
-			return item.Id
-		}))
-		for id, moduleId := range deploymentIds {
-			var errResult lib_models.ErrorResult
-			deleteResult, ok := deleteResultsMap[id]
-			if !ok {
-				errResult = lib_models.NewErrorResult("not deleted")
-			} else {
-				errResult = deleteResult.ErrorResult
-			}
-			jobResult.Results = append(jobResult.Results, lib_models.DeploymentDeleteResult{
-				DeploymentResult: lib_models.DeploymentResult{
-					ModuleId:    moduleId,
-					Id:          id,
-					ErrorResult: errResult,
-				},
-				AuxiliaryDeployments: auxResults[id],
-			})
-		}
 		for _, res := range jobResult.Results {
 			if res.HasError {
 				jobResult.ResultsErrNum++
@@ -411,6 +331,72 @@ func (s *Service) DeleteDeployments(ctx context.Context, moduleIds []string, all
 	}, nil
 }
 
+// deleteDeployments removes the auxiliary deployments of the deployments matching the filter before the deployments
+// are deleted. Deployments whose auxiliary deployments could not be removed are skipped.
+func (s *Service) deleteDeployments(
+	ctx context.Context,
+	filter pkg_models.DeploymentsFilter,
+) ([]lib_models.DeploymentDeleteResult, error) {
+	deploymentIds, err := s.deploymentsHandler.GetDeploymentIds(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	auxResults := make(map[string]lib_models.AuxiliaryDeploymentDeleteResult)
+	var toDelete []string
+	for id := range deploymentIds {
+		var auxResult lib_models.AuxiliaryDeploymentDeleteResult
+		auxResult.Results, auxResult.VolumeResults, err = s.deleteAuxDeployments(ctx, id)
+		if err != nil {
+			auxResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		for _, res := range auxResult.Results {
+			if res.HasError {
+				auxResult.ResultsErrNum++
+			}
+		}
+		for _, res := range auxResult.VolumeResults {
+			if res.HasError {
+				auxResult.VolumeResultsErrNum++
+			}
+		}
+		auxResults[id] = auxResult
+		if !auxResult.HasError && auxResult.ResultsErrNum+auxResult.VolumeResultsErrNum == 0 {
+			toDelete = append(toDelete, id)
+		}
+	}
+	deleteResults, deleteErr := s.deploymentsHandler.DeleteDeployments(
+		ctx,
+		pkg_models.DeploymentsFilterWithState{
+			DeploymentsFilter: pkg_models.DeploymentsFilter{
+				Ids: toDelete,
+			},
+		},
+		false,
+	)
+	var results []lib_models.DeploymentDeleteResult
+	deleteResultsMap := maps.Collect(helper_slices.AllFunc(deleteResults, func(item lib_models.DeploymentResult) string {
+		return item.Id
+	}))
+	for id, moduleId := range deploymentIds {
+		var errResult lib_models.ErrorResult
+		deleteResult, ok := deleteResultsMap[id]
+		if !ok {
+			errResult = lib_models.NewErrorResult("not deleted")
+		} else {
+			errResult = deleteResult.ErrorResult
+		}
+		results = append(results, lib_models.DeploymentDeleteResult{
+			DeploymentResult: lib_models.DeploymentResult{
+				ModuleId:    moduleId,
+				Id:          id,
+				ErrorResult: errResult,
+			},
+			AuxiliaryDeployments: auxResults[id],
+		})
+	}
+	return results, deleteErr
+}
+
 func (s *Service) EnableDeployments(ctx context.Context, moduleIds []string) ([]string, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -443,6 +429,301 @@ func (s *Service) DisableDeployments(ctx context.Context, moduleIds []string) ([
 	return s.deploymentsHandler.DisableDeployments(ctx, moduleIds)
 }
 
+func (s *Service) GetDeployment(ctx context.Context, id string) (lib_models.Deployment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	handlerDeployment, err := s.deploymentsHandler.GetDeployment(ctx, id)
+	if err != nil {
+		return lib_models.Deployment{}, err
+	}
+	return getDeployment(handlerDeployment), nil
+}
+
+// GetDeployments returns the primary deployments and instances ordered by module ID and instance name.
+func (s *Service) GetDeployments(ctx context.Context, filter lib_models.DeploymentsFilter) ([]lib_models.DeploymentReduced, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	handlerDeployments, err := s.deploymentsHandler.GetReducedDeployments(ctx, pkg_models.DeploymentsFilterWithState{
+		DeploymentsFilter: pkg_models.DeploymentsFilter{
+			ModuleIds: filter.ModuleIds,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var deployments []lib_models.DeploymentReduced
+	for _, handlerDeployment := range handlerDeployments {
+		deployments = append(deployments, getDeploymentReduced(handlerDeployment))
+	}
+	slices.SortFunc(deployments, func(a, b lib_models.DeploymentReduced) int {
+		return cmp.Or(strings.Compare(a.ModuleId, b.ModuleId), strings.Compare(a.Name, b.Name))
+	})
+	return deployments, nil
+}
+
+func (s *Service) CreateDeploymentInstance(ctx context.Context, userInput lib_models.DeploymentInstanceUserInput) (lib_models.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{deploymentJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	if userInput.Name == "" {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrInvalidInput]("missing instance name")
+	}
+	job, err := s.jobsHandler.CreateSlotJob(deploymentJobSlotNum, "create deployment instance")
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	go func() {
+		jobResult := lib_models.DeploymentJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"create deployment instance",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setDeploymentsJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		handlerModules, err := s.modulesHandler.GetModules(
+			ctx,
+			pkg_models.ModulesFilterWithName{
+				ModulesFilter: pkg_models.ModulesFilter{
+					Ids: []string{userInput.ModuleId},
+				},
+			},
+			false,
+		)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		module, ok := handlerModules[userInput.ModuleId]
+		if !ok {
+			jobResult.ErrorResult = lib_models.NewErrorResult("module not found")
+			return
+		}
+		userInputMap, err := getUserInputs([]lib_models.DeploymentUserInput{userInput.DeploymentUserInput}, handlerModules)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		result, err := s.deploymentsHandler.CreateDeploymentInstance(
+			job.Context(),
+			module,
+			userInput.Name,
+			userInputMap[userInput.ModuleId],
+		)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		jobResult.Results = append(jobResult.Results, result)
+		if result.HasError {
+			jobResult.ResultsErrNum++
+		}
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+// UpdateDeployment updates a single deployment, the module ID of the user input is ignored.
+func (s *Service) UpdateDeployment(ctx context.Context, id string, userInput lib_models.DeploymentUserInput) (lib_models.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{deploymentJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	deploymentIds, err := s.deploymentsHandler.GetDeploymentIds(ctx, pkg_models.DeploymentsFilter{Ids: []string{id}})
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	moduleId, ok := deploymentIds[id]
+	if !ok {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrNotFound]("deployment not found")
+	}
+	userInput.ModuleId = moduleId
+	job, err := s.jobsHandler.CreateSlotJob(deploymentJobSlotNum, "update deployment")
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	go func() {
+		jobResult := lib_models.DeploymentUpdateJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"update deployment",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setUpdateDeploymentsJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		handlerModules, err := s.modulesHandler.GetModules(
+			ctx,
+			pkg_models.ModulesFilterWithName{
+				ModulesFilter: pkg_models.ModulesFilter{
+					Ids: []string{moduleId},
+				},
+			},
+			false,
+		)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		module, ok := handlerModules[moduleId]
+		if !ok {
+			jobResult.ErrorResult = lib_models.NewErrorResult("module not found")
+			return
+		}
+		userInputMap, err := getUserInputs([]lib_models.DeploymentUserInput{userInput}, handlerModules)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		updateDepResult, err := s.deploymentsHandler.UpdateDeployment(job.Context(), module, id, userInputMap[moduleId])
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		if updateDepResult.HasError {
+			jobResult.ResultsErrNum++
+		}
+		jobResult.Results = s.recreateUpdatedAuxDeployments(
+			ctx,
+			[]lib_models.DeploymentResult{updateDepResult},
+			handlerModules,
+			lib_models.AuxiliaryDeploymentsFilter{Recreate: 1},
+		)
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+func (s *Service) DeleteDeployment(ctx context.Context, id string) (lib_models.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(deploymentJobSlotNum)
+	if ok {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	err := s.deploymentsHandler.CheckDeployment(ctx, id)
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	job, err := s.jobsHandler.CreateSlotJob(deploymentJobSlotNum, "delete deployment")
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	go func() {
+		jobResult := lib_models.DeploymentDeleteJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"delete deployment",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setDeleteDeploymentsJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		var err error
+		jobResult.Results, err = s.deleteDeployments(ctx, pkg_models.DeploymentsFilter{Ids: []string{id}})
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		for _, res := range jobResult.Results {
+			if res.HasError {
+				jobResult.ResultsErrNum++
+			}
+		}
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+func (s *Service) EnableDeployment(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(deploymentJobSlotNum)
+	if ok {
+		return lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	return s.deploymentsHandler.EnableDeployment(ctx, id)
+}
+
+func (s *Service) DisableDeployment(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(deploymentJobSlotNum)
+	if ok {
+		return lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	return s.deploymentsHandler.DisableDeployment(ctx, id)
+}
+
+// AuthenticateDeployment returns the ID of the deployment the token belongs to. Not guarded by the service lock, as
+// deployments must be able to authenticate while jobs are running.
+func (s *Service) AuthenticateDeployment(ctx context.Context, token string) (string, error) {
+	return s.deploymentsHandler.AuthenticateDeployment(ctx, token)
+}
+
+// RecreateDeploymentsWithoutCredentials starts a job that recreates the deployments of modules with deployments
+// lacking a credential, e.g. deployments created before credentials were introduced. Containers of these deployments
+// have no token and can't access the restricted API until recreated.
+func (s *Service) RecreateDeploymentsWithoutCredentials(ctx context.Context) error {
+	moduleIds, err := s.deploymentsHandler.GetModulesWithoutCredentials(ctx)
+	if err != nil {
+		return err
+	}
+	if len(moduleIds) == 0 {
+		return nil
+	}
+	job, err := s.RecreateDeployments(ctx, moduleIds)
+	if err != nil {
+		return err
+	}
+	logger.InfoContext(ctx, "recreate deployments without credentials", slog_keys.ModuleIds, moduleIds, slog_keys.JobId, job.Id)
+	return nil
+}
+
 func (s *Service) deleteAuxDeployments(
 	ctx context.Context,
 	deploymentId string,
@@ -463,6 +744,46 @@ func (s *Service) deleteAuxDeployments(
 	return results, volResults, nil
 }
 
+// recreateUpdatedAuxDeployments recreates the auxiliary deployments matching the filter of all successfully updated
+// deployments.
+func (s *Service) recreateUpdatedAuxDeployments(
+	ctx context.Context,
+	updateDepResults []lib_models.DeploymentResult,
+	handlerModules map[string]pkg_models.Module,
+	filter lib_models.AuxiliaryDeploymentsFilter,
+) []lib_models.DeploymentUpdateResult {
+	var results []lib_models.DeploymentUpdateResult
+	cacheDependencyDeployments := make(map[string]pkg_models.DeploymentReduced)
+	for _, updateDepResult := range updateDepResults {
+		result := lib_models.DeploymentUpdateResult{DeploymentResult: updateDepResult}
+		if !updateDepResult.HasError {
+			module, ok := handlerModules[updateDepResult.ModuleId]
+			if ok {
+				var err error
+				result.AuxiliaryDeployments.Results, err = s.recreateAuxDeployments(
+					ctx,
+					module,
+					updateDepResult.Id,
+					cacheDependencyDeployments,
+					filter,
+				)
+				if err != nil {
+					result.AuxiliaryDeployments.ErrorResult = lib_models.NewErrorResult(err.Error())
+				}
+				for _, res := range result.AuxiliaryDeployments.Results {
+					if res.HasError {
+						result.AuxiliaryDeployments.ResultsErrNum++
+					}
+				}
+			} else {
+				result.AuxiliaryDeployments.ErrorResult = lib_models.NewErrorResult("missing module")
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
 func getUserInputs(
 	userInputs []lib_models.DeploymentUserInput,
 	handlerModules map[string]pkg_models.Module,
@@ -524,13 +845,14 @@ func getUserInputs(
 			fileGroups[reference] = depItems
 		}
 		userInputsMap[userInput.ModuleId] = pkg_models.DeploymentUserInput{
-			ModuleId:      userInput.ModuleId,
-			HostResources: userInput.HostResources,
-			Secrets:       userInput.Secrets,
-			Configs:       configs,
-			GlobalConfigs: userInput.GlobalConfigs,
-			Files:         files,
-			FileGroups:    fileGroups,
+			ModuleId:          userInput.ModuleId,
+			HostResources:     userInput.HostResources,
+			Secrets:           userInput.Secrets,
+			Configs:           configs,
+			GlobalConfigs:     userInput.GlobalConfigs,
+			Files:             files,
+			FileGroups:        fileGroups,
+			DependencyTargets: userInput.DependencyTargets,
 		}
 	}
 	if len(errs) > 0 {
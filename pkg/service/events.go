@@ -0,0 +1,29 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+)
+
+// SubscribeEvents returns a channel providing events matching the filter and a function that must be called to end
+// the subscription.
+func (s *Service) SubscribeEvents(_ context.Context, filter lib_models.EventsFilter) (<-chan lib_models.Event, func()) {
+	return s.eventsHandler.Subscribe(filter)
+}
@@ -18,10 +18,15 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_configs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/configs"
+	helper_slices "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/slices"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 )
 
 func (s *Service) CreateGlobalConfig(ctx context.Context, input lib_models.GlobalConfigInput) (string, error) {
@@ -41,7 +46,12 @@ func (s *Service) GetGlobalConfig(ctx context.Context, id string) (lib_models.Gl
 	if err != nil {
 		return lib_models.GlobalConfig{}, err
 	}
-	return newGlobalConfig(config), nil
+	globalConfig := newGlobalConfig(config)
+	globalConfig.UsedBy, err = s.getGlobalConfigReferences(ctx, id)
+	if err != nil {
+		return lib_models.GlobalConfig{}, err
+	}
+	return globalConfig, nil
 }
 
 func (s *Service) GetGlobalConfigs(ctx context.Context, ids []string) (map[string]lib_models.GlobalConfig, error) {
@@ -72,16 +82,125 @@ func (s *Service) UpdateGlobalConfig(ctx context.Context, config lib_models.Glob
 	})
 }
 
-func (s *Service) DeleteGlobalConfig(ctx context.Context, id string) error {
+func (s *Service) DeleteGlobalConfig(ctx context.Context, id string, force bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.globalConfigsHandler.DeleteGlobalConfig(ctx, id, force)
+}
+
+func (s *Service) DeleteGlobalConfigs(ctx context.Context, ids []string, allowAll, force bool) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.globalConfigsHandler.DeleteGlobalConfig(ctx, id)
+	return s.globalConfigsHandler.DeleteGlobalConfigs(ctx, ids, allowAll, force)
 }
 
-func (s *Service) DeleteGlobalConfigs(ctx context.Context, ids []string, allowAll bool) error {
+// RecreateGlobalConfigDeployments recreates the deployments referencing the global config to apply a changed value.
+// Auxiliary deployments flagged for recreation are recreated as well.
+func (s *Service) RecreateGlobalConfigDeployments(ctx context.Context, id string) (lib_models.Job, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.globalConfigsHandler.DeleteGlobalConfigs(ctx, ids, allowAll)
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{deploymentJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	if _, err := s.globalConfigsHandler.GetGlobalConfig(ctx, id); err != nil {
+		return lib_models.Job{}, err
+	}
+	references, err := s.getGlobalConfigReferences(ctx, id)
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	job, err := s.jobsHandler.CreateSlotJob(deploymentJobSlotNum, "recreate global config deployments")
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	go func() {
+		jobResult := lib_models.DeploymentUpdateJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"recreate global config deployments",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setUpdateDeploymentsJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		if len(references) == 0 {
+			return
+		}
+		handlerModules, err := s.modulesHandler.GetModules(
+			ctx,
+			pkg_models.ModulesFilterWithName{
+				ModulesFilter: pkg_models.ModulesFilter{
+					Ids: helper_slices.CollectFunc(slices.Values(references), func(item lib_models.GlobalConfigReference) string {
+						return item.ModuleId
+					}),
+				},
+			},
+			false,
+		)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			return
+		}
+		recreateDepResults, err := s.deploymentsHandler.RecreateDeployments(
+			job.Context(),
+			handlerModules,
+			helper_slices.CollectFunc(slices.Values(references), func(item lib_models.GlobalConfigReference) string {
+				return item.DeploymentId
+			}),
+		)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		for _, recreateDepResult := range recreateDepResults {
+			if recreateDepResult.HasError {
+				jobResult.ResultsErrNum++
+			}
+		}
+		jobResult.Results = s.recreateUpdatedAuxDeployments(ctx, recreateDepResults, handlerModules, lib_models.AuxiliaryDeploymentsFilter{Recreate: 1})
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+func (s *Service) getGlobalConfigReferences(ctx context.Context, id string) ([]lib_models.GlobalConfigReference, error) {
+	references, err := s.globalConfigsHandler.GetReferences(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(references[id]) == 0 {
+		return nil, nil
+	}
+	deploymentIds, err := s.deploymentsHandler.GetDeploymentIds(ctx, pkg_models.DeploymentsFilter{
+		Ids: helper_slices.CollectFunc(slices.Values(references[id]), func(item pkg_models.DeploymentGlobalConfig) string {
+			return item.DeploymentId
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var globalConfigReferences []lib_models.GlobalConfigReference
+	for _, reference := range references[id] {
+		globalConfigReferences = append(globalConfigReferences, lib_models.GlobalConfigReference{
+			DeploymentId: reference.DeploymentId,
+			ModuleId:     deploymentIds[reference.DeploymentId],
+			Reference:    reference.Reference,
+		})
+	}
+	return globalConfigReferences, nil
 }
 
 func newGlobalConfig(config pkg_models.Config) lib_models.GlobalConfig {
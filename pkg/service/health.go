@@ -43,7 +43,8 @@ func (s *Service) DeploymentsHealth(ctx context.Context, filter lib_models.Deplo
 			moduleIds = append(moduleIds, id)
 		}
 	}
-	deployments, err := s.deploymentsHandler.GetReducedDeploymentsByModuleIds(ctx, pkg_models.DeploymentsFilterWithState{
+	// deployments are keyed by deployment ID, as a module can have multiple deployment instances
+	deployments, err := s.deploymentsHandler.GetReducedDeployments(ctx, pkg_models.DeploymentsFilterWithState{
 		DeploymentsFilter: pkg_models.DeploymentsFilter{
 			ModuleIds: moduleIds,
 			Enabled:   1,
@@ -52,13 +53,16 @@ func (s *Service) DeploymentsHealth(ctx context.Context, filter lib_models.Deplo
 	if err != nil {
 		return lib_models.DeploymentsHealthInfo{}, err
 	}
-	for _, id := range filter.ExclModuleIds {
-		delete(deployments, id)
+	for id, deployment := range deployments {
+		if slices.Contains(filter.ExclModuleIds, deployment.ModuleId) {
+			delete(deployments, id)
+		}
 	}
 	lenFilterAuxDepsOfIds := len(filter.AuxDeploymentsOfIds)
 	lenFilterExclAuxDepsOfIds := len(filter.ExclAuxDeploymentsOfIds)
 	auxDeployments := make(map[string]map[string]lib_models.AuxiliaryDeploymentReduced)
-	for moduleId, deployment := range deployments {
+	for id, deployment := range deployments {
+		moduleId := deployment.ModuleId
 		if lenFilterAuxDepsOfIds > 0 && !slices.Contains(filter.AuxDeploymentsOfIds, moduleId) || lenFilterExclAuxDepsOfIds > 0 && slices.Contains(filter.ExclAuxDeploymentsOfIds, moduleId) {
 			continue
 		}
@@ -70,11 +74,19 @@ func (s *Service) DeploymentsHealth(ctx context.Context, filter lib_models.Deplo
 		if err != nil {
 			return lib_models.DeploymentsHealthInfo{}, err
 		}
-		auxDeployments[moduleId] = auxDeps
+		auxDeployments[id] = auxDeps
 	}
 	return getDeploymentsHealthInfo(deployments, auxDeployments, filter.IncludeHealthy), nil
 }
 
+func (s *Service) GetDeploymentRuntimeEvents(
+	ctx context.Context,
+	deploymentId string,
+	filter lib_models.DeploymentRuntimeEventsFilter,
+) ([]lib_models.DeploymentRuntimeEvent, error) {
+	return s.deploymentsHandler.GetRuntimeEvents(ctx, deploymentId, filter)
+}
+
 func getDeploymentsHealthInfo(
 	deployments map[string]pkg_models.DeploymentReduced,
 	auxDeployments map[string]map[string]lib_models.AuxiliaryDeploymentReduced,
@@ -115,11 +127,14 @@ func getDeploymentsHealthInfo(
 		}
 		depHealth := lib_models.DeploymentHealthInfo{
 			ModuleId:                         deployment.ModuleId,
+			DeploymentId:                     deployment.Id,
+			Name:                             deployment.Name,
 			State:                            deployment.State,
 			TotalContainers:                  len(deployment.Containers),
 			AuxiliaryDeployments:             auxDepsHealthInfo,
 			TotalEnabledAuxiliaryDeployments: len(auxDeps),
 			AuxiliaryDeploymentsState:        auxDepsState,
+			LastStartError:                   getDeploymentStartError(deployment.LastStartError),
 		}
 		for _, container := range deployment.Containers {
 			if !includeHealthy && containerOk(container.State, container.Health) {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 	"io/fs"
 
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
@@ -16,6 +17,11 @@ type repositoriesHandler interface {
 	GetModule(ctx context.Context, id, source, channel string) (pkg_models.RepositoryModule, error)
 	GetModules(ctx context.Context, filter pkg_models.RepositoryModulesFilter) ([]pkg_models.RepositoryModule, error)
 	GetModuleFS(ctx context.Context, id, source, channel string) (fs.FS, error)
+	VerifyModuleFS(ctx context.Context, source string, fSys fs.FS) error
+	StageModulePackage(ctx context.Context, r io.Reader) (string, error)
+	AddModulePackage(ctx context.Context, channel, stageId string) (pkg_models.RepositoryModule, error)
+	DiscardModulePackage(ctx context.Context, stageId string) error
+	DeleteModulePackage(ctx context.Context, channel, moduleId string) error
 }
 
 type modulesHandler interface {
@@ -24,10 +30,19 @@ type modulesHandler interface {
 	AddModule(ctx context.Context, id, source, channel string, fSys fs.FS) error
 	UpdateModule(ctx context.Context, id, source, channel string, fSys fs.FS) error
 	DeleteModule(ctx context.Context, id string) error
+	GetVersionConstraints(ctx context.Context) (map[string]string, error)
+	SetVersionConstraint(ctx context.Context, id, constraint string) error
+	DeleteVersionConstraint(ctx context.Context, id string) error
 }
 
 type deploymentsHandler interface {
+	AuthenticateDeployment(ctx context.Context, token string) (string, error)
+	GetModulesWithoutCredentials(ctx context.Context) ([]string, error)
 	GetDeployment(ctx context.Context, id string) (pkg_models.Deployment, error)
+	GetReducedDeployments(
+		ctx context.Context,
+		filter pkg_models.DeploymentsFilterWithState,
+	) (map[string]pkg_models.DeploymentReduced, error)
 	GetReducedDeploymentsByModuleIds(
 		ctx context.Context,
 		filter pkg_models.DeploymentsFilterWithState,
@@ -48,9 +63,22 @@ type deploymentsHandler interface {
 		selectedModules map[string]pkg_models.Module,
 		userInputs map[string]pkg_models.DeploymentUserInput,
 	) ([]lib_models.DeploymentResult, error)
+	CreateDeploymentInstance(
+		ctx context.Context,
+		module pkg_models.Module,
+		name string,
+		userInput pkg_models.DeploymentUserInput,
+	) (lib_models.DeploymentResult, error)
+	UpdateDeployment(
+		ctx context.Context,
+		module pkg_models.Module,
+		deploymentId string,
+		userInput pkg_models.DeploymentUserInput,
+	) (lib_models.DeploymentResult, error)
 	RecreateDeployments(
 		ctx context.Context,
 		selectedModules map[string]pkg_models.Module,
+		deploymentIds []string,
 	) ([]lib_models.DeploymentResult, error)
 	DeleteDeployments(
 		ctx context.Context,
@@ -59,7 +87,31 @@ type deploymentsHandler interface {
 	) ([]lib_models.DeploymentResult, error)
 	EnableDeployments(ctx context.Context, moduleIds []string) ([]string, error)
 	DisableDeployments(ctx context.Context, moduleIds []string) ([]string, error)
+	EnableDeployment(ctx context.Context, id string) error
+	DisableDeployment(ctx context.Context, id string) error
+	PlanCreateDeployments(
+		ctx context.Context,
+		selectedModules map[string]pkg_models.Module,
+		userInputs map[string]pkg_models.DeploymentUserInput,
+	) ([]lib_models.DeploymentPlan, error)
+	PlanUpdateDeployments(
+		ctx context.Context,
+		selectedModules map[string]pkg_models.Module,
+		userInputs map[string]pkg_models.DeploymentUserInput,
+	) ([]lib_models.DeploymentPlan, error)
+	PlanDeleteDeployments(
+		ctx context.Context,
+		filter pkg_models.DeploymentsFilterWithState,
+		allowAll bool,
+	) ([]lib_models.DeploymentPlan, error)
 	CheckDeployment(ctx context.Context, id string) error
+	GetRuntimeEvents(
+		ctx context.Context,
+		id string,
+		filter lib_models.DeploymentRuntimeEventsFilter,
+	) ([]lib_models.DeploymentRuntimeEvent, error)
+	GetSnapshots(ctx context.Context, deploymentId string) ([]pkg_models.DeploymentSnapshot, error)
+	GetSnapshot(ctx context.Context, deploymentId, snapshotId string) (pkg_models.DeploymentSnapshot, error)
 	IsDeployed(ctx context.Context, moduleId string) (bool, error)
 }
 
@@ -149,8 +201,9 @@ type globalConfigsHandler interface {
 	GetGlobalConfig(ctx context.Context, id string) (pkg_models.Config, error)
 	GetGlobalConfigs(ctx context.Context, ids []string) (map[string]pkg_models.Config, error)
 	UpdateGlobalConfig(ctx context.Context, config pkg_models.Config) error
-	DeleteGlobalConfig(ctx context.Context, id string) error
-	DeleteGlobalConfigs(ctx context.Context, ids []string, allowAll bool) error
+	GetReferences(ctx context.Context, ids []string) (map[string][]pkg_models.DeploymentGlobalConfig, error)
+	DeleteGlobalConfig(ctx context.Context, id string, force bool) error
+	DeleteGlobalConfigs(ctx context.Context, ids []string, allowAll, force bool) error
 }
 
 type deploymentAdvertisementsHandler interface {
@@ -186,8 +239,28 @@ type deploymentAdvertisementsHandler interface {
 	) error
 }
 
+type backupHandler interface {
+	Export(ctx context.Context) (io.ReadCloser, error)
+	Stage(ctx context.Context, r io.Reader) (string, error)
+	Restore(ctx context.Context, id string) ([]lib_models.RepositoryResult, error)
+	Discard(ctx context.Context, id string) error
+}
+
+type bundlesHandler interface {
+	Stage(ctx context.Context, r io.Reader) (string, error)
+	Import(ctx context.Context, id, channel string) ([]lib_models.BundleImageResult, []lib_models.BundleModuleResult, error)
+	Discard(ctx context.Context, id string) error
+}
+
 type databaseHandler interface {
 	Ping(ctx context.Context) error
+	CreateJobResult(ctx context.Context, result pkg_models.JobResult) error
+	ReadJobResult(ctx context.Context, jobId, resultType string) (pkg_models.JobResult, error)
+}
+
+type eventsHandler interface {
+	Publish(event lib_models.Event)
+	Subscribe(filter lib_models.EventsFilter) (<-chan lib_models.Event, func())
 }
 
 type infoHandler interface {
@@ -27,10 +27,15 @@ import (
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 )
 
-func (s *Service) GetJobs(_ context.Context, filterIds []string) ([]lib_models.Job, error) {
+// GetJobs returns the jobs matching the filter ids. If a deployment id is provided, only jobs that belong to the
+// deployment are returned.
+func (s *Service) GetJobs(_ context.Context, deploymentId string, filterIds []string) ([]lib_models.Job, error) {
 	handlerJobs := s.jobsHandler.Jobs(filterIds)
 	var jobs []lib_models.Job
 	for _, handlerJob := range handlerJobs {
+		if !jobBelongsTo(handlerJob, deploymentId) {
+			continue
+		}
 		jobs = append(jobs, getJob(handlerJob))
 	}
 	slices.SortStableFunc(jobs, func(a, b lib_models.Job) int {
@@ -39,37 +44,59 @@ func (s *Service) GetJobs(_ context.Context, filterIds []string) ([]lib_models.J
 	return jobs, nil
 }
 
-func (s *Service) GetJob(_ context.Context, id string) (lib_models.Job, error) {
-	handlerJob, ok := s.jobsHandler.Job(id)
-	if !ok {
-		return lib_models.Job{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
+// GetJob returns a job. If a deployment id is provided, jobs of other deployments are treated as not found.
+func (s *Service) GetJob(_ context.Context, deploymentId, id string) (lib_models.Job, error) {
+	handlerJob, err := s.getHandlerJob(deploymentId, id)
+	if err != nil {
+		return lib_models.Job{}, err
 	}
 	return getJob(handlerJob), nil
 }
 
-func (s *Service) CancelJobs(_ context.Context, ids []string) error {
+// CancelJobs cancels the jobs matching the ids. If a deployment id is provided, jobs of other deployments are skipped.
+func (s *Service) CancelJobs(_ context.Context, deploymentId string, ids []string) error {
 	handlerJobs := s.jobsHandler.Jobs(ids)
 	for _, handlerJob := range handlerJobs {
+		if !jobBelongsTo(handlerJob, deploymentId) {
+			continue
+		}
 		handlerJob.Cancel()
 	}
 	return nil
 }
 
-func (s *Service) CancelJob(_ context.Context, id string) error {
-	handlerJob, ok := s.jobsHandler.Job(id)
-	if !ok {
-		return lib_errors.New[lib_errors.ErrNotFound]("job not found")
+// CancelJob cancels a job. If a deployment id is provided, jobs of other deployments are treated as not found.
+func (s *Service) CancelJob(_ context.Context, deploymentId, id string) error {
+	handlerJob, err := s.getHandlerJob(deploymentId, id)
+	if err != nil {
+		return err
 	}
 	handlerJob.Cancel()
 	return nil
 }
 
+func (s *Service) getHandlerJob(deploymentId, id string) (*handler_jobs.Job, error) {
+	handlerJob, ok := s.jobsHandler.Job(id)
+	if !ok || !jobBelongsTo(handlerJob, deploymentId) {
+		return nil, lib_errors.New[lib_errors.ErrNotFound]("job not found")
+	}
+	return handlerJob, nil
+}
+
+// jobBelongsTo checks if a job belongs to the deployment. All jobs are accessible if no deployment id is provided.
+func jobBelongsTo(handlerJob *handler_jobs.Job, deploymentId string) bool {
+	return deploymentId == "" || handlerJob.DeploymentId == deploymentId
+}
+
 func getJob(handlerJob *handler_jobs.Job) lib_models.Job {
 	job := lib_models.Job{
-		Id:          handlerJob.Id,
-		Description: handlerJob.Description,
-		Start:       handlerJob.Start,
-		End:         handlerJob.End(),
+		Id:           handlerJob.Id,
+		Description:  handlerJob.Description,
+		DeploymentId: handlerJob.DeploymentId,
+		Start:        handlerJob.Start,
+		End:          handlerJob.End(),
+		Interrupted:  handlerJob.Interrupted(),
+		Progress:     handlerJob.Progress(),
 	}
 	return job
 }
@@ -14,6 +14,21 @@ const (
 	moduleJobSlotNum
 )
 
+type RepositoriesRefreshSchedulerConfig struct {
+	StartupDelay  time.Duration
+	Interval      time.Duration
+	Jitter        time.Duration
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+}
+
+// sourceRefreshState holds the number of consecutive refresh failures of a repository source and when the source is
+// due for the next scheduled refresh.
+type sourceRefreshState struct {
+	failures int
+	due      time.Time
+}
+
 type modWrapper struct {
 	Mod     external_models.ModuleLibModule
 	FS      fs.FS
@@ -28,6 +28,8 @@ import (
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	helper_configs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/configs"
+	helper_progress "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/progress"
+	helper_sem_ver "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/sem_ver"
 	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
@@ -195,6 +197,52 @@ func (s *Service) GetModulesAvailableUpdatesCount(ctx context.Context) (int, err
 	return len(changeRequest.Change), nil
 }
 
+// GetModulesAvailableUpdates returns pairs of installed modules and their available updates.
+func (s *Service) GetModulesAvailableUpdates(ctx context.Context) ([][2]lib_models.ModuleAbbreviated, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changeRequest, err := s.newModulesUpdateAllChangeRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates := transformModulesChangeRequest(changeRequest).Change
+	if updates == nil {
+		updates = [][2]lib_models.ModuleAbbreviated{}
+	}
+	return updates, nil
+}
+
+func (s *Service) GetModuleVersionConstraints(ctx context.Context) ([]lib_models.ModuleVersionConstraint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	constraints, err := s.modulesHandler.GetVersionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]lib_models.ModuleVersionConstraint, 0, len(constraints))
+	for _, id := range slices.Sorted(maps.Keys(constraints)) {
+		res = append(res, lib_models.ModuleVersionConstraint{
+			ModuleId:   id,
+			Constraint: constraints[id],
+		})
+	}
+	return res, nil
+}
+
+// SetModuleVersionConstraint sets a version constraint which restricts the versions update all change requests apply
+// to the module.
+func (s *Service) SetModuleVersionConstraint(ctx context.Context, id, constraint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.modulesHandler.SetVersionConstraint(ctx, id, constraint)
+}
+
+func (s *Service) DeleteModuleVersionConstraint(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.modulesHandler.DeleteVersionConstraint(ctx, id)
+}
+
 func (s *Service) CreateModulesUpdateAllChangeRequest(ctx context.Context) (lib_models.ModulesChangeRequest, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -223,13 +271,25 @@ func (s *Service) newModulesUpdateAllChangeRequest(ctx context.Context) (modules
 	if len(repoMods) == 0 {
 		return modulesChangeRequest{}, nil
 	}
+	constraints, err := s.modulesHandler.GetVersionConstraints(ctx)
+	if err != nil {
+		return modulesChangeRequest{}, err
+	}
 	var reqItems []lib_models.ChangeRequestItem
 	for _, repoMod := range repoMods {
 		installedMod, ok := installedMods[repoMod.Id]
 		if !ok {
 			continue
 		}
-		if installedMod.Source == repoMod.Source && installedMod.Channel == repoMod.Channel && installedMod.Version != repoMod.Version {
+		if installedMod.Source != repoMod.Source || installedMod.Channel != repoMod.Channel {
+			continue
+		}
+		updateType, err := helper_sem_ver.GetUpdateType(installedMod.Version, repoMod.Version)
+		if err != nil {
+			logger.ErrorContext(ctx, "update all change request", slog_keys.ModuleId, repoMod.Id, slog_keys.Error, err)
+			continue
+		}
+		if updateType != "" && isUpdateAllowed(updateType, repoMod.Version, constraints[repoMod.Id]) {
 			reqItems = append(reqItems, lib_models.ChangeRequestItem{
 				Id:      installedMod.ID,
 				Source:  installedMod.Source,
@@ -250,17 +310,30 @@ func (s *Service) execModulesChangeRequest(ctx context.Context) lib_models.Modul
 	}()
 	var success []lib_models.ChangeReportItem
 	var failed []lib_models.ChangeReportErrItem
+	helper_progress.SetSteps(ctx, len(s.changeRequest.Remove)+len(s.changeRequest.Install)+len(s.changeRequest.Change))
+	for _, id := range s.changeRequest.Remove {
+		helper_progress.SetItem(ctx, id, lib_constants.JobItemPending)
+	}
+	for _, repoMod := range s.changeRequest.Install {
+		helper_progress.SetItem(ctx, repoMod.Mod.ID, lib_constants.JobItemPending)
+	}
+	for _, item := range s.changeRequest.Change {
+		helper_progress.SetItem(ctx, item.Next.Mod.ID, lib_constants.JobItemPending)
+	}
 	for _, id := range s.changeRequest.Remove {
 		cri := lib_models.ChangeReportItem{
 			Id:     id,
 			Action: lib_constants.ActionRemove,
 		}
+		helper_progress.NextStep(ctx, fmt.Sprintf("removing module '%s'", id))
+		helper_progress.SetItem(ctx, id, lib_constants.JobItemRunning)
 		ok, err := s.deploymentsHandler.IsDeployed(ctx, id)
 		if err != nil {
 			failed = append(failed, lib_models.ChangeReportErrItem{
 				ChangeReportItem: cri,
 				Error:            err.Error(),
 			})
+			helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemFailed)
 			continue
 		}
 		if ok {
@@ -268,6 +341,7 @@ func (s *Service) execModulesChangeRequest(ctx context.Context) lib_models.Modul
 				ChangeReportItem: cri,
 				Error:            "deployment exists",
 			})
+			helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemFailed)
 			continue
 		}
 		err = s.modulesHandler.DeleteModule(ctx, id)
@@ -276,39 +350,55 @@ func (s *Service) execModulesChangeRequest(ctx context.Context) lib_models.Modul
 				ChangeReportItem: cri,
 				Error:            err.Error(),
 			})
+			helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemFailed)
 			continue
 		}
 		success = append(success, cri)
+		helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemDone)
 	}
 	for _, repoMod := range s.changeRequest.Install {
 		cri := lib_models.ChangeReportItem{
 			Id:     repoMod.Mod.ID,
 			Action: lib_constants.ActionInstall,
 		}
-		err := s.modulesHandler.AddModule(ctx, repoMod.Mod.ID, repoMod.Source, repoMod.Channel, repoMod.FS)
+		helper_progress.NextStep(ctx, fmt.Sprintf("installing module '%s'", cri.Id))
+		helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemRunning)
+		err := s.repositoriesHandler.VerifyModuleFS(ctx, repoMod.Source, repoMod.FS)
+		if err == nil {
+			err = s.modulesHandler.AddModule(ctx, repoMod.Mod.ID, repoMod.Source, repoMod.Channel, repoMod.FS)
+		}
 		if err != nil {
 			failed = append(failed, lib_models.ChangeReportErrItem{
 				ChangeReportItem: cri,
 				Error:            err.Error(),
 			})
+			helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemFailed)
 			continue
 		}
 		success = append(success, cri)
+		helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemDone)
 	}
 	for _, item := range s.changeRequest.Change {
 		cri := lib_models.ChangeReportItem{
 			Id:     item.Next.Mod.ID,
 			Action: lib_constants.ActionChange,
 		}
-		err := s.modulesHandler.UpdateModule(ctx, item.Next.Mod.ID, item.Next.Source, item.Next.Channel, item.Next.FS)
+		helper_progress.NextStep(ctx, fmt.Sprintf("updating module '%s'", cri.Id))
+		helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemRunning)
+		err := s.repositoriesHandler.VerifyModuleFS(ctx, item.Next.Source, item.Next.FS)
+		if err == nil {
+			err = s.modulesHandler.UpdateModule(ctx, item.Next.Mod.ID, item.Next.Source, item.Next.Channel, item.Next.FS)
+		}
 		if err != nil {
 			failed = append(failed, lib_models.ChangeReportErrItem{
 				ChangeReportItem: cri,
 				Error:            err.Error(),
 			})
+			helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemFailed)
 			continue
 		}
 		success = append(success, cri)
+		helper_progress.SetItem(ctx, cri.Id, lib_constants.JobItemDone)
 	}
 	return lib_models.ModulesChangeReport{
 		Success: success,
@@ -390,6 +480,19 @@ func equalMods(repoMod modWrapper, installedMod pkg_models.Module) bool {
 		repoMod.Mod.Version == installedMod.Version
 }
 
+// isUpdateAllowed returns true if an update is applied by update all change requests. Updates must satisfy the version
+// constraint of the module, without constraint major updates are excluded.
+func isUpdateAllowed(updateType, version, constraint string) bool {
+	if constraint == "" {
+		return updateType != lib_constants.UpdateTypeMajor
+	}
+	ok, err := helper_sem_ver.InConstraint(constraint, version)
+	if err != nil {
+		return false
+	}
+	return ok
+}
+
 func transformModulesChangeRequest(req modulesChangeRequest) lib_models.ModulesChangeRequest {
 	mcr := lib_models.ModulesChangeRequest{
 		Created: req.Created,
@@ -460,23 +563,11 @@ func getModulesReduced(
 			License:     module.License,
 			Author:      module.Author,
 			IsDeployed:  ok,
-			Deployment: lib_models.DeploymentReduced{
-				Id:            deployment.Id,
-				ModuleSource:  deployment.ModuleSource,
-				ModuleChannel: deployment.ModuleChannel,
-				ModuleVersion: deployment.ModuleVersion,
-				Enabled:       deployment.Enabled,
-				Created:       deployment.Created,
-				Updated:       deployment.Updated,
-				State:         deployment.State,
-			},
+			Deployment:  getDeploymentReduced(deployment),
 		}
 		if module.Err != nil {
 			mod.ErrorResult = lib_models.NewErrorResult(module.Err.Error())
 		}
-		if deployment.Err != nil {
-			mod.Deployment.ErrorResult = lib_models.NewErrorResult(deployment.Err.Error())
-		}
 		modules = append(modules, mod)
 	}
 	return modules
@@ -489,16 +580,7 @@ func getModule(module pkg_models.Module, deployment pkg_models.Deployment) lib_m
 		Channel:    module.Channel,
 		Added:      module.Added,
 		Updated:    module.Updated,
-		Deployment: lib_models.Deployment{
-			Id:            deployment.Id,
-			ModuleSource:  deployment.ModuleSource,
-			ModuleChannel: deployment.ModuleChannel,
-			ModuleVersion: deployment.ModuleVersion,
-			Enabled:       deployment.Enabled,
-			Created:       deployment.Created,
-			Updated:       deployment.Updated,
-			State:         deployment.State,
-		},
+		Deployment: getDeployment(deployment),
 	}
 	if len(module.Files) > 0 {
 		mod.Files = make(map[string]lib_models.ModuleFile)
@@ -513,10 +595,29 @@ func getModule(module pkg_models.Module, deployment pkg_models.Deployment) lib_m
 			}
 		}
 	}
+	if module.Err != nil {
+		mod.ErrorResult = lib_models.NewErrorResult(module.Err.Error())
+	}
+	return mod
+}
+
+func getDeployment(deployment pkg_models.Deployment) lib_models.Deployment {
+	dep := lib_models.Deployment{
+		Id:            deployment.Id,
+		ModuleId:      deployment.ModuleId,
+		Name:          deployment.Name,
+		ModuleSource:  deployment.ModuleSource,
+		ModuleChannel: deployment.ModuleChannel,
+		ModuleVersion: deployment.ModuleVersion,
+		Enabled:       deployment.Enabled,
+		Created:       deployment.Created,
+		Updated:       deployment.Updated,
+		State:         deployment.State,
+	}
 	if len(deployment.Containers) > 0 {
-		mod.Deployment.Containers = make(map[string]lib_models.Container)
+		dep.Containers = make(map[string]lib_models.Container)
 		for reference, container := range deployment.Containers {
-			mod.Deployment.Containers[reference] = lib_models.Container{
+			dep.Containers[reference] = lib_models.Container{
 				Name:    container.Name,
 				Alias:   container.Alias,
 				ImageId: container.ImageId,
@@ -526,30 +627,30 @@ func getModule(module pkg_models.Module, deployment pkg_models.Deployment) lib_m
 		}
 	}
 	if len(deployment.Volumes) > 0 {
-		mod.Deployment.Volumes = make(map[string]string)
+		dep.Volumes = make(map[string]string)
 		for reference, volume := range deployment.Volumes {
-			mod.Deployment.Volumes[reference] = volume.Name
+			dep.Volumes[reference] = volume.Name
 		}
 	}
 	if len(deployment.HostResources) > 0 {
-		mod.Deployment.HostResources = make(map[string]string)
+		dep.HostResources = make(map[string]string)
 		for reference, resource := range deployment.HostResources {
-			mod.Deployment.HostResources[reference] = resource.Id
+			dep.HostResources[reference] = resource.Id
 		}
 	}
 	if len(deployment.Secrets) > 0 {
-		mod.Deployment.Secrets = make(map[string]lib_models.DeploymentSecret)
+		dep.Secrets = make(map[string]lib_models.DeploymentSecret)
 		for reference, secret := range deployment.Secrets {
-			mod.Deployment.Secrets[reference] = lib_models.DeploymentSecret{
+			dep.Secrets[reference] = lib_models.DeploymentSecret{
 				Id:    secret.Id,
 				Items: secret.Items,
 			}
 		}
 	}
 	if len(deployment.Configs) > 0 {
-		mod.Deployment.Configs = make(map[string]lib_models.InterfaceValue)
+		dep.Configs = make(map[string]lib_models.InterfaceValue)
 		for reference, config := range deployment.Configs {
-			mod.Deployment.Configs[reference] = lib_models.InterfaceValue{
+			dep.Configs[reference] = lib_models.InterfaceValue{
 				DataType: config.DataType,
 				IsSlice:  config.IsSlice,
 				Value:    helper_configs.ValueToInterface(config.Value),
@@ -557,19 +658,19 @@ func getModule(module pkg_models.Module, deployment pkg_models.Deployment) lib_m
 		}
 	}
 	if len(deployment.GlobalConfigs) > 0 {
-		mod.Deployment.GlobalConfigs = make(map[string]string)
+		dep.GlobalConfigs = make(map[string]string)
 		for reference, globalConfig := range deployment.GlobalConfigs {
-			mod.Deployment.GlobalConfigs[reference] = globalConfig.Id
+			dep.GlobalConfigs[reference] = globalConfig.Id
 		}
 	}
 	if len(deployment.Files) > 0 {
-		mod.Deployment.Files = make(map[string]string)
+		dep.Files = make(map[string]string)
 		for reference, file := range deployment.Files {
-			mod.Deployment.Files[reference] = base64.StdEncoding.EncodeToString(file.Data)
+			dep.Files[reference] = base64.StdEncoding.EncodeToString(file.Data)
 		}
 	}
 	if len(deployment.FileGroups) > 0 {
-		mod.Deployment.FileGroups = make(map[string]lib_models.DeploymentFileGroup)
+		dep.FileGroups = make(map[string]lib_models.DeploymentFileGroup)
 		for reference, fileGroup := range deployment.FileGroups {
 			var fileGroupFiles []lib_models.DeploymentFileGroupFile
 			for _, file := range fileGroup.Files {
@@ -579,17 +680,51 @@ func getModule(module pkg_models.Module, deployment pkg_models.Deployment) lib_m
 					Data:   base64.StdEncoding.EncodeToString(file.Data),
 				})
 			}
-			mod.Deployment.FileGroups[reference] = lib_models.DeploymentFileGroup{
+			dep.FileGroups[reference] = lib_models.DeploymentFileGroup{
 				Id:    fileGroup.Id,
 				Files: fileGroupFiles,
 			}
 		}
 	}
-	if module.Err != nil {
-		mod.ErrorResult = lib_models.NewErrorResult(module.Err.Error())
+	if len(deployment.DependencyTargets) > 0 {
+		dep.DependencyTargets = make(map[string]string)
+		for moduleId, target := range deployment.DependencyTargets {
+			dep.DependencyTargets[moduleId] = target.TargetId
+		}
 	}
 	if deployment.Err != nil {
-		mod.Deployment.ErrorResult = lib_models.NewErrorResult(deployment.Err.Error())
+		dep.ErrorResult = lib_models.NewErrorResult(deployment.Err.Error())
+	}
+	dep.LastStartError = getDeploymentStartError(deployment.LastStartError)
+	return dep
+}
+
+func getDeploymentReduced(deployment pkg_models.DeploymentReduced) lib_models.DeploymentReduced {
+	dep := lib_models.DeploymentReduced{
+		Id:            deployment.Id,
+		ModuleId:      deployment.ModuleId,
+		Name:          deployment.Name,
+		ModuleSource:  deployment.ModuleSource,
+		ModuleChannel: deployment.ModuleChannel,
+		ModuleVersion: deployment.ModuleVersion,
+		Enabled:       deployment.Enabled,
+		Created:       deployment.Created,
+		Updated:       deployment.Updated,
+		State:         deployment.State,
+	}
+	if deployment.Err != nil {
+		dep.ErrorResult = lib_models.NewErrorResult(deployment.Err.Error())
+	}
+	dep.LastStartError = getDeploymentStartError(deployment.LastStartError)
+	return dep
+}
+
+func getDeploymentStartError(startErr *pkg_models.DeploymentStartError) *lib_models.DeploymentStartError {
+	if startErr == nil {
+		return nil
+	}
+	return &lib_models.DeploymentStartError{
+		Error:     startErr.Err.Error(),
+		Timestamp: startErr.Time,
 	}
-	return mod
 }
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_slices "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/slices"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+)
+
+func (s *Service) PlanCreateDeployments(ctx context.Context, userInputs []lib_models.DeploymentUserInput) ([]lib_models.DeploymentPlan, error) {
+	handlerModules, userInputMap, err := s.getPlanModulesAndUserInputs(ctx, userInputs, true)
+	if err != nil || len(handlerModules) == 0 {
+		return nil, err
+	}
+	return s.deploymentsHandler.PlanCreateDeployments(ctx, handlerModules, userInputMap)
+}
+
+// PlanUpdateDeployments returns the plans of the deployments and the auxiliary deployments recreated on update.
+func (s *Service) PlanUpdateDeployments(ctx context.Context, userInputs []lib_models.DeploymentUserInput) ([]lib_models.DeploymentPlan, error) {
+	handlerModules, userInputMap, err := s.getPlanModulesAndUserInputs(ctx, userInputs, false)
+	if err != nil || len(handlerModules) == 0 {
+		return nil, err
+	}
+	plans, err := s.deploymentsHandler.PlanUpdateDeployments(ctx, handlerModules, userInputMap)
+	if err != nil {
+		return nil, err
+	}
+	err = s.setPlansAuxDeployments(
+		ctx,
+		plans,
+		lib_models.AuxiliaryDeploymentsFilter{Recreate: 1},
+		lib_constants.PlanActionRecreate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// PlanDeleteDeployments returns the plans of the deployments and the auxiliary deployments removed on delete.
+func (s *Service) PlanDeleteDeployments(ctx context.Context, moduleIds []string, allowAll bool) ([]lib_models.DeploymentPlan, error) {
+	plans, err := s.deploymentsHandler.PlanDeleteDeployments(
+		ctx,
+		pkg_models.DeploymentsFilterWithState{
+			DeploymentsFilter: pkg_models.DeploymentsFilter{
+				ModuleIds: moduleIds,
+			},
+		},
+		allowAll,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = s.setPlansAuxDeployments(ctx, plans, lib_models.AuxiliaryDeploymentsFilter{}, lib_constants.PlanActionRemove)
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+func (s *Service) getPlanModulesAndUserInputs(
+	ctx context.Context,
+	userInputs []lib_models.DeploymentUserInput,
+	dependencies bool,
+) (map[string]pkg_models.Module, map[string]pkg_models.DeploymentUserInput, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(moduleJobSlotNum)
+	if ok {
+		return nil, nil, lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	if len(userInputs) == 0 {
+		return nil, nil, nil
+	}
+	handlerModules, err := s.modulesHandler.GetModules(
+		ctx,
+		pkg_models.ModulesFilterWithName{
+			ModulesFilter: pkg_models.ModulesFilter{
+				Ids: helper_slices.CollectFunc(slices.Values(userInputs), func(item lib_models.DeploymentUserInput) string {
+					return item.ModuleId
+				}),
+			},
+		},
+		dependencies,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	userInputMap, err := getUserInputs(userInputs, handlerModules)
+	if err != nil {
+		return nil, nil, lib_errors.Wrap[lib_errors.ErrInvalidInput](err)
+	}
+	return handlerModules, userInputMap, nil
+}
+
+// setPlansAuxDeployments adds the auxiliary deployments matching the filter to plans without errors.
+func (s *Service) setPlansAuxDeployments(
+	ctx context.Context,
+	plans []lib_models.DeploymentPlan,
+	filter lib_models.AuxiliaryDeploymentsFilter,
+	action string,
+) error {
+	for i, plan := range plans {
+		if plan.HasError || plan.Id == "" {
+			continue
+		}
+		auxDeployments, err := s.auxDeploymentsHandler.GetReducedDeployments(
+			ctx,
+			plan.Id,
+			lib_models.AuxiliaryDeploymentsFilterWithState{AuxiliaryDeploymentsFilter: filter},
+		)
+		if err != nil {
+			return err
+		}
+		for _, id := range slices.Sorted(maps.Keys(auxDeployments)) {
+			plans[i].AuxiliaryDeployments = append(plans[i].AuxiliaryDeployments, lib_models.DeploymentPlanAuxiliaryDeployment{
+				Id:        id,
+				Reference: auxDeployments[id].Reference,
+				Action:    action,
+			})
+		}
+	}
+	return nil
+}
@@ -3,12 +3,20 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
+	"maps"
+	"math/rand/v2"
 	"slices"
 	"strings"
+	"time"
 
+	lib_constants "github.com/SENERGY-Platform/mgw-module-manager/lib/constants"
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	handler_jobs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/jobs"
 	helper_modfile "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/modfile"
+	helper_sem_ver "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/sem_ver"
+	helper_time "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/time"
 	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
 	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
@@ -26,43 +34,194 @@ func (s *Service) RefreshRepositories(ctx context.Context, filter lib_models.Rep
 	if err != nil {
 		return lib_models.Job{}, err
 	}
-	go func() {
-		jobResult := lib_models.RepositoryJobResult{
-			JobResult: lib_models.JobResult{
-				JobId: job.Id,
-			},
+	go s.execRefreshRepositoriesJob(ctx, job, filter)
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+// RepositoriesRefreshScheduler periodically refreshes repositories until the context is canceled. Each source is
+// refreshed after the configured interval plus a random jitter. Failed sources are retried with an exponential backoff
+// while other sources keep their interval. The scheduler is disabled if the interval is not greater than zero.
+func (s *Service) RepositoriesRefreshScheduler(ctx context.Context, config RepositoriesRefreshSchedulerConfig) {
+	if config.Interval <= 0 {
+		return
+	}
+	timer := time.NewTimer(config.StartupDelay)
+	defer timer.Stop()
+	states := make(map[string]sourceRefreshState)
+	for {
+		select {
+		case <-timer.C:
+			timer.Reset(s.scheduledRefreshRepositories(ctx, config, states))
+		case <-ctx.Done():
+			return
 		}
-		defer func() {
-			if st := recover(); st != nil {
-				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
-				logger.ErrorContext(
+	}
+}
+
+// scheduledRefreshRepositories refreshes the sources that are due, updates their states and returns the delay until
+// the next source is due. Available updates provided by successfully refreshed sources are published.
+func (s *Service) scheduledRefreshRepositories(
+	ctx context.Context,
+	config RepositoriesRefreshSchedulerConfig,
+	states map[string]sourceRefreshState,
+) time.Duration {
+	repos, err := s.repositoriesHandler.GetRepositories(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "scheduled repositories refresh", slog_keys.Error, err)
+		return getRepositoryRefreshDelay(config, 1, nil)
+	}
+	now := helper_time.Now()
+	var dueSources []string
+	existing := make(map[string]struct{})
+	for _, repo := range repos {
+		existing[repo.Source] = struct{}{}
+		if !states[repo.Source].due.After(now) {
+			dueSources = append(dueSources, repo.Source)
+		}
+	}
+	maps.DeleteFunc(states, func(source string, _ sourceRefreshState) bool {
+		_, ok := existing[source]
+		return !ok
+	})
+	if len(dueSources) > 0 {
+		jobResult, err := s.execScheduledRefreshRepositories(ctx, dueSources)
+		if err != nil || jobResult.HasError {
+			logger.WarnContext(
+				ctx,
+				"scheduled repositories refresh failed",
+				slog_keys.JobId, jobResult.JobId,
+				slog_keys.Error, getRefreshRepositoriesJobErrMsg(jobResult, err),
+			)
+			return getRepositoryRefreshDelay(config, 1, nil)
+		}
+		results := make(map[string]lib_models.RepositoryResult)
+		for _, result := range jobResult.Results {
+			if result.Refresh {
+				results[result.Source] = result
+			}
+		}
+		var refreshedSources []string
+		for _, source := range dueSources {
+			state := states[source]
+			result, ok := results[source]
+			if ok && !result.HasError {
+				state.failures = 0
+				refreshedSources = append(refreshedSources, source)
+			} else {
+				state.failures++
+				errMsg := "not refreshed"
+				if ok {
+					errMsg = result.ErrorMsg
+				}
+				logger.WarnContext(
 					ctx,
-					"refresh repositories",
-					slog_keys.JobId, job.Id,
-					slog_keys.Error, "panic",
-					slog_keys.StackTrace, st,
+					"scheduled repository refresh failed",
+					slog_keys.JobId, jobResult.JobId,
+					slog_keys.Source, source,
+					slog_keys.Error, errMsg,
 				)
 			}
-			s.setRefreshRepositoriesJobResult(job.Id, jobResult)
-			job.Done()
-			logJobDone(ctx, job)
-		}()
-		logJobStart(ctx, job)
-		jobResult.Results, err = s.repositoriesHandler.RefreshRepositories(job.Context(), filter)
-		if err != nil {
-			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+			state.due = now.Add(getRepositoryRefreshDelay(config, state.failures, result.RateLimitedUntil))
+			states[source] = state
 		}
-		for _, res := range jobResult.Results {
-			if res.HasError {
-				jobResult.ResultsErrNum++
-			}
+		if len(refreshedSources) > 0 {
+			s.notifyAvailableUpdates(ctx, refreshedSources)
 		}
+	}
+	if len(states) == 0 {
+		return getRepositoryRefreshDelay(config, 0, nil)
+	}
+	var next time.Time
+	for _, state := range states {
+		if next.IsZero() || state.due.Before(next) {
+			next = state.due
+		}
+	}
+	return max(next.Sub(now), 0)
+}
+
+func (s *Service) execScheduledRefreshRepositories(ctx context.Context, sources []string) (lib_models.RepositoryJobResult, error) {
+	s.mu.Lock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(repositoryJobSlotNum)
+	if ok {
+		s.mu.Unlock()
+		return lib_models.RepositoryJobResult{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	s.changeRequest = nil
+	job, err := s.jobsHandler.CreateSlotJob(repositoryJobSlotNum, "scheduled repositories refresh")
+	s.mu.Unlock()
+	if err != nil {
+		return lib_models.RepositoryJobResult{}, err
+	}
+	return s.execRefreshRepositoriesJob(ctx, job, lib_models.RepositoriesRefreshFilter{Sources: sources}), nil
+}
+
+func (s *Service) execRefreshRepositoriesJob(
+	ctx context.Context,
+	job *handler_jobs.Job,
+	filter lib_models.RepositoriesRefreshFilter,
+) (jobResult lib_models.RepositoryJobResult) {
+	jobResult.JobId = job.Id
+	defer func() {
+		if st := recover(); st != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+			logger.ErrorContext(
+				ctx,
+				"refresh repositories",
+				slog_keys.JobId, job.Id,
+				slog_keys.Error, "panic",
+				slog_keys.StackTrace, st,
+			)
+		}
+		s.setRefreshRepositoriesJobResult(job.Id, jobResult)
+		job.Done()
+		logJobDone(ctx, job)
 	}()
-	return lib_models.Job{
-		Id:          job.Id,
-		Description: job.Description,
-		Start:       job.Start,
-	}, nil
+	logJobStart(ctx, job)
+	var err error
+	jobResult.Results, err = s.repositoriesHandler.RefreshRepositories(job.Context(), filter)
+	if err != nil {
+		jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+	}
+	for _, res := range jobResult.Results {
+		if res.HasError {
+			jobResult.ResultsErrNum++
+		}
+	}
+	return
+}
+
+// notifyAvailableUpdates publishes the available module updates provided by the sources.
+func (s *Service) notifyAvailableUpdates(ctx context.Context, sources []string) {
+	updates, err := s.GetModulesAvailableUpdates(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "get available module updates", slog_keys.Error, err)
+		return
+	}
+	var moduleUpdates []lib_models.ModuleUpdateEvent
+	var ids []string
+	for _, update := range updates {
+		if !slices.Contains(sources, update[1].Source) {
+			continue
+		}
+		moduleUpdates = append(moduleUpdates, lib_models.ModuleUpdateEvent{
+			Installed: update[0],
+			Update:    update[1],
+		})
+		ids = append(ids, update[0].Id)
+	}
+	if len(moduleUpdates) == 0 {
+		return
+	}
+	logger.InfoContext(ctx, "module updates available", slog_keys.ModuleIds, ids)
+	s.eventsHandler.Publish(lib_models.Event{
+		Type:          lib_constants.EventModuleUpdatesAvailable,
+		ModuleUpdates: moduleUpdates,
+	})
 }
 
 func (s *Service) GetRepositories(ctx context.Context) ([]lib_models.Repository, error) {
@@ -95,6 +254,46 @@ func (s *Service) DeleteRepository(ctx context.Context, source string) error {
 	return s.repositoriesHandler.DeleteRepository(ctx, source)
 }
 
+// AddModulePackage stores an uploaded module package, the module can be selected via the returned source and
+// channel like modules of other repositories.
+func (s *Service) AddModulePackage(ctx context.Context, channel string, r io.Reader) (lib_models.ModulePackage, error) {
+	stageId, err := s.repositoriesHandler.StageModulePackage(ctx, r)
+	if err != nil {
+		return lib_models.ModulePackage{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(repositoryJobSlotNum)
+	if ok {
+		_ = s.repositoriesHandler.DiscardModulePackage(ctx, stageId)
+		return lib_models.ModulePackage{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	s.changeRequest = nil
+	repoMod, err := s.repositoriesHandler.AddModulePackage(ctx, channel, stageId)
+	if err != nil {
+		return lib_models.ModulePackage{}, err
+	}
+	return lib_models.ModulePackage{
+		Id:      repoMod.Id,
+		Name:    repoMod.Name,
+		Desc:    repoMod.Desc,
+		Version: repoMod.Version,
+		Source:  repoMod.Source,
+		Channel: repoMod.Channel,
+	}, nil
+}
+
+func (s *Service) DeleteModulePackage(ctx context.Context, channel, moduleId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJob, ok := s.jobsHandler.CurrentSlotJob(repositoryJobSlotNum)
+	if ok {
+		return lib_errors.New[lib_errors.ErrActiveJob](activeJobErrMsg(currentJob))
+	}
+	s.changeRequest = nil
+	return s.repositoriesHandler.DeleteModulePackage(ctx, channel, moduleId)
+}
+
 func (s *Service) GetRepositoryModules(ctx context.Context, filter lib_models.RepoModulesFilter) ([]lib_models.RepoModule, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -126,7 +325,11 @@ func (s *Service) GetRepositoryModules(ctx context.Context, filter lib_models.Re
 	if err != nil {
 		return nil, err
 	}
-	return handleInstalledMods(mergedRepoModules, installedMods, filter.Installed, filter.UpdateAvailable), nil
+	constraints, err := s.modulesHandler.GetVersionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handleInstalledMods(mergedRepoModules, installedMods, constraints, filter.Installed, filter.UpdateAvailable), nil
 }
 
 func (s *Service) mergeRepoModules(ctx context.Context, repos []lib_models.Repository, repoMods []pkg_models.RepositoryModule) ([]lib_models.RepoModule, error) {
@@ -339,7 +542,12 @@ func buildReposTree(repos []lib_models.Repository) map[string]repoAbbreviated {
 	return reposTree
 }
 
-func handleInstalledMods(mods []lib_models.RepoModule, installedMods map[string]pkg_models.Module, filterInstalled, filterUpdateAvailable bool) []lib_models.RepoModule {
+func handleInstalledMods(
+	mods []lib_models.RepoModule,
+	installedMods map[string]pkg_models.Module,
+	constraints map[string]string,
+	filterInstalled, filterUpdateAvailable bool,
+) []lib_models.RepoModule {
 	if len(installedMods) == 0 {
 		if filterInstalled || filterUpdateAvailable {
 			return nil
@@ -350,7 +558,7 @@ func handleInstalledMods(mods []lib_models.RepoModule, installedMods map[string]
 	for _, mod := range mods {
 		variant, ok := installedMods[mod.Id]
 		if ok {
-			nextVersion := getNextVersion(variant, mod.RepositoryVariants)
+			nextVersion, updateType := getNextVersion(variant, mod.RepositoryVariants)
 			if filterUpdateAvailable && nextVersion == "" {
 				continue
 			}
@@ -361,7 +569,12 @@ func handleInstalledMods(mods []lib_models.RepoModule, installedMods map[string]
 					Channel: variant.Channel,
 					Version: variant.Version,
 				},
-				NextVersion: nextVersion,
+				NextVersion:       nextVersion,
+				UpdateType:        updateType,
+				VersionConstraint: constraints[mod.Id],
+			}
+			if nextVersion != "" {
+				mod.InstalledVariant.UpdateRestricted = !isUpdateAllowed(updateType, nextVersion, constraints[mod.Id])
 			}
 		} else {
 			if filterInstalled {
@@ -376,19 +589,20 @@ func handleInstalledMods(mods []lib_models.RepoModule, installedMods map[string]
 	return tmp
 }
 
-func getNextVersion(installed pkg_models.Module, repos []lib_models.RepoModuleVariant) string {
+func getNextVersion(installed pkg_models.Module, repos []lib_models.RepoModuleVariant) (string, string) {
 	for _, repo := range repos {
 		if repo.Source == installed.Source {
 			for _, channel := range repo.Channels {
 				if channel.Name == installed.Channel {
-					if channel.Version != installed.Version {
-						return channel.Version
+					updateType, err := helper_sem_ver.GetUpdateType(installed.Version, channel.Version)
+					if err == nil && updateType != "" {
+						return channel.Version, updateType
 					}
 				}
 			}
 		}
 	}
-	return ""
+	return "", ""
 }
 
 func selectByPriority[S ~[]E, E any](sl S, comp func(item E, lastPrio int) (int, bool)) E {
@@ -403,3 +617,35 @@ func selectByPriority[S ~[]E, E any](sl S, comp func(item E, lastPrio int) (int,
 	}
 	return candidate
 }
+
+func getRefreshRepositoriesJobErrMsg(jobResult lib_models.RepositoryJobResult, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	if jobResult.HasError {
+		return jobResult.ErrorMsg
+	}
+	return fmt.Sprintf("%d repository errors", jobResult.ResultsErrNum)
+}
+
+// getRepositoryRefreshDelay returns the interval plus jitter or, after failures, the backoff delay plus jitter. If the
+// source is rate limited, the delay is extended to the reset time.
+func getRepositoryRefreshDelay(config RepositoriesRefreshSchedulerConfig, failures int, rateLimitedUntil *time.Time) time.Duration {
+	delay := config.Interval
+	if failures > 0 {
+		delay = config.RetryDelay
+		for i := 1; i < failures && delay < config.MaxRetryDelay; i++ {
+			delay *= 2
+		}
+		delay = min(delay, config.MaxRetryDelay)
+		if rateLimitedUntil != nil {
+			if d := rateLimitedUntil.Sub(helper_time.Now()); d > delay {
+				delay = d
+			}
+		}
+	}
+	if config.Jitter > 0 {
+		delay += rand.N(config.Jitter)
+	}
+	return delay
+}
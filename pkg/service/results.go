@@ -18,162 +18,161 @@ package service
 
 import (
 	"context"
-	"sync"
+	"encoding/json"
 
 	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
 	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
 )
 
-type jobResults struct {
-	deployments         map[string]lib_models.DeploymentJobResult
-	deploymentsUpdate   map[string]lib_models.DeploymentUpdateJobResult
-	deploymentsDelete   map[string]lib_models.DeploymentDeleteJobResult
-	moduleChange        map[string]lib_models.ModulesChangeJobResult
-	refreshRepositories map[string]lib_models.RepositoryJobResult
-	auxDeploymentCreate map[string]lib_models.AuxiliaryDeploymentCreateJobResult
-	auxDeploymentUpdate map[string]lib_models.JobResult
-	auxDeployment       map[string]lib_models.AuxiliaryDeploymentJobResult
-	mu                  sync.RWMutex
-}
+const (
+	jobResultTypeDeployments         = "deployments"
+	jobResultTypeDeploymentsUpdate   = "deployments_update"
+	jobResultTypeDeploymentsDelete   = "deployments_delete"
+	jobResultTypeModuleChange        = "module_change"
+	jobResultTypeRefreshRepositories = "refresh_repositories"
+	jobResultTypeAuxDeploymentCreate = "aux_deployment_create"
+	jobResultTypeAuxDeploymentUpdate = "aux_deployment_update"
+	jobResultTypeAuxDeployment       = "aux_deployment"
+	jobResultTypeBackupRestore       = "backup_restore"
+	jobResultTypeBundleImport        = "bundle_import"
+)
 
 func (s *Service) setDeploymentsJobResult(jobId string, res lib_models.DeploymentJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.deployments[jobId] = res
+	setJobResult(s, jobId, jobResultTypeDeployments, res)
 }
 
-func (s *Service) GetDeploymentsJobResult(_ context.Context, jobId string) (lib_models.DeploymentJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.deployments[jobId]
-	if !ok {
-		return lib_models.DeploymentJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetDeploymentsJobResult(ctx context.Context, jobId string) (lib_models.DeploymentJobResult, error) {
+	return getJobResult[lib_models.DeploymentJobResult](ctx, s, jobId, jobResultTypeDeployments)
 }
 
 func (s *Service) setUpdateDeploymentsJobResult(jobId string, res lib_models.DeploymentUpdateJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.deploymentsUpdate[jobId] = res
+	setJobResult(s, jobId, jobResultTypeDeploymentsUpdate, res)
 }
 
-func (s *Service) GetUpdateDeploymentsJobResult(_ context.Context, jobId string) (lib_models.DeploymentUpdateJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.deploymentsUpdate[jobId]
-	if !ok {
-		return lib_models.DeploymentUpdateJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetUpdateDeploymentsJobResult(ctx context.Context, jobId string) (lib_models.DeploymentUpdateJobResult, error) {
+	return getJobResult[lib_models.DeploymentUpdateJobResult](ctx, s, jobId, jobResultTypeDeploymentsUpdate)
 }
 
 func (s *Service) setDeleteDeploymentsJobResult(jobId string, res lib_models.DeploymentDeleteJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.deploymentsDelete[jobId] = res
+	setJobResult(s, jobId, jobResultTypeDeploymentsDelete, res)
 }
 
-func (s *Service) GetDeleteDeploymentsJobResult(_ context.Context, jobId string) (lib_models.DeploymentDeleteJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.deploymentsDelete[jobId]
-	if !ok {
-		return lib_models.DeploymentDeleteJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetDeleteDeploymentsJobResult(ctx context.Context, jobId string) (lib_models.DeploymentDeleteJobResult, error) {
+	return getJobResult[lib_models.DeploymentDeleteJobResult](ctx, s, jobId, jobResultTypeDeploymentsDelete)
 }
 
 func (s *Service) setModuleChangeJobResult(jobId string, res lib_models.ModulesChangeJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.moduleChange[jobId] = res
+	setJobResult(s, jobId, jobResultTypeModuleChange, res)
 }
 
-func (s *Service) GetModuleChangeJobResult(_ context.Context, jobId string) (lib_models.ModulesChangeJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.moduleChange[jobId]
-	if !ok {
-		return lib_models.ModulesChangeJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetModuleChangeJobResult(ctx context.Context, jobId string) (lib_models.ModulesChangeJobResult, error) {
+	return getJobResult[lib_models.ModulesChangeJobResult](ctx, s, jobId, jobResultTypeModuleChange)
 }
 
 func (s *Service) setRefreshRepositoriesJobResult(jobId string, res lib_models.RepositoryJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.refreshRepositories[jobId] = res
+	setJobResult(s, jobId, jobResultTypeRefreshRepositories, res)
 }
 
-func (s *Service) GetRefreshRepositoriesJobResult(_ context.Context, jobId string) (lib_models.RepositoryJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.refreshRepositories[jobId]
-	if !ok {
-		return lib_models.RepositoryJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetRefreshRepositoriesJobResult(ctx context.Context, jobId string) (lib_models.RepositoryJobResult, error) {
+	return getJobResult[lib_models.RepositoryJobResult](ctx, s, jobId, jobResultTypeRefreshRepositories)
 }
 
 func (s *Service) setCreateAuxiliaryDeploymentJobResult(jobId string, res lib_models.AuxiliaryDeploymentCreateJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.auxDeploymentCreate[jobId] = res
+	setJobResult(s, jobId, jobResultTypeAuxDeploymentCreate, res)
 }
 
-func (s *Service) GetCreateAuxiliaryDeploymentJobResult(_ context.Context, jobId string) (lib_models.AuxiliaryDeploymentCreateJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.auxDeploymentCreate[jobId]
-	if !ok {
-		return lib_models.AuxiliaryDeploymentCreateJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetCreateAuxiliaryDeploymentJobResult(ctx context.Context, deploymentId, jobId string) (lib_models.AuxiliaryDeploymentCreateJobResult, error) {
+	return getDeploymentJobResult[lib_models.AuxiliaryDeploymentCreateJobResult](ctx, s, deploymentId, jobId, jobResultTypeAuxDeploymentCreate)
 }
 
 func (s *Service) setUpdateAuxiliaryDeploymentJobResult(jobId string, res lib_models.JobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.auxDeploymentUpdate[jobId] = res
+	setJobResult(s, jobId, jobResultTypeAuxDeploymentUpdate, res)
 }
 
-func (s *Service) GetUpdateAuxiliaryDeploymentJobResult(_ context.Context, jobId string) (lib_models.JobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.auxDeploymentUpdate[jobId]
-	if !ok {
-		return lib_models.JobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
-	}
-	return res, nil
+func (s *Service) GetUpdateAuxiliaryDeploymentJobResult(ctx context.Context, deploymentId, jobId string) (lib_models.JobResult, error) {
+	return getDeploymentJobResult[lib_models.JobResult](ctx, s, deploymentId, jobId, jobResultTypeAuxDeploymentUpdate)
 }
 
 func (s *Service) setAuxiliaryDeploymentsJobResult(jobId string, res lib_models.AuxiliaryDeploymentJobResult) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	s.jobResults.auxDeployment[jobId] = res
+	setJobResult(s, jobId, jobResultTypeAuxDeployment, res)
 }
 
-func (s *Service) GetAuxiliaryDeploymentsJobResult(_ context.Context, jobId string) (lib_models.AuxiliaryDeploymentJobResult, error) {
-	s.jobResults.mu.RLock()
-	defer s.jobResults.mu.RUnlock()
-	res, ok := s.jobResults.auxDeployment[jobId]
-	if !ok {
-		return lib_models.AuxiliaryDeploymentJobResult{}, lib_errors.New[lib_errors.ErrNotFound]("job not found")
+func (s *Service) GetAuxiliaryDeploymentsJobResult(ctx context.Context, deploymentId, jobId string) (lib_models.AuxiliaryDeploymentJobResult, error) {
+	return getDeploymentJobResult[lib_models.AuxiliaryDeploymentJobResult](ctx, s, deploymentId, jobId, jobResultTypeAuxDeployment)
+}
+
+func (s *Service) setRestoreBackupJobResult(jobId string, res lib_models.BackupRestoreJobResult) {
+	setJobResult(s, jobId, jobResultTypeBackupRestore, res)
+}
+
+func (s *Service) GetRestoreBackupJobResult(ctx context.Context, jobId string) (lib_models.BackupRestoreJobResult, error) {
+	return getJobResult[lib_models.BackupRestoreJobResult](ctx, s, jobId, jobResultTypeBackupRestore)
+}
+
+func (s *Service) setImportBundleJobResult(jobId string, res lib_models.BundleImportJobResult) {
+	setJobResult(s, jobId, jobResultTypeBundleImport, res)
+}
+
+func (s *Service) GetImportBundleJobResult(ctx context.Context, jobId string) (lib_models.BundleImportJobResult, error) {
+	return getJobResult[lib_models.BundleImportJobResult](ctx, s, jobId, jobResultTypeBundleImport)
+}
+
+// setJobResult stores the result of a job. The job or service context may already be canceled, thus a new
+// context is used.
+func setJobResult[T any](s *Service, jobId, resultType string, res T) {
+	data, err := json.Marshal(res)
+	if err != nil {
+		logger.Error("set job result", slog_keys.JobId, jobId, slog_keys.Error, err)
+		return
+	}
+	err = s.databaseHandler.CreateJobResult(context.Background(), pkg_models.JobResult{
+		JobId: jobId,
+		Type:  resultType,
+		Data:  data,
+	})
+	if err != nil {
+		logger.Error("set job result", slog_keys.JobId, jobId, slog_keys.Error, err)
+	}
+}
+
+// getJobResult returns the stored result of a job. Jobs interrupted by a shutdown have no stored result, for these
+// jobs a result containing an error is returned.
+func getJobResult[T any](ctx context.Context, s *Service, jobId, resultType string) (T, error) {
+	var res T
+	dbRes, err := s.databaseHandler.ReadJobResult(ctx, jobId, resultType)
+	if err != nil {
+		if !lib_errors.IsOf[lib_errors.ErrNotFound](err) {
+			return res, err
+		}
+		job, ok := s.jobsHandler.Job(jobId)
+		if !ok || !job.Interrupted() {
+			return res, lib_errors.New[lib_errors.ErrNotFound]("job not found")
+		}
+		// all job results embed lib_models.JobResult
+		dbRes.Data, err = json.Marshal(lib_models.JobResult{
+			JobId:       jobId,
+			ErrorResult: lib_models.NewErrorResult("job interrupted"),
+		})
+		if err != nil {
+			return res, err
+		}
+	}
+	if err = json.Unmarshal(dbRes.Data, &res); err != nil {
+		return res, err
 	}
 	return res, nil
 }
 
-func (s *Service) DeleteJobResults(jobIds []string) {
-	s.jobResults.mu.Lock()
-	defer s.jobResults.mu.Unlock()
-	for _, id := range jobIds {
-		delete(s.jobResults.deployments, id)
-		delete(s.jobResults.deploymentsUpdate, id)
-		delete(s.jobResults.moduleChange, id)
-		delete(s.jobResults.refreshRepositories, id)
-		delete(s.jobResults.auxDeploymentCreate, id)
-		delete(s.jobResults.auxDeploymentUpdate, id)
-		delete(s.jobResults.auxDeployment, id)
+// getDeploymentJobResult returns the stored result of a job via getJobResult. If a deployment id is provided, results
+// of jobs that belong to other deployments are treated as not found.
+func getDeploymentJobResult[T any](ctx context.Context, s *Service, deploymentId, jobId, resultType string) (T, error) {
+	if deploymentId != "" {
+		if _, err := s.getHandlerJob(deploymentId, jobId); err != nil {
+			var res T
+			return res, err
+		}
 	}
+	return getJobResult[T](ctx, s, jobId, resultType)
 }
@@ -3,7 +3,6 @@ package service
 import (
 	"sync"
 
-	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
 	handler_jobs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/handler/jobs"
 )
 
@@ -14,10 +13,12 @@ type Service struct {
 	auxDeploymentsHandler    auxiliaryDeploymentsHandler
 	globalConfigsHandler     globalConfigsHandler
 	depAdvertisementsHandler deploymentAdvertisementsHandler
+	backupHandler            backupHandler
+	bundlesHandler           bundlesHandler
 	databaseHandler          databaseHandler
 	jobsHandler              *handler_jobs.Handler
+	eventsHandler            eventsHandler
 	changeRequest            *modulesChangeRequest
-	jobResults               jobResults
 	mu                       sync.RWMutex
 	infoHandler
 }
@@ -29,8 +30,11 @@ func New(
 	auxDeploymentsHandler auxiliaryDeploymentsHandler,
 	globalConfigsHandler globalConfigsHandler,
 	depAdvertisementsHandler deploymentAdvertisementsHandler,
+	backupHandler backupHandler,
+	bundlesHandler bundlesHandler,
 	databaseHandler databaseHandler,
 	jobsHandler *handler_jobs.Handler,
+	eventsHandler eventsHandler,
 	infoHandler infoHandler,
 ) *Service {
 	return &Service{
@@ -40,18 +44,11 @@ func New(
 		auxDeploymentsHandler:    auxDeploymentsHandler,
 		globalConfigsHandler:     globalConfigsHandler,
 		depAdvertisementsHandler: depAdvertisementsHandler,
+		backupHandler:            backupHandler,
+		bundlesHandler:           bundlesHandler,
 		databaseHandler:          databaseHandler,
 		jobsHandler:              jobsHandler,
+		eventsHandler:            eventsHandler,
 		infoHandler:              infoHandler,
-		jobResults: jobResults{
-			deployments:         make(map[string]lib_models.DeploymentJobResult),
-			deploymentsUpdate:   make(map[string]lib_models.DeploymentUpdateJobResult),
-			deploymentsDelete:   make(map[string]lib_models.DeploymentDeleteJobResult),
-			moduleChange:        make(map[string]lib_models.ModulesChangeJobResult),
-			refreshRepositories: make(map[string]lib_models.RepositoryJobResult),
-			auxDeploymentCreate: make(map[string]lib_models.AuxiliaryDeploymentCreateJobResult),
-			auxDeploymentUpdate: make(map[string]lib_models.JobResult),
-			auxDeployment:       make(map[string]lib_models.AuxiliaryDeploymentJobResult),
-		},
 	}
 }
@@ -0,0 +1,269 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	lib_models "github.com/SENERGY-Platform/mgw-module-manager/lib/models"
+	helper_configs "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/configs"
+	helper_errors "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/errors"
+	helper_file_sys "github.com/SENERGY-Platform/mgw-module-manager/pkg/components/helper/file_sys"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	"github.com/SENERGY-Platform/mgw-module-manager/pkg/models/constants/slog_keys"
+)
+
+func (s *Service) GetDeploymentSnapshots(ctx context.Context, deploymentId string) ([]lib_models.DeploymentSnapshot, error) {
+	snapshots, err := s.deploymentsHandler.GetSnapshots(ctx, deploymentId)
+	if err != nil {
+		return nil, err
+	}
+	var libSnapshots []lib_models.DeploymentSnapshot
+	for _, snapshot := range snapshots {
+		libSnapshots = append(libSnapshots, getSnapshot(snapshot))
+	}
+	return libSnapshots, nil
+}
+
+// RollbackDeployment restores the module variant and the user input of a snapshot. If no snapshot ID is provided the
+// snapshot previous to the current state is used. The module is restored if the deployment update fails. Restoring
+// the module variant is refused while other deployments of the module exist, as they share the module.
+func (s *Service) RollbackDeployment(ctx context.Context, deploymentId, snapshotId string) (lib_models.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	currentJobs := s.jobsHandler.CurrentSlotJobs([]int{deploymentJobSlotNum, moduleJobSlotNum})
+	if len(currentJobs) > 0 {
+		return lib_models.Job{}, lib_errors.New[lib_errors.ErrActiveJob](activeJobsErrMsg(currentJobs))
+	}
+	snapshot, err := s.getRollbackSnapshot(ctx, deploymentId, snapshotId)
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	if err = s.checkRollbackModule(ctx, snapshot); err != nil {
+		return lib_models.Job{}, err
+	}
+	job, err := s.jobsHandler.CreateSlotJob(deploymentJobSlotNum, "rollback deployment")
+	if err != nil {
+		return lib_models.Job{}, err
+	}
+	go func() {
+		jobResult := lib_models.DeploymentUpdateJobResult{
+			JobResult: lib_models.JobResult{JobId: job.Id},
+		}
+		defer func() {
+			if st := recover(); st != nil {
+				jobResult.ErrorResult = lib_models.NewErrorResult(fmt.Sprintf("%v", st))
+				logger.ErrorContext(
+					ctx,
+					"rollback deployment",
+					slog_keys.JobId, job.Id,
+					slog_keys.Error, "panic",
+					slog_keys.StackTrace, st,
+				)
+			}
+			s.setUpdateDeploymentsJobResult(job.Id, jobResult)
+			job.Done()
+			logJobDone(ctx, job)
+		}()
+		logJobStart(ctx, job)
+		jobResult.Results, err = s.rollbackDeployment(job.Context(), snapshot)
+		if err != nil {
+			jobResult.ErrorResult = lib_models.NewErrorResult(err.Error())
+		}
+		for _, res := range jobResult.Results {
+			if res.HasError {
+				jobResult.ResultsErrNum++
+			}
+		}
+	}()
+	return lib_models.Job{
+		Id:          job.Id,
+		Description: job.Description,
+		Start:       job.Start,
+	}, nil
+}
+
+func (s *Service) getRollbackSnapshot(ctx context.Context, deploymentId, snapshotId string) (pkg_models.DeploymentSnapshot, error) {
+	if snapshotId != "" {
+		return s.deploymentsHandler.GetSnapshot(ctx, deploymentId, snapshotId)
+	}
+	snapshots, err := s.deploymentsHandler.GetSnapshots(ctx, deploymentId)
+	if err != nil {
+		return pkg_models.DeploymentSnapshot{}, err
+	}
+	// snapshots are sorted newest first, the newest snapshot reflects the current state
+	if len(snapshots) < 2 {
+		return pkg_models.DeploymentSnapshot{}, lib_errors.New[lib_errors.ErrNotFound]("no previous deployment snapshot available")
+	}
+	return snapshots[1], nil
+}
+
+// checkRollbackModule returns an error if the snapshot requires a different module variant while the module is used
+// by other deployments. Replacing the shared module would leave these deployments with removed images and a module
+// not matching their state.
+func (s *Service) checkRollbackModule(ctx context.Context, snapshot pkg_models.DeploymentSnapshot) error {
+	module, err := s.modulesHandler.GetModule(ctx, snapshot.ModuleId)
+	if err != nil {
+		return err
+	}
+	if module.Source == snapshot.ModuleSource && module.Channel == snapshot.ModuleChannel && module.Version == snapshot.ModuleVersion {
+		return nil
+	}
+	deploymentIds, err := s.deploymentsHandler.GetDeploymentIds(ctx, pkg_models.DeploymentsFilter{
+		ModuleIds: []string{snapshot.ModuleId},
+	})
+	if err != nil {
+		return err
+	}
+	delete(deploymentIds, snapshot.DeploymentId)
+	if len(deploymentIds) > 0 {
+		return lib_errors.New[lib_errors.ErrInUse](
+			fmt.Sprintf(
+				"module '%s' is used by other deployments: %s",
+				snapshot.ModuleId,
+				strings.Join(slices.Sorted(maps.Keys(deploymentIds)), ", "),
+			),
+		)
+	}
+	return nil
+}
+
+func (s *Service) rollbackDeployment(ctx context.Context, snapshot pkg_models.DeploymentSnapshot) ([]lib_models.DeploymentUpdateResult, error) {
+	module, err := s.modulesHandler.GetModule(ctx, snapshot.ModuleId)
+	if err != nil {
+		return nil, err
+	}
+	restoreModule := func() error { return nil }
+	if module.Source != snapshot.ModuleSource || module.Channel != snapshot.ModuleChannel || module.Version != snapshot.ModuleVersion {
+		backupDir, err := os.MkdirTemp("", "module-backup-")
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := os.RemoveAll(backupDir); err != nil {
+				logger.ErrorContext(ctx, "rollback deployment, remove module backup", slog_keys.ModuleId, module.ID, slog_keys.Error, err)
+			}
+		}()
+		backupPath := path.Join(backupDir, module.ID)
+		if err = helper_file_sys.CopyAll(module.FileSystem, backupPath); err != nil {
+			return nil, fmt.Errorf("backup module: %w", err)
+		}
+		restoreModule = func() error {
+			return s.modulesHandler.UpdateModule(ctx, module.ID, module.Source, module.Channel, os.DirFS(backupPath))
+		}
+		err = s.modulesHandler.UpdateModule(ctx, module.ID, snapshot.ModuleSource, snapshot.ModuleChannel, snapshot.ModuleFileSystem)
+		if err != nil {
+			return nil, fmt.Errorf("restore module: %w", err)
+		}
+		logger.InfoContext(
+			ctx,
+			"rollback deployment, restore module",
+			slog_keys.ModuleId, module.ID,
+			slog_keys.Version, snapshot.ModuleVersion,
+			slog_keys.DeploymentId, snapshot.DeploymentId,
+		)
+	}
+	handlerModules, err := s.modulesHandler.GetModules(
+		ctx,
+		pkg_models.ModulesFilterWithName{
+			ModulesFilter: pkg_models.ModulesFilter{
+				Ids: []string{snapshot.ModuleId},
+			},
+		},
+		false,
+	)
+	if err != nil {
+		return nil, helper_errors.Join(err, restoreModule())
+	}
+	handlerModule, ok := handlerModules[snapshot.ModuleId]
+	if !ok {
+		return nil, helper_errors.Join(errors.New("module not found"), restoreModule())
+	}
+	var updateDepResults []lib_models.DeploymentResult
+	updateDepResult, err := s.deploymentsHandler.UpdateDeployment(
+		ctx,
+		handlerModule,
+		snapshot.DeploymentId,
+		snapshot.UserInput,
+	)
+	if err == nil {
+		updateDepResults = append(updateDepResults, updateDepResult)
+		if updateDepResult.HasError {
+			err = fmt.Errorf("update deployment: %s", updateDepResult.ErrorMsg)
+		}
+	}
+	if err != nil {
+		if e := restoreModule(); e != nil {
+			logger.ErrorContext(ctx, "rollback deployment, restore previous module", slog_keys.ModuleId, module.ID, slog_keys.Error, e)
+			err = helper_errors.Join(err, e)
+		}
+	}
+	return s.recreateUpdatedAuxDeployments(ctx, updateDepResults, handlerModules, lib_models.AuxiliaryDeploymentsFilter{Recreate: 1}), err
+}
+
+func getSnapshot(snapshot pkg_models.DeploymentSnapshot) lib_models.DeploymentSnapshot {
+	userInput := lib_models.DeploymentUserInput{
+		ModuleId:          snapshot.UserInput.ModuleId,
+		HostResources:     snapshot.UserInput.HostResources,
+		Secrets:           snapshot.UserInput.Secrets,
+		Configs:           make(map[string]interface{}),
+		GlobalConfigs:     snapshot.UserInput.GlobalConfigs,
+		Files:             make(map[string]string),
+		DependencyTargets: snapshot.UserInput.DependencyTargets,
+		FileGroups:        make(map[string]map[string]lib_models.DeploymentFileGroupUserInput),
+	}
+	for reference, value := range snapshot.UserInput.Configs {
+		userInput.Configs[reference] = helper_configs.ValueToInterface(value)
+	}
+	for reference, data := range snapshot.UserInput.Files {
+		userInput.Files[reference] = base64.StdEncoding.EncodeToString(data)
+	}
+	for reference, items := range snapshot.UserInput.FileGroups {
+		libItems := make(map[string]lib_models.DeploymentFileGroupUserInput)
+		for p, item := range items {
+			libItems[p] = lib_models.DeploymentFileGroupUserInput{
+				Format: item.Format,
+				Data:   base64.StdEncoding.EncodeToString(item.Data),
+			}
+		}
+		userInput.FileGroups[reference] = libItems
+	}
+	containers := make(map[string]string)
+	for _, container := range snapshot.Containers {
+		containers[container.Reference] = container.Name
+	}
+	return lib_models.DeploymentSnapshot{
+		Id:            snapshot.Id,
+		DeploymentId:  snapshot.DeploymentId,
+		ModuleId:      snapshot.ModuleId,
+		ModuleSource:  snapshot.ModuleSource,
+		ModuleChannel: snapshot.ModuleChannel,
+		ModuleVersion: snapshot.ModuleVersion,
+		UserInput:     userInput,
+		Containers:    containers,
+		Created:       snapshot.Created,
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	lib_errors "github.com/SENERGY-Platform/mgw-module-manager/lib/errors"
+	pkg_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models"
+	external_models "github.com/SENERGY-Platform/mgw-module-manager/pkg/models/external"
+)
+
+func TestService_checkRollbackModule(t *testing.T) {
+	mockMods := &modulesHandlerMock{Module: pkg_models.Module{
+		ModuleLibModule: external_models.ModuleLibModule{ID: "github.com/org/repo", Version: "v2.0.0"},
+		Source:          "test",
+		Channel:         "test",
+	}}
+	mockDeps := &deploymentsHandlerMock{DeploymentIds: map[string]string{
+		"dep-a": "github.com/org/repo",
+		"dep-b": "github.com/org/repo",
+	}}
+	s := &Service{modulesHandler: mockMods, deploymentsHandler: mockDeps}
+	snapshot := pkg_models.DeploymentSnapshot{
+		DeploymentId:  "dep-a",
+		ModuleId:      "github.com/org/repo",
+		ModuleSource:  "test",
+		ModuleChannel: "test",
+		ModuleVersion: "v1.0.0",
+	}
+	err := s.checkRollbackModule(context.Background(), snapshot)
+	if !lib_errors.IsOf[lib_errors.ErrInUse](err) {
+		t.Errorf("expected in use error, got %v", err)
+	}
+	t.Run("same module variant", func(t *testing.T) {
+		snapshot := snapshot
+		snapshot.ModuleVersion = "v2.0.0"
+		if err := s.checkRollbackModule(context.Background(), snapshot); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("single deployment", func(t *testing.T) {
+		delete(mockDeps.DeploymentIds, "dep-b")
+		if err := s.checkRollbackModule(context.Background(), snapshot); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+type modulesHandlerMock struct {
+	modulesHandler
+	Module pkg_models.Module
+}
+
+func (m *modulesHandlerMock) GetModule(_ context.Context, id string) (pkg_models.Module, error) {
+	if id != m.Module.ID {
+		return pkg_models.Module{}, lib_errors.New[lib_errors.ErrNotFound]("module not found")
+	}
+	return m.Module, nil
+}
+
+type deploymentsHandlerMock struct {
+	deploymentsHandler
+	DeploymentIds map[string]string
+}
+
+func (m *deploymentsHandlerMock) GetDeploymentIds(_ context.Context, filter pkg_models.DeploymentsFilter) (map[string]string, error) {
+	ids := make(map[string]string)
+	for id, moduleId := range m.DeploymentIds {
+		for _, fId := range filter.ModuleIds {
+			if moduleId == fId {
+				ids[id] = moduleId
+			}
+		}
+	}
+	return ids, nil
+}